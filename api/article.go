@@ -4,11 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
 	"github.com/MonitorAllen/nostalgia/internal/cache/key"
-	"github.com/go-ego/gse"
+	"github.com/MonitorAllen/nostalgia/internal/search"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 
@@ -19,14 +19,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-var segmenter gse.Segmenter
-
 var errArticleAccessRestricted = errors.New("访问受限")
 
-func init() {
-	segmenter.LoadDict()
-}
-
 type getArticleRequest struct {
 	ID string `uri:"id" binding:"required,uuid"`
 }
@@ -52,18 +46,9 @@ func (server *Server) getArticle(ctx *gin.Context) {
 	cacheKey := key.GetArticleIDKey(articleID)
 	articleCache := cachepkg.NewArticleCache(server.cache)
 
-	article, ok, err := articleCache.GetByID(ctx, articleID)
-	if err != nil && !errors.Is(err, redis.Nil) {
-		log.Error().
-			Err(err).
-			Str("key", cacheKey).
-			Str("module", "article").
-			Str("action", "cache_get").
-			Str("article_id", req.ID).
-			Msg("根据 ID 获取文章缓存失败，降级为仅数据库")
-	}
-
+	article, ok := server.cachedArticleByID(ctx, articleID)
 	if ok {
+		addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
 		ctx.JSON(http.StatusOK, getArticleResponse{article})
 		return
 	}
@@ -109,10 +94,54 @@ func (server *Server) getArticle(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(fmt.Errorf("unexpected article cache load result")))
 		return
 	}
+	server.mergePendingCounters(ctx, article.ID, &article.Views, &article.Likes)
+	addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
 
 	ctx.JSON(http.StatusOK, getArticleResponse{Article: article})
 }
 
+// cachedGetArticleResponse 文章详情的 HTTP 缓存校验器
+func (server *Server) cachedGetArticleResponse(ctx *gin.Context) (any, bool) {
+	var req getArticleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		return nil, false
+	}
+	articleID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, false
+	}
+
+	article, ok := server.cachedArticleByID(ctx, articleID)
+	if !ok {
+		return nil, false
+	}
+	addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
+	return getArticleResponse{Article: article}, true
+}
+
+// cachedArticleByID 只读取缓存中的文章详情并叠加实时计数
+func (server *Server) cachedArticleByID(ctx *gin.Context, id uuid.UUID) (db.GetArticleRow, bool) {
+	cacheKey := key.GetArticleIDKey(id)
+	return memoizeRequest(ctx, cacheKey, func() (db.GetArticleRow, bool) {
+		article, ok, err := cachepkg.NewArticleCache(server.cache).GetByID(ctx, id)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			log.Error().
+				Err(err).
+				Str("key", cacheKey).
+				Str("module", "article").
+				Str("action", "cache_get").
+				Str("article_id", id.String()).
+				Msg("根据 ID 获取文章缓存失败，降级为仅数据库")
+		}
+		if !ok {
+			return db.GetArticleRow{}, false
+		}
+
+		server.mergePendingCounters(ctx, article.ID, &article.Views, &article.Likes)
+		return article, true
+	})
+}
+
 type listArticleRequest struct {
 	CategoryID int64 `form:"category_id" binding:"omitempty"`
 	Page       int32 `form:"page" binding:"required,min=1"`
@@ -157,21 +186,17 @@ func (server *Server) listArticle(ctx *gin.Context) {
 		Valid: req.CategoryID != 0,
 	}
 
+	if req.CategoryID != 0 {
+		addSurrogateKeys(ctx, categorySurrogateKey(req.CategoryID))
+	}
+
 	articleCache := cachepkg.NewArticleCache(server.cache)
 	cacheParams := cachepkg.ArticleListParams{
 		CategoryID: req.CategoryID,
 		Page:       req.Page,
 		Limit:      req.Limit,
 	}
-	cachedPage, ok, err := articleCache.GetList(ctx, cacheParams)
-	if err != nil && !errors.Is(err, redis.Nil) {
-		log.Error().
-			Err(err).
-			Str("module", "article").
-			Str("action", "cache_get").
-			Str("cache_namespace", "article_list").
-			Msg("获取文章分页缓存失败，降级为仅数据库")
-	}
+	cachedPage, ok := server.cachedArticleList(ctx, cacheParams)
 	if ok {
 		ctx.JSON(http.StatusOK, listArticleResponse{
 			Count:    cachedPage.Count,
@@ -222,6 +247,44 @@ func (server *Server) listArticle(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+// cachedListArticleResponse 文章列表的 HTTP 缓存校验器
+func (server *Server) cachedListArticleResponse(ctx *gin.Context) (any, bool) {
+	var req listArticleRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		return nil, false
+	}
+
+	page, ok := server.cachedArticleList(ctx, cachepkg.ArticleListParams{
+		CategoryID: req.CategoryID,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	})
+	if !ok {
+		return nil, false
+	}
+	if req.CategoryID != 0 {
+		addSurrogateKeys(ctx, categorySurrogateKey(req.CategoryID))
+	}
+	return listArticleResponse{Count: page.Count, Articles: page.Articles}, true
+}
+
+// cachedArticleList 只读取缓存中的文章分页
+func (server *Server) cachedArticleList(ctx *gin.Context, params cachepkg.ArticleListParams) (cachepkg.ArticleListPage, bool) {
+	memoKey := fmt.Sprintf("cache:article:list:%d:%d:%d", params.CategoryID, params.Page, params.Limit)
+	return memoizeRequest(ctx, memoKey, func() (cachepkg.ArticleListPage, bool) {
+		page, ok, err := cachepkg.NewArticleCache(server.cache).GetList(ctx, params)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			log.Error().
+				Err(err).
+				Str("module", "article").
+				Str("action", "cache_get").
+				Str("cache_namespace", "article_list").
+				Msg("获取文章分页缓存失败，降级为仅数据库")
+		}
+		return page, ok
+	})
+}
+
 type incrementArticleLikesRequest struct {
 	ID uuid.UUID `json:"id" binding:"required,uuid"`
 }
@@ -256,7 +319,7 @@ func (server *Server) incrementArticleLikes(ctx *gin.Context) {
 		return
 	}
 
-	if err = server.store.IncrementArticleLikes(ctx, req.ID); err != nil {
+	if err = server.incrementArticleCounter(ctx, cachepkg.ArticleCounterLikes, req.ID); err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -297,7 +360,7 @@ func (server *Server) incrementArticleViews(ctx *gin.Context) {
 		return
 	}
 
-	if err = server.store.IncrementArticleViews(ctx, req.ID); err != nil {
+	if err = server.incrementArticleCounter(ctx, cachepkg.ArticleCounterViews, req.ID); err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -305,15 +368,112 @@ func (server *Server) incrementArticleViews(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, nil)
 }
 
+// incrementArticleCounter 计数先累积到 Redis，由定时任务批量写回；缓存不可用时直接更新数据库
+func (server *Server) incrementArticleCounter(ctx *gin.Context, metric string, id uuid.UUID) error {
+	counterCache := cachepkg.NewArticleCounterCache(server.cache)
+
+	var buffered bool
+	var err error
+	if metric == cachepkg.ArticleCounterLikes {
+		buffered, err = counterCache.IncrLikes(ctx, id)
+	} else {
+		buffered, err = counterCache.IncrViews(ctx, id)
+	}
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.ArticleCounterPendingKey).
+			Str("module", "article").
+			Str("action", "counter_incr").
+			Str("metric", metric).
+			Str("article_id", id.String()).
+			Msg("累积文章计数失败，降级为仅数据库")
+	}
+	if buffered {
+		return nil
+	}
+
+	if metric == cachepkg.ArticleCounterLikes {
+		err = server.store.IncrementArticleLikes(ctx, id)
+	} else {
+		err = server.store.IncrementArticleViews(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 最近一次写回记录的计数不包含这次更新，清除后详情接口回退到数据库中的计数
+	if err := counterCache.ClearFlushedTotals(ctx, id); err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.GetArticleCounterTotalsKey(id)).
+			Str("module", "article").
+			Str("action", "counter_clear").
+			Str("article_id", id.String()).
+			Msg("清除文章已写回计数失败")
+	}
+	return nil
+}
+
+// mergePendingCounters 以最近一次写回的计数为准，叠加尚未写回数据库的计数。
+// 详情缓存中的计数可能早于写回，因此不需要在写回后删除详情缓存；
+// 写回后的计数与清除本批增量在同一事务中完成，本批增量不会被重复叠加
+func (server *Server) mergePendingCounters(ctx *gin.Context, id uuid.UUID, views, likes *int32) {
+	counterCache := cachepkg.NewArticleCounterCache(server.cache)
+
+	totals, ok, err := counterCache.FlushedTotals(ctx, id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.GetArticleCounterTotalsKey(id)).
+			Str("module", "article").
+			Str("action", "counter_get").
+			Str("article_id", id.String()).
+			Msg("获取文章已写回计数失败")
+	} else if ok {
+		*views = totals.Views
+		*likes = totals.Likes
+	}
+
+	delta, err := counterCache.Pending(ctx, id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.ArticleCounterPendingKey).
+			Str("module", "article").
+			Str("action", "counter_get").
+			Str("article_id", id.String()).
+			Msg("获取文章待写回计数失败")
+		return
+	}
+
+	*views += int32(delta.Views)
+	*likes += int32(delta.Likes)
+}
+
 type searchArticlesRequest struct {
-	Keyword string `form:"keyword" binding:"required"`
-	Page    int32  `form:"page" binding:"required,min=0"`
-	Limit   int32  `form:"limit" binding:"required,min=1,max=20"`
+	Keyword    string `form:"keyword" binding:"required"`
+	Page       int32  `form:"page" binding:"required,min=0"`
+	Limit      int32  `form:"limit" binding:"required,min=1,max=20"`
+	CategoryID int64  `form:"category_id" binding:"omitempty,min=1"`
+	Tag        string `form:"tag" binding:"omitempty,max=50"`
+	StartDate  string `form:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate    string `form:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	Sort       string `form:"sort" binding:"omitempty,oneof=relevance newest most_viewed"`
+}
+
+type searchFacets struct {
+	Categories []db.ListSearchArticleCategoryFacetsRow `json:"categories"`
+	Years      []db.ListSearchArticleYearFacetsRow     `json:"years"`
 }
 
 type searchArticlesResponse struct {
-	Articles []db.SearchArticlesRow `json:"articles"`
-	Count    int64                  `json:"count"`
+	Articles   []db.SearchArticlesRow `json:"articles"`
+	Count      int64                  `json:"count"`
+	Facets     searchFacets           `json:"facets"`
+	DidYouMean []string               `json:"did_you_mean"`
+	// Impression 签名后的展示 ID，上报点击时原样带回，只在第一页返回
+	Impression string `json:"impression,omitempty"`
 }
 
 func (server *Server) searchArticle(ctx *gin.Context) {
@@ -323,52 +483,291 @@ func (server *Server) searchArticle(ctx *gin.Context) {
 		return
 	}
 
-	userInput := req.Keyword
+	query, err := search.ParseFilter(search.Filter{
+		Keyword:    req.Keyword,
+		CategoryID: req.CategoryID,
+		Tag:        req.Tag,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Sort:       req.Sort,
+		IsPublish: pgtype.Bool{
+			Bool:  true,
+			Valid: true,
+		},
+		Location: search.Location(server.config),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
 
-	segments := segmenter.Cut(userInput, true)
+	result, err := search.Search(ctx, server.store, query, req.Limit, (req.Page-1)*req.Limit, search.NewHighlighter(server.config))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
 
-	var cleanSegments []string
-	for _, s := range segments {
-		if len(s) > 0 && s != " " {
-			cleanSegments = append(cleanSegments, s)
+	didYouMean := []string{}
+	if result.Count == 0 {
+		suggestions, err := search.NewSuggester(server.store, server.cache).DidYouMean(ctx, req.Keyword)
+		if err != nil {
+			log.Error().Err(err).Str("module", "search").Str("keyword", req.Keyword).Msg("生成搜索纠正建议失败")
+		} else if len(suggestions) > 0 {
+			didYouMean = suggestions
 		}
 	}
-	keyword := strings.Join(cleanSegments, " OR ")
-	if keyword == "" {
-		keyword = req.Keyword
+
+	// 翻页不重复计入搜索次数
+	var impression string
+	if req.Page == 1 {
+		if searchID := server.recordSearchQuery(ctx, req.Keyword, result.Count); searchID > 0 {
+			impression = search.SignImpression(searchID, time.Now(), server.config.TokenSymmetricKey)
+		}
 	}
 
-	arg := db.SearchArticlesParams{
-		Limit:   req.Limit,
-		Offset:  (req.Page - 1) * req.Limit,
-		Keyword: keyword,
-		IsPublish: pgtype.Bool{
-			Bool:  true,
-			Valid: true,
+	ctx.JSON(http.StatusOK, searchArticlesResponse{
+		Articles: result.Articles,
+		Count:    result.Count,
+		Facets: searchFacets{
+			Categories: result.Categories,
+			Years:      result.Years,
 		},
+		DidYouMean: didYouMean,
+		Impression: impression,
+	})
+}
+
+// recordSearchQuery 记录脱敏后的查询词，失败时只记录日志并返回 0
+func (server *Server) recordSearchQuery(ctx *gin.Context, keyword string, resultCount int64) int64 {
+	query := search.NormalizeQuery(keyword)
+	if query == "" {
+		return 0
+	}
+
+	searchQuery, err := server.store.CreateSearchQuery(ctx, db.CreateSearchQueryParams{
+		Query:       query,
+		ResultCount: resultCount,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("module", "search").Str("action", "record_query").Msg("记录搜索查询失败")
+		return 0
 	}
-	searchArticlesRows, err := server.store.SearchArticles(ctx, arg)
+	return searchQuery.ID
+}
+
+type recordSearchClickRequest struct {
+	Impression string `json:"impression" binding:"required,max=128"`
+	ArticleID  string `json:"article_id" binding:"required,uuid"`
+	Position   int32  `json:"position" binding:"required,min=1,max=1000"`
+}
+
+// recordSearchClick 只接受搜索接口签发的展示 ID，按 IP 限流，同一次搜索中的同一篇文章只记录一次
+func (server *Server) recordSearchClick(ctx *gin.Context) {
+	var req recordSearchClickRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	articleID, err := uuid.Parse(req.ArticleID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	searchID, err := search.VerifyImpression(req.Impression, server.config.TokenSymmetricKey, time.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	searchCache := cachepkg.NewSearchCache(server.cache)
+	allowed, err := searchCache.AllowClick(ctx, ctx.ClientIP(), time.Now())
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	if !allowed {
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(errors.New("too many search clicks")))
+		return
+	}
 
-	countArg := db.CountSearchArticlesParams{
-		Keyword: keyword,
-		IsPublish: pgtype.Bool{
-			Bool:  true,
-			Valid: true,
-		},
+	ok, err := searchCache.MarkClick(ctx, searchID, articleID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !ok {
+		ctx.JSON(http.StatusConflict, nil)
+		return
 	}
-	countSearchArticles, err := server.store.CountSearchArticles(ctx, countArg)
+
+	_, err = server.store.CreateSearchClick(ctx, db.CreateSearchClickParams{
+		SearchQueryID: searchID,
+		ArticleID:     articleID,
+		Position:      req.Position,
+	})
 	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, searchArticlesResponse{
-		Articles: searchArticlesRows,
-		Count:    countSearchArticles,
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type suggestArticlesRequest struct {
+	Prefix string `form:"prefix" binding:"required,max=50"`
+	Limit  int32  `form:"limit" binding:"omitempty,min=1,max=10"`
+}
+
+type suggestArticlesResponse struct {
+	Titles []string            `json:"titles"`
+	Tags   []db.SuggestTagsRow `json:"tags"`
+}
+
+const defaultSuggestLimit int32 = 5
+
+func (server *Server) suggestArticles(ctx *gin.Context) {
+	var req suggestArticlesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultSuggestLimit
+	}
+
+	suggestions, err := search.NewSuggester(server.store, server.cache).Suggest(ctx, req.Prefix, req.Limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, suggestArticlesResponse{
+		Titles: suggestions.Titles,
+		Tags:   suggestions.Tags,
+	})
+}
+
+type listRelatedArticlesRequest struct {
+	ID    string `uri:"id" binding:"required,uuid"`
+	Limit int32  `form:"limit" binding:"omitempty,min=1,max=10"`
+}
+
+type listRelatedArticlesResponse struct {
+	Articles []db.ListRelatedArticlesRow `json:"articles"`
+}
+
+const defaultRelatedArticleLimit int32 = 5
+
+func (server *Server) listRelatedArticles(ctx *gin.Context) {
+	var req listRelatedArticlesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultRelatedArticleLimit
+	}
+
+	articleID, err := uuid.Parse(req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	addSurrogateKeys(ctx, articleSurrogateKey(articleID))
+
+	articleCache := cachepkg.NewArticleCache(server.cache)
+	related, ok := server.cachedRelatedArticles(ctx, articleID, req.Limit)
+	if ok {
+		ctx.JSON(http.StatusOK, listRelatedArticlesResponse{Articles: related})
+		return
+	}
+
+	article, err := server.store.GetArticle(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !article.IsPublish {
+		ctx.JSON(http.StatusForbidden, errorResponse(errArticleAccessRestricted))
+		return
+	}
+
+	related, err = server.store.ListRelatedArticles(ctx, db.ListRelatedArticlesParams{
+		ArticleID:  article.ID,
+		Keyword:    search.BuildRelatedKeyword(article.Title, article.Summary),
+		MaxResults: req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if err := articleCache.SetRelated(ctx, articleID, req.Limit, related); err != nil {
+		log.Error().
+			Err(err).
+			Str("module", "article").
+			Str("action", "cache_set").
+			Str("article_id", req.ID).
+			Msg("设置相关文章缓存失败")
+	}
+
+	ctx.JSON(http.StatusOK, listRelatedArticlesResponse{Articles: related})
+}
+
+// cachedListRelatedArticlesResponse 相关文章的 HTTP 缓存校验器
+func (server *Server) cachedListRelatedArticlesResponse(ctx *gin.Context) (any, bool) {
+	var req listRelatedArticlesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		return nil, false
+	}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		return nil, false
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultRelatedArticleLimit
+	}
+	articleID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, false
+	}
+
+	related, ok := server.cachedRelatedArticles(ctx, articleID, req.Limit)
+	if !ok {
+		return nil, false
+	}
+	addSurrogateKeys(ctx, articleSurrogateKey(articleID))
+	return listRelatedArticlesResponse{Articles: related}, true
+}
+
+// cachedRelatedArticles 只读取缓存中的相关文章
+func (server *Server) cachedRelatedArticles(ctx *gin.Context, id uuid.UUID, limit int32) ([]db.ListRelatedArticlesRow, bool) {
+	memoKey := fmt.Sprintf("cache:article:related:%s:%d", id.String(), limit)
+	return memoizeRequest(ctx, memoKey, func() ([]db.ListRelatedArticlesRow, bool) {
+		related, ok, err := cachepkg.NewArticleCache(server.cache).GetRelated(ctx, id, limit)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			log.Error().
+				Err(err).
+				Str("module", "article").
+				Str("action", "cache_get").
+				Str("article_id", id.String()).
+				Msg("获取相关文章缓存失败，降级为仅数据库")
+		}
+		return related, ok
 	})
 }
 
@@ -391,18 +790,9 @@ func (server *Server) getArticleBySlug(ctx *gin.Context) {
 	cacheKey := key.GetArticleSlugKey(req.Slug)
 	articleCache := cachepkg.NewArticleCache(server.cache)
 
-	article, ok, err := articleCache.GetBySlug(ctx, req.Slug)
-	if err != nil && !errors.Is(err, redis.Nil) {
-		log.Error().
-			Err(err).
-			Str("key", cacheKey).
-			Str("module", "article").
-			Str("action", "cache_get").
-			Str("article_slug", req.Slug).
-			Msg("获取文章缓存失败，降级为仅数据库")
-	}
-
+	article, ok := server.cachedArticleBySlug(ctx, req.Slug)
 	if ok {
+		addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
 		ctx.JSON(http.StatusOK, getArticleBySlugResponse{article})
 		return
 	}
@@ -434,6 +824,16 @@ func (server *Server) getArticleBySlug(ctx *gin.Context) {
 	})
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
+			// 短标识已修改或配置了自定义跳转时返回新地址，由前端替换当前页面
+			target, found, redirectErr := server.findRedirect(ctx, "/article/"+req.Slug)
+			if redirectErr != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(redirectErr))
+				return
+			}
+			if found {
+				ctx.JSON(http.StatusOK, redirectResponse{Redirect: target})
+				return
+			}
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
 			return
 		}
@@ -451,6 +851,46 @@ func (server *Server) getArticleBySlug(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(fmt.Errorf("unexpected article slug cache load result")))
 		return
 	}
+	server.mergePendingCounters(ctx, article.ID, &article.Views, &article.Likes)
+	addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
 
 	ctx.JSON(http.StatusOK, getArticleBySlugResponse{Article: article})
 }
+
+// cachedGetArticleBySlugResponse 按短标识获取文章详情的 HTTP 缓存校验器
+func (server *Server) cachedGetArticleBySlugResponse(ctx *gin.Context) (any, bool) {
+	var req getArticleBySlugRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		return nil, false
+	}
+
+	article, ok := server.cachedArticleBySlug(ctx, req.Slug)
+	if !ok {
+		return nil, false
+	}
+	addSurrogateKeys(ctx, articleSurrogateKey(article.ID))
+	return getArticleBySlugResponse{Article: article}, true
+}
+
+// cachedArticleBySlug 只读取缓存中的文章详情并叠加实时计数
+func (server *Server) cachedArticleBySlug(ctx *gin.Context, slug string) (db.GetArticleBySlugRow, bool) {
+	cacheKey := key.GetArticleSlugKey(slug)
+	return memoizeRequest(ctx, cacheKey, func() (db.GetArticleBySlugRow, bool) {
+		article, ok, err := cachepkg.NewArticleCache(server.cache).GetBySlug(ctx, slug)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			log.Error().
+				Err(err).
+				Str("key", cacheKey).
+				Str("module", "article").
+				Str("action", "cache_get").
+				Str("article_slug", slug).
+				Msg("获取文章缓存失败，降级为仅数据库")
+		}
+		if !ok {
+			return db.GetArticleBySlugRow{}, false
+		}
+
+		server.mergePendingCounters(ctx, article.ID, &article.Views, &article.Likes)
+		return article, true
+	})
+}
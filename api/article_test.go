@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -18,7 +19,9 @@ import (
 	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
 	"github.com/MonitorAllen/nostalgia/internal/cache/key"
 	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/internal/search"
 	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -35,6 +38,12 @@ func TestGetArticleAPI(t *testing.T) {
 	unpublishedArticle.IsPublish = false
 
 	cacheArticle := article
+	pendingArticle := cacheArticle
+	pendingArticle.Views += 5
+	pendingArticle.Likes += 2
+	flushedArticle := cacheArticle
+	flushedArticle.Views += 10
+	flushedArticle.Likes += 4
 	cacheKey := key.GetArticleIDKey(article.ID)
 	unpublishedCacheKey := key.GetArticleIDKey(unpublishedArticle.ID)
 
@@ -62,6 +71,21 @@ func TestGetArticleAPI(t *testing.T) {
 					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(article), durationBetween(cachepkg.ArticleDetailTTL, cachepkg.ArticleDetailTTL+cachepkg.ArticleDetailTTL/10)).
 					Times(1).
 					Return(nil)
+
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(article.ID)), gomock.Any()).
+					Times(1).
+					Return(false, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -83,10 +107,65 @@ func TestGetArticleAPI(t *testing.T) {
 				store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(article.ID)).Times(0)
 
 				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(article.ID)), gomock.Any()).
+					Times(1).
+					Return(false, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"5", "2"}, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				// 叠加尚未写回数据库的计数
+				requireBodyMatchGetArticleRow(t, recorder.Body, pendingArticle)
+			},
+		},
+		{
+			name:      "OK_FlushedTotals",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *db.GetArticleRow) (bool, error) {
+						*dest = cacheArticle
+						return true, nil
+					})
+
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Any()).Times(0)
+
+				// 详情缓存中的计数早于写回，以写回后的计数为准
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(article.ID)), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *cachepkg.ArticleCounterTotals) (bool, error) {
+						*dest = cachepkg.ArticleCounterTotals{Views: flushedArticle.Views - 2, Likes: flushedArticle.Likes}
+						return true, nil
+					})
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+
+				// 正在写回的批次仍计入实时计数
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"2", ""}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchGetArticleRow(t, recorder.Body, cacheArticle)
+				requireBodyMatchGetArticleRow(t, recorder.Body, flushedArticle)
 			},
 		},
 		{
@@ -571,6 +650,13 @@ func TestSearchArticleAPI(t *testing.T) {
 		}
 	}
 
+	categoryFacets := []db.ListSearchArticleCategoryFacetsRow{
+		{CategoryID: 1, CategoryName: "默认", Count: int64(n)},
+	}
+	yearFacets := []db.ListSearchArticleYearFacetsRow{
+		{Year: 2024, Count: int64(n)},
+	}
+
 	testCases := []struct {
 		name          string
 		req           searchArticlesRequest
@@ -586,29 +672,58 @@ func TestSearchArticleAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				arg := db.SearchArticlesParams{
-					Limit:   10,
-					Offset:  0,
-					Keyword: "go",
+					Limit:        10,
+					Offset:       0,
+					Keyword:      `"go"`,
+					SnippetWidth: util.DefaultSearchSnippetWidth,
 					IsPublish: pgtype.Bool{
 						Bool:  true,
 						Valid: true,
 					},
+					SortBy: search.SortRelevance,
 				}
 				store.EXPECT().SearchArticles(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(searchArticlesRows, nil)
 
 				countArg := db.CountSearchArticlesParams{
-					Keyword:   "go",
+					Keyword:   `"go"`,
 					IsPublish: pgtype.Bool{Bool: true, Valid: true},
 				}
 				store.EXPECT().CountSearchArticles(gomock.Any(), gomock.Eq(countArg)).
 					Times(1).
 					Return(int64(n), nil)
+
+				store.EXPECT().
+					ListSearchArticleCategoryFacets(gomock.Any(), gomock.Eq(db.ListSearchArticleCategoryFacetsParams{
+						Keyword:   `"go"`,
+						IsPublish: pgtype.Bool{Bool: true, Valid: true},
+					})).
+					Times(1).
+					Return(categoryFacets, nil)
+				store.EXPECT().
+					ListSearchArticleYearFacets(gomock.Any(), gomock.Eq(db.ListSearchArticleYearFacetsParams{
+						TimeZone:  "UTC",
+						Keyword:   `"go"`,
+						IsPublish: pgtype.Bool{Bool: true, Valid: true},
+					})).
+					Times(1).
+					Return(yearFacets, nil)
+				store.EXPECT().
+					CreateSearchQuery(gomock.Any(), gomock.Eq(db.CreateSearchQueryParams{Query: "go", ResultCount: int64(n)})).
+					Times(1).
+					Return(db.SearchQuery{ID: 42}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 				requireBodyMatchSearchArticles(t, recorder.Body, searchArticlesRows)
+
+				var resp searchArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Equal(t, categoryFacets, resp.Facets.Categories)
+				require.Equal(t, yearFacets, resp.Facets.Years)
+				require.True(t, strings.HasPrefix(resp.Impression, "42."))
 			},
 		},
 		{
@@ -629,6 +744,10 @@ func TestSearchArticleAPI(t *testing.T) {
 					CountSearchArticles(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(int64(0), nil)
+				store.EXPECT().
+					ListSearchVocabulary(gomock.Any()).
+					Times(1).
+					Return([]string{"golang"}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -640,6 +759,35 @@ func TestSearchArticleAPI(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, int64(0), resp.Count)
 				require.Empty(t, resp.Articles)
+				require.NotNil(t, resp.Facets.Categories)
+				require.Empty(t, resp.Facets.Categories)
+				require.NotNil(t, resp.DidYouMean)
+				require.Empty(t, resp.DidYouMean)
+			},
+		},
+		{
+			name: "OK_DidYouMean",
+			req: searchArticlesRequest{
+				Keyword: "golnag",
+				Page:    1,
+				Limit:   10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchArticles(gomock.Any(), gomock.Any()).Times(1).Return([]db.SearchArticlesRow{}, nil)
+				store.EXPECT().CountSearchArticles(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					ListSearchVocabulary(gomock.Any()).
+					Times(1).
+					Return([]string{"golang", "redis"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp searchArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Equal(t, []string{"golang"}, resp.DidYouMean)
 			},
 		},
 		{
@@ -652,10 +800,12 @@ func TestSearchArticleAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				// 修改这里：期望 "go OR 并发"
 				arg := db.SearchArticlesParams{
-					Limit:     10,
-					Offset:    0,
-					Keyword:   "go OR 并发", // <--- Go 改成小写
-					IsPublish: pgtype.Bool{Bool: true, Valid: true},
+					Limit:        10,
+					Offset:       0,
+					Keyword:      `"go" OR "并发"`, // <--- Go 改成小写
+					SnippetWidth: util.DefaultSearchSnippetWidth,
+					IsPublish:    pgtype.Bool{Bool: true, Valid: true},
+					SortBy:       search.SortRelevance,
 				}
 
 				store.EXPECT().
@@ -664,16 +814,176 @@ func TestSearchArticleAPI(t *testing.T) {
 					Return(searchArticlesRows, nil)
 
 				countArg := db.CountSearchArticlesParams{
-					Keyword:   "go OR 并发", // <--- Go 改成小写
+					Keyword:   `"go" OR "并发"`, // <--- Go 改成小写
 					IsPublish: pgtype.Bool{Bool: true, Valid: true},
 				}
 				store.EXPECT().
 					CountSearchArticles(gomock.Any(), gomock.Eq(countArg)).
 					Times(1).
 					Return(int64(n), nil)
+				store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Any()).Times(1).Return(categoryFacets, nil)
+				store.EXPECT().ListSearchArticleYearFacets(gomock.Any(), gomock.Any()).Times(1).Return(yearFacets, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK_Highlight",
+			req: searchArticlesRequest{
+				Keyword: "Go",
+				Page:    1,
+				Limit:   10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				row := searchArticlesRows[0]
+				row.HighlightedTitle = `<span class="keyword">Go</span> &amp; 并发`
+				row.Snippets = []string{
+					"第一段 <span class=\"keyword\">Go</span>\n代码",
+					"  ",
+					"第二段", "第三段", "第四段",
+				}
+
+				store.EXPECT().
+					SearchArticles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.SearchArticlesRow{row}, nil)
+				store.EXPECT().
+					CountSearchArticles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Any()).Times(1).Return(categoryFacets, nil)
+				store.EXPECT().ListSearchArticleYearFacets(gomock.Any(), gomock.Any()).Times(1).Return(yearFacets, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp searchArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Len(t, resp.Articles, 1)
+				require.Equal(t, "<mark>Go</mark> &amp; 并发", resp.Articles[0].HighlightedTitle)
+				require.Equal(t, []string{"第一段 <mark>Go</mark> 代码", "第二段", "第三段"}, resp.Articles[0].Snippets)
+			},
+		},
+		{
+			name: "OK_WithFilters",
+			req: searchArticlesRequest{
+				Keyword:    "Go",
+				Page:       2,
+				Limit:      10,
+				CategoryID: 3,
+				Tag:        "golang",
+				StartDate:  "2024-01-01",
+				EndDate:    "2024-12-31",
+				Sort:       search.SortMostViewed,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				startTime := pgtype.Timestamptz{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+				endTime := pgtype.Timestamptz{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+				arg := db.SearchArticlesParams{
+					Limit:        10,
+					Offset:       10,
+					Keyword:      `"go"`,
+					SnippetWidth: util.DefaultSearchSnippetWidth,
+					IsPublish:    pgtype.Bool{Bool: true, Valid: true},
+					CategoryID:   pgtype.Int8{Int64: 3, Valid: true},
+					Tag:          pgtype.Text{String: "golang", Valid: true},
+					StartTime:    startTime,
+					EndTime:      endTime,
+					SortBy:       search.SortMostViewed,
+				}
+				store.EXPECT().SearchArticles(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(searchArticlesRows, nil)
+				store.EXPECT().CountSearchArticles(gomock.Any(), gomock.Eq(db.CountSearchArticlesParams{
+					Keyword:    `"go"`,
+					IsPublish:  arg.IsPublish,
+					CategoryID: arg.CategoryID,
+					Tag:        arg.Tag,
+					StartTime:  startTime,
+					EndTime:    endTime,
+				})).
+					Times(1).
+					Return(int64(n), nil)
+				// 分类分面忽略分类筛选，年份分面忽略日期筛选
+				store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Eq(db.ListSearchArticleCategoryFacetsParams{
+					Keyword:   `"go"`,
+					IsPublish: arg.IsPublish,
+					Tag:       arg.Tag,
+					StartTime: startTime,
+					EndTime:   endTime,
+				})).
+					Times(1).
+					Return(categoryFacets, nil)
+				store.EXPECT().ListSearchArticleYearFacets(gomock.Any(), gomock.Eq(db.ListSearchArticleYearFacetsParams{
+					TimeZone:   "UTC",
+					Keyword:    `"go"`,
+					IsPublish:  arg.IsPublish,
+					CategoryID: arg.CategoryID,
+					Tag:        arg.Tag,
+				})).
+					Times(1).
+					Return(yearFacets, nil)
+				// 翻页不重复记录搜索
+				store.EXPECT().CreateSearchQuery(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK_RecordQueryFails",
+			req: searchArticlesRequest{
+				Keyword: "Go",
+				Page:    1,
+				Limit:   10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchArticles(gomock.Any(), gomock.Any()).Times(1).Return(searchArticlesRows, nil)
+				store.EXPECT().CountSearchArticles(gomock.Any(), gomock.Any()).Times(1).Return(int64(n), nil)
+				store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Any()).Times(1).Return(categoryFacets, nil)
+				store.EXPECT().ListSearchArticleYearFacets(gomock.Any(), gomock.Any()).Times(1).Return(yearFacets, nil)
+				store.EXPECT().CreateSearchQuery(gomock.Any(), gomock.Any()).Times(1).Return(db.SearchQuery{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp searchArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Empty(t, resp.Impression)
+			},
+		},
+		{
+			name: "InvalidSort",
+			req: searchArticlesRequest{
+				Keyword: "Go",
+				Page:    1,
+				Limit:   10,
+				Sort:    "random",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidDateRange",
+			req: searchArticlesRequest{
+				Keyword:   "Go",
+				Page:      1,
+				Limit:     10,
+				StartDate: "2024-12-31",
+				EndDate:   "2024-01-01",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
@@ -717,6 +1027,7 @@ func TestSearchArticleAPI(t *testing.T) {
 
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
+			store.EXPECT().CreateSearchQuery(gomock.Any(), gomock.Any()).AnyTimes().Return(db.SearchQuery{ID: 1}, nil)
 
 			testServer := newTestServer(t, store, nil, nil)
 			recorder := httptest.NewRecorder()
@@ -729,6 +1040,19 @@ func TestSearchArticleAPI(t *testing.T) {
 			q.Add("keyword", tc.req.Keyword)
 			q.Add("page", fmt.Sprintf("%d", tc.req.Page))
 			q.Add("limit", fmt.Sprintf("%d", tc.req.Limit))
+			if tc.req.CategoryID > 0 {
+				q.Add("category_id", fmt.Sprintf("%d", tc.req.CategoryID))
+			}
+			for name, value := range map[string]string{
+				"tag":        tc.req.Tag,
+				"start_date": tc.req.StartDate,
+				"end_date":   tc.req.EndDate,
+				"sort":       tc.req.Sort,
+			} {
+				if value != "" {
+					q.Add(name, value)
+				}
+			}
 			request.URL.RawQuery = q.Encode()
 
 			testServer.router.ServeHTTP(recorder, request)
@@ -738,128 +1062,710 @@ func TestSearchArticleAPI(t *testing.T) {
 	}
 }
 
-func TestGetArticleBySlugAPI(t *testing.T) {
-	user, _ := randomUser(t)
-
-	randomArticle := randomArticle(t, user.ID, true)
-
-	getArticleBySlugRow := db.GetArticleBySlugRow{
-		ID:            randomArticle.ID,
-		Title:         randomArticle.Title,
-		Summary:       randomArticle.Summary,
-		Slug:          randomArticle.Slug,
-		CheckOutdated: false,
-		IsPublish:     randomArticle.IsPublish,
-		Owner:         randomArticle.Owner,
-		CategoryID:    randomArticle.CategoryID,
-		Cover:         "/resources/articles/" + randomArticle.ID.String() + "/cover.jpg",
-	}
-
-	unpublishedArticle := getArticleBySlugRow
-	unpublishedArticle.IsPublish = false
-
-	cacheArticle := getArticleBySlugRow
-	slug := getArticleBySlugRow.Slug.String
-	articleSlugKey := key.GetArticleSlugKey(slug)
-
+func TestSuggestArticlesAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
-		slug          string
-		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name: "OK",
-			slug: slug,
-			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
-				cache.EXPECT().
-					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Any()).
-					Times(1).
-					Return(false, redis.Nil)
-
+			name:  "OK",
+			query: "prefix=Red",
+			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					SuggestArticleTitles(gomock.Any(), gomock.Eq(db.SuggestArticleTitlesParams{Prefix: "Red", MaxResults: defaultSuggestLimit})).
 					Times(1).
-					Return(getArticleBySlugRow, nil)
-
-				cache.EXPECT().
-					Set(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(getArticleBySlugRow), durationBetween(cachepkg.ArticleDetailTTL, cachepkg.ArticleDetailTTL+cachepkg.ArticleDetailTTL/10)).
+					Return([]string{"Redis 缓存实践"}, nil)
+				store.EXPECT().
+					SuggestTags(gomock.Any(), gomock.Eq(db.SuggestTagsParams{Prefix: "Red", MaxResults: defaultSuggestLimit})).
 					Times(1).
-					Return(nil)
+					Return([]db.SuggestTagsRow{{Name: "redis", ArticleCount: 2}}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchGetArticleBySlugRow(t, recorder.Body, getArticleBySlugRow)
+
+				var resp suggestArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Equal(t, []string{"Redis 缓存实践"}, resp.Titles)
+				require.Equal(t, []db.SuggestTagsRow{{Name: "redis", ArticleCount: 2}}, resp.Tags)
 			},
 		},
 		{
-			name: "OK_CacheHit",
-			slug: slug,
-			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
-				cache.EXPECT().
-					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Any()).
-					Times(1).
-					DoAndReturn(func(_ context.Context, _ string, dest *db.GetArticleBySlugRow) (bool, error) {
-						*dest = cacheArticle
-						return true, nil
-					})
-
-				store.EXPECT().GetArticleBySlug(gomock.Any(), gomock.Any()).Times(0)
-
-				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name:  "MissingPrefix",
+			query: "limit=5",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SuggestArticleTitles(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchGetArticleBySlugRow(t, recorder.Body, cacheArticle)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name: "BadRequest",
-			slug: "bad",
-			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
-				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-
-				store.EXPECT().GetArticleBySlug(gomock.Any(), gomock.Any()).Times(0)
-
-				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name:  "LimitTooLarge",
+			query: "prefix=go&limit=50",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SuggestArticleTitles(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name: "InternalError",
-			slug: slug,
-			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
-				cache.EXPECT().
-					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
-					Times(1).
-					Return(false, redis.Nil)
-
+			name:  "InternalError",
+			query: "prefix=go",
+			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					SuggestArticleTitles(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.GetArticleBySlugRow{}, sql.ErrConnDone)
-
-				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+					Return(nil, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
-		{
-			name: "NotFound",
-			slug: slug,
-			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
-				cache.EXPECT().
-					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
-					Times(1).
-					Return(false, redis.Nil)
+	}
 
-				store.EXPECT().
-					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
-					Times(1).
-					Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			testServer := newTestServer(t, store, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/articles/suggest?"+tc.query, nil)
+			require.NoError(t, err)
+
+			testServer.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListRelatedArticlesAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomGetArticleRow(t, user.ID, true)
+	article.Title = "Go 并发"
+	article.Summary = "Redis 缓存"
+	unpublishedArticle := article
+	unpublishedArticle.IsPublish = false
+
+	related := []db.ListRelatedArticlesRow{
+		{
+			ID:       uuid.New(),
+			Title:    "Go 调度器",
+			Cover:    "/resources/articles/cover.jpg",
+			Slug:     pgtype.Text{String: "go-scheduler", Valid: true},
+			ReadTime: "3分钟",
+			Score:    12.5,
+		},
+	}
+	versionKey := key.GetArticleListVersionKey(0)
+	relatedKey := key.GetArticleRelatedKey(0, article.ID, defaultRelatedArticleLimit)
+
+	testCases := []struct {
+		name          string
+		articleID     string
+		query         string
+		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(versionKey), gomock.Any()).Times(2).Return(false, nil)
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(relatedKey), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(article.ID)).
+					Times(1).
+					Return(article, nil)
+				store.EXPECT().
+					ListRelatedArticles(gomock.Any(), gomock.Eq(db.ListRelatedArticlesParams{
+						ArticleID:  article.ID,
+						Keyword:    `"go" OR "并发" OR "redis" OR "缓存"`,
+						MaxResults: defaultRelatedArticleLimit,
+					})).
+					Times(1).
+					Return(related, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(relatedKey), gomock.Eq(related), durationBetween(cachepkg.ArticleRelatedTTL, cachepkg.ArticleRelatedTTL+cachepkg.ArticleRelatedTTL/10)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp listRelatedArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Equal(t, related, resp.Articles)
+			},
+		},
+		{
+			name:      "OK_CacheHit",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(versionKey), gomock.Any()).Times(1).Return(false, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(relatedKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, _ string, dest *[]db.ListRelatedArticlesRow) (bool, error) {
+						*dest = related
+						return true, nil
+					})
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListRelatedArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp listRelatedArticlesResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				require.NoError(t, err)
+				require.Equal(t, related, resp.Articles)
+			},
+		},
+		{
+			name:      "OK_CustomLimit",
+			articleID: article.ID.String(),
+			query:     "limit=3",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(article.ID)).Times(1).Return(article, nil)
+				store.EXPECT().
+					ListRelatedArticles(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.ListRelatedArticlesParams) ([]db.ListRelatedArticlesRow, error) {
+						require.Equal(t, int32(3), arg.MaxResults)
+						return []db.ListRelatedArticlesRow{}, nil
+					})
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(key.GetArticleRelatedKey(0, article.ID, 3)), gomock.Any(), durationBetween(cachepkg.EmptyArticleListTTL, cachepkg.EmptyArticleListTTL+cachepkg.EmptyArticleListTTL/10)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `{"articles":[]}`, recorder.Body.String())
+			},
+		},
+		{
+			name:      "InvalidID",
+			articleID: "invalid",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "LimitTooLarge",
+			articleID: article.ID.String(),
+			query:     "limit=50",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(article.ID)).
+					Times(1).
+					Return(db.GetArticleRow{}, db.ErrRecordNotFound)
+				store.EXPECT().ListRelatedArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "Unpublished",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(article.ID)).
+					Times(1).
+					Return(unpublishedArticle, nil)
+				store.EXPECT().ListRelatedArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			articleID: article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(article.ID)).Times(1).Return(article, nil)
+				store.EXPECT().
+					ListRelatedArticles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			redisCache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, redisCache)
+
+			testServer := newTestServer(t, store, nil, redisCache)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/articles/%s/related?%s", tc.articleID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			testServer.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRecordSearchClickAPI(t *testing.T) {
+	articleID := uuid.New()
+	onceKey := key.GetSearchClickOnceKey(42, articleID)
+
+	testCases := []struct {
+		name          string
+		body          func(secret string) gin.H
+		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(42, time.Now(), secret), "article_id": articleID.String(), "position": 3}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), 0, 2*cachepkg.SearchClickRateWindow).Return(true, nil)
+				cache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				cache.EXPECT().SetNX(gomock.Any(), onceKey, 1, cachepkg.SearchClickIdempotencyTTL).Return(true, nil)
+				store.EXPECT().
+					CreateSearchClick(gomock.Any(), gomock.Eq(db.CreateSearchClickParams{
+						SearchQueryID: 42,
+						ArticleID:     articleID,
+						Position:      3,
+					})).
+					Times(1).
+					Return(db.SearchClick{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateClick",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(42, time.Now(), secret), "article_id": articleID.String(), "position": 3}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), 0, gomock.Any()).Return(false, nil)
+				cache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+				cache.EXPECT().SetNX(gomock.Any(), onceKey, 1, gomock.Any()).Return(false, nil)
+				store.EXPECT().CreateSearchClick(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "RateLimited",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(42, time.Now(), secret), "article_id": articleID.String(), "position": 3}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), 0, gomock.Any()).Return(false, nil)
+				cache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(cachepkg.SearchClickRateLimit+1), nil)
+				store.EXPECT().CreateSearchClick(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "ForgedImpression",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(42, time.Now(), "forged-secret"), "article_id": articleID.String(), "position": 3}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().CreateSearchClick(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ExpiredImpression",
+			body: func(secret string) gin.H {
+				issuedAt := time.Now().Add(-search.ImpressionTTL - time.Minute)
+				return gin.H{"impression": search.SignImpression(42, issuedAt, secret), "article_id": articleID.String(), "position": 3}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().CreateSearchClick(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownSearch",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(404, time.Now(), secret), "article_id": articleID.String(), "position": 1}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(true, nil)
+				cache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				store.EXPECT().
+					CreateSearchClick(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SearchClick{}, db.ErrForeignKeyViolation)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPosition",
+			body: func(secret string) gin.H {
+				return gin.H{"impression": search.SignImpression(42, time.Now(), secret), "article_id": articleID.String(), "position": 0}
+			},
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().CreateSearchClick(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			redisCache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, redisCache)
+
+			testServer := newTestServer(t, store, nil, redisCache)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body(testServer.config.TokenSymmetricKey))
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/articles/search/clicks", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			testServer.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestIncrementArticleCountersAPI(t *testing.T) {
+	articleID := uuid.New()
+	viewsField := key.GetArticleCounterField(cachepkg.ArticleCounterViews, articleID)
+	likesField := key.GetArticleCounterField(cachepkg.ArticleCounterLikes, articleID)
+
+	testCases := []struct {
+		name          string
+		path          string
+		withCache     bool
+		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "ViewsBuffered",
+			path:      "/api/articles/increment_views",
+			withCache: true,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				cache.EXPECT().
+					HIncrBy(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Eq(viewsField), gomock.Eq(int64(1))).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().IncrementArticleViews(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "LikesBuffered",
+			path:      "/api/articles/increment_likes",
+			withCache: true,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				cache.EXPECT().
+					HIncrBy(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Eq(likesField), gomock.Eq(int64(1))).
+					Times(1).
+					Return(int64(3), nil)
+				store.EXPECT().IncrementArticleLikes(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "CacheErrorFallsBackToDB",
+			path:      "/api/articles/increment_views",
+			withCache: true,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				cache.EXPECT().HIncrBy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+				store.EXPECT().IncrementArticleViews(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(nil)
+				// 已写回的计数不包含这次直接写入数据库的更新
+				cache.EXPECT().Del(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(articleID))).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NoCacheWritesDB",
+			path: "/api/articles/increment_likes",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				store.EXPECT().IncrementArticleLikes(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "Duplicate",
+			path:      "/api/articles/increment_views",
+			withCache: true,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				cache.EXPECT().HIncrBy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().IncrementArticleViews(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			cache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, cache)
+
+			var testServer *Server
+			if tc.withCache {
+				testServer = newTestServer(t, store, nil, cache)
+			} else {
+				testServer = newTestServer(t, store, nil, nil)
+			}
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": articleID.String()})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, tc.path, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			testServer.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetArticleBySlugAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	randomArticle := randomArticle(t, user.ID, true)
+
+	getArticleBySlugRow := db.GetArticleBySlugRow{
+		ID:            randomArticle.ID,
+		Title:         randomArticle.Title,
+		Summary:       randomArticle.Summary,
+		Slug:          randomArticle.Slug,
+		CheckOutdated: false,
+		IsPublish:     randomArticle.IsPublish,
+		Owner:         randomArticle.Owner,
+		CategoryID:    randomArticle.CategoryID,
+		Cover:         "/resources/articles/" + randomArticle.ID.String() + "/cover.jpg",
+	}
+
+	unpublishedArticle := getArticleBySlugRow
+	unpublishedArticle.IsPublish = false
+
+	cacheArticle := getArticleBySlugRow
+	pendingArticle := cacheArticle
+	pendingArticle.Views += 3
+	slug := getArticleBySlugRow.Slug.String
+	articleSlugKey := key.GetArticleSlugKey(slug)
+
+	testCases := []struct {
+		name          string
+		slug          string
+		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Any()).
+					Times(1).
+					Return(false, redis.Nil)
+
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					Times(1).
+					Return(getArticleBySlugRow, nil)
+
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(getArticleBySlugRow), durationBetween(cachepkg.ArticleDetailTTL, cachepkg.ArticleDetailTTL+cachepkg.ArticleDetailTTL/10)).
+					Times(1).
+					Return(nil)
+
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(getArticleBySlugRow.ID)), gomock.Any()).
+					Times(1).
+					Return(false, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGetArticleBySlugRow(t, recorder.Body, getArticleBySlugRow)
+			},
+		},
+		{
+			name: "OK_CacheHit",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *db.GetArticleBySlugRow) (bool, error) {
+						*dest = cacheArticle
+						return true, nil
+					})
+
+				store.EXPECT().GetArticleBySlug(gomock.Any(), gomock.Any()).Times(0)
+
+				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(getArticleBySlugRow.ID)), gomock.Any()).
+					Times(1).
+					Return(false, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"3", ""}, nil)
+
+				cache.EXPECT().
+					HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"", ""}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGetArticleBySlugRow(t, recorder.Body, pendingArticle)
+			},
+		},
+		{
+			name: "BadRequest",
+			slug: "bad",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				store.EXPECT().GetArticleBySlug(gomock.Any(), gomock.Any()).Times(0)
+
+				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
+					Times(1).
+					Return(false, redis.Nil)
+
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					Times(1).
+					Return(db.GetArticleBySlugRow{}, sql.ErrConnDone)
+
+				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
+					Times(1).
+					Return(false, redis.Nil)
+
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					Times(1).
+					Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/article/"+slug)).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetArticleSlugRedirect(gomock.Any(), gomock.Eq(slug)).
+					Times(1).
+					Return(db.GetArticleSlugRedirectRow{}, db.ErrRecordNotFound)
 
 				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -867,6 +1773,71 @@ func TestGetArticleBySlugAPI(t *testing.T) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
+		{
+			name: "RedirectOldSlug",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
+					Times(1).
+					Return(false, redis.Nil)
+
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(getArticleBySlugRow.Slug)).
+					Times(1).
+					Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/article/"+slug)).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetArticleSlugRedirect(gomock.Any(), gomock.Eq(slug)).
+					Times(1).
+					Return(db.GetArticleSlugRedirectRow{
+						ID:   randomArticle.ID,
+						Slug: pgtype.Text{String: "renamed-slug", Valid: true},
+					}, nil)
+
+				cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp redirectResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				require.Equal(t, redirectTarget{
+					Location:   "/article/renamed-slug",
+					StatusCode: http.StatusMovedPermanently,
+				}, resp.Redirect)
+			},
+		},
+		{
+			name: "CustomRedirect",
+			slug: slug,
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(articleSlugKey), gomock.Eq(&db.GetArticleBySlugRow{})).
+					Times(1).
+					Return(false, redis.Nil)
+
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/article/"+slug)).
+					Times(1).
+					Return(db.Redirect{SourcePath: "/article/" + slug, TargetPath: "/category/7", StatusCode: 302}, nil)
+				store.EXPECT().GetArticleSlugRedirect(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp redirectResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				require.Equal(t, redirectTarget{Location: "/category/7", StatusCode: http.StatusFound}, resp.Redirect)
+			},
+		},
 		{
 			name: "Forbidden",
 			slug: slug,
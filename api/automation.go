@@ -12,6 +12,7 @@ import (
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	automationauth "github.com/MonitorAllen/nostalgia/internal/automation"
+	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/MonitorAllen/nostalgia/worker"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -31,7 +32,7 @@ const (
 	automationRequestStatusFailedCreate     = "failed_create"
 )
 
-var automationSlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+var automationSlugPattern = util.SlugPattern
 
 type createAutomationArticleDraftRequest struct {
 	Title           string `json:"title" binding:"required"`
@@ -5,7 +5,10 @@ import (
 	"net/http"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
 )
 
 type listCategoriesResponse struct {
@@ -25,6 +28,14 @@ func (server *Server) listCategories(ctx *gin.Context) {
 		return
 	}
 
+	if categories, ok := server.loadCachedCategories(ctx); ok {
+		ctx.JSON(http.StatusOK, listCategoriesResponse{
+			Categories: paginateCategories(categories, req.Page, req.Limit),
+			Count:      int64(len(categories)),
+		})
+		return
+	}
+
 	arg := db.ListCategoriesCountArticlesParams{
 		Limit:  req.Limit,
 		Offset: (req.Page - 1) * req.Limit,
@@ -49,6 +60,94 @@ func (server *Server) listCategories(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+// loadCachedCategories 返回完整的分类列表，未配置缓存或分类过多时返回 false，由调用方分页查询数据库
+func (server *Server) loadCachedCategories(ctx *gin.Context) ([]db.ListCategoriesCountArticlesRow, bool) {
+	if server.cache == nil {
+		return nil, false
+	}
+	categoryCache := cachepkg.NewCategoryCache(server.cache)
+
+	categories, ok := server.cachedCategories(ctx)
+	if ok {
+		return categories, true
+	}
+
+	value, err, _ := server.cacheLoadGroup.Do(key.CategoryAllKey, func() (any, error) {
+		categories, err := server.store.ListCategoriesCountArticles(ctx, db.ListCategoriesCountArticlesParams{
+			Limit: cachepkg.MaxCachedCategories,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if int32(len(categories)) >= cachepkg.MaxCachedCategories {
+			return nil, nil
+		}
+
+		if err := categoryCache.SetList(ctx, categories); err != nil {
+			log.Error().
+				Err(err).
+				Str("key", key.CategoryAllKey).
+				Str("module", "category").
+				Str("action", "cache_set").
+				Msg("设置分类列表缓存失败")
+		}
+		return categories, nil
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	categories, ok = value.([]db.ListCategoriesCountArticlesRow)
+	return categories, ok
+}
+
+// cachedListCategoriesResponse 分类列表的 HTTP 缓存校验器
+func (server *Server) cachedListCategoriesResponse(ctx *gin.Context) (any, bool) {
+	var req listCategoriesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		return nil, false
+	}
+
+	categories, ok := server.cachedCategories(ctx)
+	if !ok {
+		return nil, false
+	}
+	return listCategoriesResponse{
+		Categories: paginateCategories(categories, req.Page, req.Limit),
+		Count:      int64(len(categories)),
+	}, true
+}
+
+// cachedCategories 只读取缓存中的完整分类列表
+func (server *Server) cachedCategories(ctx *gin.Context) ([]db.ListCategoriesCountArticlesRow, bool) {
+	return memoizeRequest(ctx, key.CategoryAllKey, func() ([]db.ListCategoriesCountArticlesRow, bool) {
+		if server.cache == nil {
+			return nil, false
+		}
+
+		var categories []db.ListCategoriesCountArticlesRow
+		ok, err := cachepkg.NewCategoryCache(server.cache).GetList(ctx, &categories)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("key", key.CategoryAllKey).
+				Str("module", "category").
+				Str("action", "cache_get").
+				Msg("获取分类列表缓存失败，降级为仅数据库")
+		}
+		return categories, ok
+	})
+}
+
+func paginateCategories(categories []db.ListCategoriesCountArticlesRow, page int32, limit int32) []db.ListCategoriesCountArticlesRow {
+	start := int((page - 1) * limit)
+	if start >= len(categories) {
+		return []db.ListCategoriesCountArticlesRow{}
+	}
+	end := min(start+int(limit), len(categories))
+	return categories[start:end]
+}
+
 type getCategoryRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
@@ -74,6 +173,7 @@ func (server *Server) getCategory(ctx *gin.Context) {
 		return
 	}
 
+	addSurrogateKeys(ctx, categorySurrogateKey(category.ID))
 	resp := getCategoryResponse{category}
 	ctx.JSON(http.StatusOK, resp)
 }
@@ -9,6 +9,9 @@ import (
 
 	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +48,75 @@ func TestListCategoriesAPIPaginates(t *testing.T) {
 	require.Equal(t, "Go", body.Categories[0].Name)
 }
 
+func TestListCategoriesAPIUsesCachedList(t *testing.T) {
+	categories := []db.ListCategoriesCountArticlesRow{
+		{ID: 1, Name: "Go", ArticleCount: 3},
+		{ID: 2, Name: "Redis", ArticleCount: 2},
+		{ID: 3, Name: "PostgreSQL", ArticleCount: 1},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore, cache *mockcache.MockCache)
+	}{
+		{
+			name: "CacheHit",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.CategoryAllKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *[]db.ListCategoriesCountArticlesRow) (bool, error) {
+						*dest = categories
+						return true, nil
+					})
+				store.EXPECT().ListCategoriesCountArticles(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountCategories(gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "CacheMissStoresFullList",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(key.CategoryAllKey), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().
+					ListCategoriesCountArticles(gomock.Any(), gomock.Eq(db.ListCategoriesCountArticlesParams{Limit: cachepkg.MaxCachedCategories})).
+					Times(1).
+					Return(categories, nil)
+				store.EXPECT().CountCategories(gomock.Any()).Times(0)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(key.CategoryAllKey), gomock.Eq(categories), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			cache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, cache)
+
+			server := newTestServer(t, store, nil, cache)
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/api/categories?page=2&limit=2", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var body listCategoriesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			require.Equal(t, int64(3), body.Count)
+			require.Len(t, body.Categories, 1)
+			require.Equal(t, "PostgreSQL", body.Categories[0].Name)
+		})
+	}
+}
+
 func TestListCategoriesAPIRejectsInvalidPagination(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	t.Cleanup(ctrl.Finish)
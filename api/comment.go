@@ -5,9 +5,14 @@ import (
 	"errors"
 	"fmt"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
 	"github.com/MonitorAllen/nostalgia/token"
+	"github.com/MonitorAllen/nostalgia/worker"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
 	"net/http"
 	"time"
 )
@@ -44,12 +49,27 @@ func (server *Server) createComment(ctx *gin.Context) {
 		return
 	}
 
+	// 先用廉价规则筛查，命中则直接进入待审核状态
+	verdict := moderation.ScreenComment(req.Content, moderation.Rules{
+		MaxLinks:  server.config.CommentMaxLinks,
+		Blocklist: server.config.CommentBlocklist,
+	})
+
+	// 需要 AI 审核的评论先不公开，由审核任务决定发布或拦截
+	screening := !verdict.Held && server.commentAIScreeningEnabled()
+	status := verdict.Status()
+	if screening {
+		status = moderation.StatusPending
+	}
+
 	arg := db.CreateCommentParams{
-		Content:    req.Content,
-		ArticleID:  req.ArticleID,
-		ParentID:   req.ParentID,
-		FromUserID: authPayload.UserID,
-		ToUserID:   req.ToUserID,
+		Content:          req.Content,
+		ArticleID:        req.ArticleID,
+		ParentID:         req.ParentID,
+		FromUserID:       authPayload.UserID,
+		ToUserID:         req.ToUserID,
+		Status:           status,
+		ModerationReason: verdict.Reason,
 	}
 
 	comment, err := server.store.CreateComment(ctx, arg)
@@ -58,6 +78,13 @@ func (server *Server) createComment(ctx *gin.Context) {
 		return
 	}
 
+	if screening {
+		comment = server.distributeCommentScreening(ctx, comment)
+	}
+	if comment.Status == moderation.StatusPublished {
+		server.invalidateCommentCache(ctx, comment.ArticleID)
+	}
+
 	toUser, err := server.store.GetUser(ctx, comment.ToUserID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -74,6 +101,7 @@ func (server *Server) createComment(ctx *gin.Context) {
 		ToUserID:     comment.ToUserID,
 		CreatedAt:    comment.CreatedAt,
 		DeletedAt:    comment.DeletedAt,
+		Status:       comment.Status,
 		FromUserName: authPayload.Username,
 		ToUserName:   toUser.Username,
 		Child:        []*Comment{},
@@ -82,8 +110,46 @@ func (server *Server) createComment(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+func (server *Server) commentAIScreeningEnabled() bool {
+	return server.taskDistributor != nil && server.config.CommentAIScreeningEnabled
+}
+
+// distributeCommentScreening 异步提交 AI 审核，提交失败时转为人工审核，避免评论一直处于待审核状态
+func (server *Server) distributeCommentScreening(ctx *gin.Context, comment db.Comment) db.Comment {
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(time.Minute),
+		asynq.Queue(worker.QueueDefault),
+	}
+	err := server.taskDistributor.DistributeTaskScreenComment(ctx, &worker.PayloadScreenComment{CommentID: comment.ID}, opts...)
+	if err == nil {
+		return comment
+	}
+	log.Error().Err(err).Int64("comment_id", comment.ID).Msg("failed to distribute comment screening task")
+
+	held, err := server.store.ResolvePendingComment(ctx, db.ResolvePendingCommentParams{
+		ID:               comment.ID,
+		Status:           moderation.StatusHeld,
+		ModerationReason: moderation.ReasonScreeningUnavailable,
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("comment_id", comment.ID).Msg("failed to hold comment")
+		return comment
+	}
+	return held
+}
+
+const (
+	defaultCommentPageLimit  int32 = 10
+	defaultCommentReplyLimit int32 = 10
+	// commentReplyPreviewLimit 每条顶层评论随分页返回的回复数量，其余回复按需加载
+	commentReplyPreviewLimit int32 = 3
+)
+
 type listCommentsByArticleIDRequest struct {
 	ArticleID string `uri:"article_id" binding:"required,uuid"`
+	Cursor    int64  `form:"cursor" binding:"omitempty,min=0"`
+	Limit     int32  `form:"limit" binding:"omitempty,min=1,max=50"`
 }
 
 type Comment struct {
@@ -96,13 +162,24 @@ type Comment struct {
 	ToUserID     uuid.UUID  `json:"to_user_id"`
 	CreatedAt    time.Time  `json:"created_at"`
 	DeletedAt    time.Time  `json:"deleted_at"`
+	Status       string     `json:"status"`
 	FromUserName string     `json:"from_user_name"`
 	ToUserName   string     `json:"to_user_name"`
+	ReplyCount   int64      `json:"reply_count"`
 	Child        []*Comment `json:"child"`
 }
 
+// commentPage 顶层评论的一页，next_cursor 为本页最后一条评论的 id
+type commentPage struct {
+	Comments   []*Comment `json:"comments"`
+	NextCursor int64      `json:"next_cursor"`
+	HasMore    bool       `json:"has_more"`
+}
+
 type listCommentsByArticleIDResponse struct {
-	Comments []*Comment `json:"comments"`
+	commentPage
+	Total         int64 `json:"total"`
+	TopLevelTotal int64 `json:"top_level_total"`
 }
 
 func (server *Server) listCommentsByArticleID(ctx *gin.Context) {
@@ -111,6 +188,13 @@ func (server *Server) listCommentsByArticleID(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultCommentPageLimit
+	}
 
 	articleID, err := uuid.Parse(req.ArticleID)
 	if err != nil {
@@ -118,29 +202,71 @@ func (server *Server) listCommentsByArticleID(ctx *gin.Context) {
 		return
 	}
 
-	comments, err := server.store.ListCommentsByArticleID(ctx, articleID)
+	thread := server.commentThread(ctx, articleID)
+
+	var page commentPage
+	ok, err := thread.GetPage(ctx, req.Cursor, req.Limit, &page)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		logCommentCacheError(err, "cache_get", articleID, "获取评论分页缓存失败，降级为仅数据库")
+	}
+	if !ok {
+		page, err = server.loadCommentPage(ctx, articleID, req.Cursor, req.Limit)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if err := thread.SetPage(ctx, req.Cursor, req.Limit, page); err != nil {
+			logCommentCacheError(err, "cache_set", articleID, "设置评论分页缓存失败")
+		}
 	}
 
-	commentTree := buildCommentTree(comments)
+	var count db.CountArticleCommentsRow
+	ok, err = thread.GetCount(ctx, &count)
+	if err != nil {
+		logCommentCacheError(err, "cache_get", articleID, "获取评论总数缓存失败，降级为仅数据库")
+	}
+	if !ok {
+		count, err = server.store.CountArticleComments(ctx, articleID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if err := thread.SetCount(ctx, count); err != nil {
+			logCommentCacheError(err, "cache_set", articleID, "设置评论总数缓存失败")
+		}
+	}
 
-	ctx.JSON(http.StatusOK, listCommentsByArticleIDResponse{Comments: commentTree})
+	ctx.JSON(http.StatusOK, listCommentsByArticleIDResponse{
+		commentPage:   page,
+		Total:         count.Total,
+		TopLevelTotal: count.TopLevel,
+	})
 }
 
-func buildCommentTree(rows []db.ListCommentsByArticleIDRow) []*Comment {
-	if len(rows) == 0 {
-		return nil
+// loadCommentPage 多取一条判断是否还有下一页，并批量预取每条顶层评论的前几条回复
+func (server *Server) loadCommentPage(ctx *gin.Context, articleID uuid.UUID, cursor int64, limit int32) (commentPage, error) {
+	rows, err := server.store.ListTopLevelComments(ctx, db.ListTopLevelCommentsParams{
+		ArticleID:  articleID,
+		AfterID:    cursor,
+		MaxResults: limit + 1,
+	})
+	if err != nil {
+		return commentPage{}, err
 	}
 
-	// 用来存储 id -> Comment 的映射
-	commentMap := make(map[int64]*Comment)
+	page := commentPage{Comments: make([]*Comment, 0, len(rows))}
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		page.HasMore = true
+	}
+	if len(rows) == 0 {
+		return page, nil
+	}
 
-	// 初始化映射
-	// 转换原始行数据为 Comment 结构
+	roots := make(map[int64]*Comment, len(rows))
+	parentIDs := make([]int64, 0, len(rows))
 	for _, row := range rows {
-		commentMap[row.ID] = &Comment{
+		comment := newComment(db.ListCommentRepliesRow{
 			ID:           row.ID,
 			Content:      row.Content,
 			ArticleID:    row.ArticleID,
@@ -150,27 +276,150 @@ func buildCommentTree(rows []db.ListCommentsByArticleIDRow) []*Comment {
 			ToUserID:     row.ToUserID,
 			CreatedAt:    row.CreatedAt,
 			DeletedAt:    row.DeletedAt,
-			FromUserName: row.FromUserName.String,
-			ToUserName:   row.ToUserName.String,
-			Child:        []*Comment{},
-		}
+			Status:       row.Status,
+			FromUserName: row.FromUserName,
+			ToUserName:   row.ToUserName,
+		})
+		comment.ReplyCount = row.ReplyCount
+		roots[row.ID] = comment
+		parentIDs = append(parentIDs, row.ID)
+		page.Comments = append(page.Comments, comment)
+	}
+	if page.HasMore {
+		page.NextCursor = rows[len(rows)-1].ID
 	}
 
-	// 2. 构造树形结构
-	var rootComments []*Comment
-	for _, comment := range rows {
-		if comment.ParentID == 0 {
-			// 根评论
-			rootComments = append(rootComments, commentMap[comment.ID])
-		} else {
-			if parent, exists := commentMap[comment.ParentID]; exists {
-				// 如果父评论存在，将当前评论添加到父评论的 Child 列表中
-				parent.Child = append(parent.Child, commentMap[comment.ID])
-			}
+	previews, err := server.store.ListCommentReplyPreviews(ctx, db.ListCommentReplyPreviewsParams{
+		ParentIds:    parentIDs,
+		MaxPerParent: commentReplyPreviewLimit,
+	})
+	if err != nil {
+		return commentPage{}, err
+	}
+	for _, row := range previews {
+		if parent, exists := roots[row.ParentID]; exists {
+			parent.Child = append(parent.Child, newComment(db.ListCommentRepliesRow(row)))
 		}
 	}
 
-	return rootComments
+	return page, nil
+}
+
+type listCommentRepliesRequest struct {
+	ArticleID string `uri:"article_id" binding:"required,uuid"`
+	ParentID  int64  `uri:"parent_id" binding:"required,min=1"`
+	Cursor    int64  `form:"cursor" binding:"omitempty,min=0"`
+	Limit     int32  `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+type listCommentRepliesResponse struct {
+	Replies    []*Comment `json:"replies"`
+	NextCursor int64      `json:"next_cursor"`
+	HasMore    bool       `json:"has_more"`
+}
+
+func (server *Server) listCommentReplies(ctx *gin.Context) {
+	var req listCommentRepliesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultCommentReplyLimit
+	}
+
+	articleID, err := uuid.Parse(req.ArticleID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	thread := server.commentThread(ctx, articleID)
+
+	var resp listCommentRepliesResponse
+	ok, err := thread.GetReplies(ctx, req.ParentID, req.Cursor, req.Limit, &resp)
+	if err != nil {
+		logCommentCacheError(err, "cache_get", articleID, "获取评论回复缓存失败，降级为仅数据库")
+	}
+	if ok {
+		ctx.JSON(http.StatusOK, resp)
+		return
+	}
+
+	rows, err := server.store.ListCommentReplies(ctx, db.ListCommentRepliesParams{
+		ArticleID:  articleID,
+		ParentID:   req.ParentID,
+		AfterID:    req.Cursor,
+		MaxResults: req.Limit + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	resp.Replies = make([]*Comment, 0, len(rows))
+	if len(rows) > int(req.Limit) {
+		rows = rows[:req.Limit]
+		resp.HasMore = true
+		resp.NextCursor = rows[len(rows)-1].ID
+	}
+	for _, row := range rows {
+		resp.Replies = append(resp.Replies, newComment(row))
+	}
+
+	if err := thread.SetReplies(ctx, req.ParentID, req.Cursor, req.Limit, resp); err != nil {
+		logCommentCacheError(err, "cache_set", articleID, "设置评论回复缓存失败")
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func newComment(row db.ListCommentRepliesRow) *Comment {
+	return &Comment{
+		ID:           row.ID,
+		Content:      row.Content,
+		ArticleID:    row.ArticleID,
+		ParentID:     row.ParentID,
+		Likes:        row.Likes,
+		FromUserID:   row.FromUserID,
+		ToUserID:     row.ToUserID,
+		CreatedAt:    row.CreatedAt,
+		DeletedAt:    row.DeletedAt,
+		Status:       row.Status,
+		FromUserName: row.FromUserName.String,
+		ToUserName:   row.ToUserName.String,
+		Child:        []*Comment{},
+	}
+}
+
+// commentThread 读取版本号失败时返回 nil，本次请求不使用缓存
+func (server *Server) commentThread(ctx *gin.Context, articleID uuid.UUID) *cachepkg.CommentThread {
+	thread, err := cachepkg.NewCommentCache(server.cache).Thread(ctx, articleID)
+	if err != nil {
+		logCommentCacheError(err, "cache_version", articleID, "获取评论缓存版本失败，降级为仅数据库")
+		return nil
+	}
+	return thread
+}
+
+// invalidateCommentCache 失效失败只记录日志，缓存最迟在过期后恢复
+func (server *Server) invalidateCommentCache(ctx *gin.Context, articleID uuid.UUID) {
+	if err := cachepkg.NewCommentCache(server.cache).Invalidate(ctx, articleID); err != nil {
+		logCommentCacheError(err, "cache_invalidate", articleID, "失效评论缓存失败")
+	}
+}
+
+func logCommentCacheError(err error, action string, articleID uuid.UUID, msg string) {
+	log.Error().
+		Err(err).
+		Str("module", "comment").
+		Str("action", action).
+		Str("article_id", articleID.String()).
+		Msg(msg)
 }
 
 type deleteCommentRequest struct {
@@ -213,5 +462,7 @@ func (server *Server) deleteComment(ctx *gin.Context) {
 		return
 	}
 
+	server.invalidateCommentCache(ctx, comment.ArticleID)
+
 	ctx.JSON(http.StatusOK, nil)
 }
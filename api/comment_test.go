@@ -2,13 +2,22 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
 	"github.com/MonitorAllen/nostalgia/token"
+	"github.com/MonitorAllen/nostalgia/worker"
+	mockwk "github.com/MonitorAllen/nostalgia/worker/mock"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"io"
 	"net/http"
@@ -32,6 +41,7 @@ func TestCreateCommentAPI(t *testing.T) {
 		Likes:      0,
 		FromUserID: sendCommentUser.ID,
 		ToUserID:   article.Owner,
+		Status:     moderation.StatusPublished,
 	}
 
 	testCases := []struct {
@@ -60,6 +70,7 @@ func TestCreateCommentAPI(t *testing.T) {
 					ParentID:   comment.ParentID,
 					FromUserID: comment.FromUserID,
 					ToUserID:   comment.ToUserID,
+					Status:     moderation.StatusPublished,
 				}
 
 				store.EXPECT().
@@ -76,6 +87,43 @@ func TestCreateCommentAPI(t *testing.T) {
 				requireBodyMatchComment(t, recorder.Body, comment)
 			},
 		},
+		{
+			name: "HeldByHeuristics",
+			body: gin.H{
+				"content":      "加我 https://spam.example.com",
+				"article_id":   comment.ArticleID,
+				"parent_id":    comment.ParentID,
+				"from_user_id": comment.FromUserID,
+				"to_user_id":   comment.ToUserID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, sendCommentUser.ID, sendCommentUser.Username, sendCommentUser.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateCommentParams) (db.Comment, error) {
+						require.Equal(t, moderation.StatusHeld, arg.Status)
+						require.Contains(t, arg.ModerationReason, "links")
+						held := comment
+						held.Content = arg.Content
+						held.Status = arg.Status
+						held.ModerationReason = arg.ModerationReason
+						return held, nil
+					})
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(comment.ToUserID)).
+					Times(1).
+					Return(user, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				var got createCommentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, moderation.StatusHeld, got.Comment.Status)
+			},
+		},
 		{
 			name: "BadRequest",
 			body: gin.H{
@@ -171,6 +219,295 @@ func TestCreateCommentAPI(t *testing.T) {
 	}
 }
 
+func TestCreateCommentDistributesScreening(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomArticle(t, user.ID, true)
+	sendCommentUser, _ := randomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+
+	// 等待 AI 审核的评论不公开，也不需要失效评论缓存
+	store.EXPECT().
+		CreateComment(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.CreateCommentParams) (db.Comment, error) {
+			require.Equal(t, moderation.StatusPending, arg.Status)
+			return db.Comment{ID: 42, Content: arg.Content, ArticleID: arg.ArticleID, ToUserID: arg.ToUserID, Status: arg.Status}, nil
+		})
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(user, nil)
+	taskDistributor.EXPECT().
+		DistributeTaskScreenComment(gomock.Any(), gomock.Eq(&worker.PayloadScreenComment{CommentID: 42}), gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	server := newTestServer(t, store, taskDistributor, nil)
+	server.config.CommentAIScreeningEnabled = true
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, newCreateCommentRequest(t, server, article.ID, sendCommentUser, user.ID))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var got createCommentResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+	require.Equal(t, moderation.StatusPending, got.Comment.Status)
+}
+
+func TestCreateCommentHoldsWhenScreeningUnavailable(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomArticle(t, user.ID, true)
+	sendCommentUser, _ := randomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+
+	pending := db.Comment{ID: 43, Content: "感谢分享", ArticleID: article.ID, ToUserID: user.ID, Status: moderation.StatusPending}
+	store.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+	taskDistributor.EXPECT().
+		DistributeTaskScreenComment(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(errors.New("redis unavailable"))
+	held := pending
+	held.Status = moderation.StatusHeld
+	store.EXPECT().
+		ResolvePendingComment(gomock.Any(), db.ResolvePendingCommentParams{
+			ID:               pending.ID,
+			Status:           moderation.StatusHeld,
+			ModerationReason: moderation.ReasonScreeningUnavailable,
+		}).
+		Times(1).
+		Return(held, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(user, nil)
+
+	server := newTestServer(t, store, taskDistributor, nil)
+	server.config.CommentAIScreeningEnabled = true
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, newCreateCommentRequest(t, server, article.ID, sendCommentUser, user.ID))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var got createCommentResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+	require.Equal(t, moderation.StatusHeld, got.Comment.Status)
+}
+
+func newCreateCommentRequest(t *testing.T, server *Server, articleID uuid.UUID, from db.User, toUserID uuid.UUID) *http.Request {
+	data, err := json.Marshal(gin.H{
+		"content":      "感谢分享",
+		"article_id":   articleID,
+		"from_user_id": from.ID,
+		"to_user_id":   toUserID,
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/comments", bytes.NewReader(data))
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, from.ID, from.Username, from.Role, time.Minute)
+	return request
+}
+
+func TestListCommentsByArticleIDAPI(t *testing.T) {
+	articleID := uuid.New()
+	roots := []db.ListTopLevelCommentsRow{
+		{ID: 1, ArticleID: articleID, Status: moderation.StatusPublished, FromUserName: pgtype.Text{String: "alice", Valid: true}, ReplyCount: 4},
+		{ID: 5, ArticleID: articleID, Status: moderation.StatusPublished},
+		{ID: 9, ArticleID: articleID, Status: moderation.StatusPublished},
+	}
+	previews := []db.ListCommentReplyPreviewsRow{
+		{ID: 2, ArticleID: articleID, ParentID: 1},
+		{ID: 3, ArticleID: articleID, ParentID: 1},
+	}
+	count := db.CountArticleCommentsRow{Total: 7, TopLevel: 3}
+	pageKey := key.GetArticleCommentPageKey(articleID, 0, 0, 2)
+	countKey := key.GetArticleCommentCountKey(articleID, 0)
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "CacheMiss",
+			query: "?limit=2",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(key.GetArticleCommentVersionKey(articleID)), gomock.Any()).Times(1).Return(false, nil)
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(pageKey), gomock.Any()).Times(1).Return(false, nil)
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(countKey), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().
+					ListTopLevelComments(gomock.Any(), gomock.Eq(db.ListTopLevelCommentsParams{ArticleID: articleID, MaxResults: 3})).
+					Times(1).
+					Return(roots, nil)
+				store.EXPECT().
+					ListCommentReplyPreviews(gomock.Any(), gomock.Eq(db.ListCommentReplyPreviewsParams{
+						ParentIds:    []int64{1, 5},
+						MaxPerParent: commentReplyPreviewLimit,
+					})).
+					Times(1).
+					Return(previews, nil)
+				store.EXPECT().CountArticleComments(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(count, nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(pageKey), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(countKey), gomock.Eq(count), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				var got listCommentsByArticleIDResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got.Comments, 2)
+				require.True(t, got.HasMore)
+				require.Equal(t, int64(5), got.NextCursor)
+				require.Equal(t, "alice", got.Comments[0].FromUserName)
+				require.Equal(t, int64(4), got.Comments[0].ReplyCount)
+				require.Len(t, got.Comments[0].Child, 2)
+				require.Empty(t, got.Comments[1].Child)
+				require.Equal(t, int64(7), got.Total)
+				require.Equal(t, int64(3), got.TopLevelTotal)
+			},
+		},
+		{
+			name:  "CacheHit",
+			query: "?limit=2",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Eq(key.GetArticleCommentVersionKey(articleID)), gomock.Any()).Times(1).Return(false, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(pageKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *commentPage) (bool, error) {
+						*dest = commentPage{Comments: []*Comment{{ID: 1, Child: []*Comment{}}}}
+						return true, nil
+					})
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(countKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest *db.CountArticleCommentsRow) (bool, error) {
+						*dest = count
+						return true, nil
+					})
+				store.EXPECT().ListTopLevelComments(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountArticleComments(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				var got listCommentsByArticleIDResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got.Comments, 1)
+				require.False(t, got.HasMore)
+				require.Equal(t, int64(7), got.Total)
+			},
+		},
+		{
+			name:  "InvalidLimit",
+			query: "?limit=100",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListTopLevelComments(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			cache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, cache)
+
+			server := newTestServer(t, store, nil, cache)
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/api/comments/"+articleID.String()+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListCommentRepliesAPI(t *testing.T) {
+	articleID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListCommentReplies(gomock.Any(), gomock.Eq(db.ListCommentRepliesParams{
+			ArticleID:  articleID,
+			ParentID:   1,
+			AfterID:    3,
+			MaxResults: 3,
+		})).
+		Times(1).
+		Return([]db.ListCommentRepliesRow{
+			{ID: 4, ArticleID: articleID, ParentID: 1},
+			{ID: 6, ArticleID: articleID, ParentID: 1},
+			{ID: 8, ArticleID: articleID, ParentID: 1},
+		}, nil)
+
+	server := newTestServer(t, store, nil, nil)
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/api/comments/"+articleID.String()+"/replies/1?cursor=3&limit=2", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var got listCommentRepliesResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+	require.Len(t, got.Replies, 2)
+	require.True(t, got.HasMore)
+	require.Equal(t, int64(6), got.NextCursor)
+}
+
+func TestCreateCommentInvalidatesCommentCache(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomArticle(t, user.ID, true)
+	sendCommentUser, _ := randomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	cache := mockcache.NewMockCache(ctrl)
+
+	store.EXPECT().
+		CreateComment(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.Comment{ID: 42, Content: "感谢分享", ArticleID: article.ID, ToUserID: user.ID, Status: moderation.StatusPublished}, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(user, nil)
+	cache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleCommentVersionKey(article.ID))).Times(1).Return(int64(1), nil)
+
+	server := newTestServer(t, store, nil, cache)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{
+		"content":      "感谢分享",
+		"article_id":   article.ID,
+		"from_user_id": sendCommentUser.ID,
+		"to_user_id":   user.ID,
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/comments", bytes.NewReader(data))
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, sendCommentUser.ID, sendCommentUser.Username, sendCommentUser.Role, time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
 func requireBodyMatchComment(t *testing.T, body *bytes.Buffer, comment db.Comment) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
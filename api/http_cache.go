@@ -0,0 +1,217 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	httpCacheSurrogateKeysKey = "http_cache_surrogate_keys"
+
+	surrogateKeyArticleList = "article-list"
+	surrogateKeyCategories  = "categories"
+)
+
+// httpCachePolicy 公开接口的缓存策略，SurrogateKeys 为路由级别的键，处理函数还可以追加具体资源的键
+type httpCachePolicy struct {
+	CacheControl  string
+	SurrogateKeys []string
+}
+
+var (
+	// 浏览量与点赞数会叠加未写回的计数，详情与列表只允许短时间缓存
+	articleHTTPCachePolicy = httpCachePolicy{
+		CacheControl:  "public, max-age=30, stale-while-revalidate=300",
+		SurrogateKeys: []string{surrogateKeyArticleList},
+	}
+	articleDetailHTTPCachePolicy = httpCachePolicy{
+		CacheControl: "public, max-age=30, stale-while-revalidate=300",
+	}
+	relatedArticleHTTPCachePolicy = httpCachePolicy{
+		CacheControl:  "public, max-age=300, stale-while-revalidate=600",
+		SurrogateKeys: []string{surrogateKeyArticleList},
+	}
+	categoryHTTPCachePolicy = httpCachePolicy{
+		CacheControl:  "public, max-age=300, stale-while-revalidate=600",
+		SurrogateKeys: []string{surrogateKeyCategories},
+	}
+)
+
+// httpValidator 在处理函数之前只从缓存中组装成功时的响应体，其中包含叠加后的实时计数，
+// 并追加与处理函数相同的 Surrogate-Key，304 响应会覆盖代理保存的响应头；
+// 缓存未命中或请求参数无效时返回 false，由处理函数完成查询
+type httpValidator func(ctx *gin.Context) (any, bool)
+
+// httpCacheMiddleware 根据响应体计算 ETag，客户端携带的 If-None-Match 仍然有效时返回 304。
+// validator 能从缓存中组装响应时直接据此判断，条件请求命中时不再执行处理函数；
+// 响应中包含实时计数，内容修改时间无法反映计数变化，因此不使用 Last-Modified
+func httpCacheMiddleware(policy httpCachePolicy, validator httpValidator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Request.Method != http.MethodGet {
+			ctx.Next()
+			return
+		}
+
+		ifNoneMatch := ctx.Request.Header.Get("If-None-Match")
+		if ifNoneMatch != "" {
+			if etag, ok := validatedETag(ctx, validator); ok && etagMatches(ifNoneMatch, etag) {
+				setHTTPCacheHeaders(ctx, ctx.Writer.Header(), policy, etag)
+				ctx.AbortWithStatus(http.StatusNotModified)
+				return
+			}
+		}
+
+		original := ctx.Writer
+		writer := &bufferedResponseWriter{ResponseWriter: original, status: http.StatusOK}
+		ctx.Writer = writer
+		ctx.Next()
+		ctx.Writer = original
+
+		if writer.status != http.StatusOK {
+			original.WriteHeader(writer.status)
+			_, _ = original.Write(writer.body.Bytes())
+			return
+		}
+
+		header := original.Header()
+		etag := responseETag(writer.body.Bytes())
+		setHTTPCacheHeaders(ctx, header, policy, etag)
+
+		if ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(writer.body.Bytes())
+	}
+}
+
+// validatedETag 与处理函数输出相同的响应体计算 ETag，两种方式得到的 ETag 一致
+func validatedETag(ctx *gin.Context, validator httpValidator) (string, bool) {
+	if validator == nil {
+		return "", false
+	}
+	resp, ok := validator(ctx)
+	if !ok {
+		return "", false
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return "", false
+	}
+	return responseETag(body), true
+}
+
+func setHTTPCacheHeaders(ctx *gin.Context, header http.Header, policy httpCachePolicy, etag string) {
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", policy.CacheControl)
+	if keys := surrogateKeys(ctx, policy.SurrogateKeys); keys != "" {
+		header.Set("Surrogate-Key", keys)
+	}
+}
+
+// addSurrogateKeys 追加具体资源的键，供代理按文章或分类精确清除。
+// 校验器与处理函数可能追加同一个键，已存在的键会跳过
+func addSurrogateKeys(ctx *gin.Context, keys ...string) {
+	current := ctx.GetStringSlice(httpCacheSurrogateKeysKey)
+	for _, cacheKey := range keys {
+		if !slices.Contains(current, cacheKey) {
+			current = append(current, cacheKey)
+		}
+	}
+	ctx.Set(httpCacheSurrogateKeysKey, current)
+}
+
+func articleSurrogateKey(id uuid.UUID) string {
+	return "article:" + id.String()
+}
+
+func categorySurrogateKey(id int64) string {
+	return fmt.Sprintf("category:%d", id)
+}
+
+func surrogateKeys(ctx *gin.Context, routeKeys []string) string {
+	keys := append(append([]string{}, routeKeys...), ctx.GetStringSlice(httpCacheSurrogateKeysKey)...)
+	return strings.Join(keys, " ")
+}
+
+// requestMemo 保存一次读取的结果，未命中也会记录
+type requestMemo[T any] struct {
+	value T
+	ok    bool
+}
+
+// memoizeRequest 同一请求内只读取一次，HTTP 缓存校验器与处理函数共用读取结果
+func memoizeRequest[T any](ctx *gin.Context, memoKey string, load func() (T, bool)) (T, bool) {
+	if value, exists := ctx.Get(memoKey); exists {
+		if memo, ok := value.(requestMemo[T]); ok {
+			return memo.value, memo.ok
+		}
+	}
+
+	value, ok := load()
+	ctx.Set(memoKey, requestMemo[T]{value: value, ok: ok})
+	return value, ok
+}
+
+func responseETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	// 使用弱校验，nginx 压缩后内容字节不同但语义相同
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponseWriter 暂存处理函数的输出，由中间件决定返回完整响应还是 304
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
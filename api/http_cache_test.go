@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPCacheMiddleware(t *testing.T) {
+	policy := httpCachePolicy{CacheControl: "public, max-age=30", SurrogateKeys: []string{surrogateKeyArticleList}}
+
+	var handlerCalls int
+	validated := true
+	router := gin.New()
+	router.GET("/ok", httpCacheMiddleware(policy, nil), func(ctx *gin.Context) {
+		addSurrogateKeys(ctx, categorySurrogateKey(7))
+		ctx.JSON(http.StatusOK, gin.H{"title": "缓存"})
+	})
+	validator := func(ctx *gin.Context) (any, bool) {
+		addSurrogateKeys(ctx, categorySurrogateKey(7))
+		return gin.H{"title": "缓存"}, validated
+	}
+	router.GET("/validated", httpCacheMiddleware(policy, validator), func(ctx *gin.Context) {
+		handlerCalls++
+		addSurrogateKeys(ctx, categorySurrogateKey(7))
+		ctx.JSON(http.StatusOK, gin.H{"title": "缓存"})
+	})
+	router.GET("/missing", httpCacheMiddleware(policy, nil), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+
+	serve := func(path string, header http.Header) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	first := serve("/ok", nil)
+	require.Equal(t, http.StatusOK, first.Code)
+	require.JSONEq(t, `{"title":"缓存"}`, first.Body.String())
+	etag := first.Header().Get("ETag")
+	require.Regexp(t, `^W/"[0-9a-f]{32}"$`, etag)
+	require.Equal(t, "public, max-age=30", first.Header().Get("Cache-Control"))
+	require.Equal(t, "article-list category:7", first.Header().Get("Surrogate-Key"))
+	require.Empty(t, first.Header().Get("Last-Modified"))
+
+	testCases := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{
+			name:   "IfNoneMatch",
+			header: http.Header{"If-None-Match": {`"other", ` + etag}},
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "IfNoneMatchStrongForm",
+			header: http.Header{"If-None-Match": {etag[2:]}},
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "IfNoneMatchChanged",
+			header: http.Header{"If-None-Match": {`W/"stale"`}},
+			status: http.StatusOK,
+		},
+		{
+			// 响应包含实时计数，不根据修改时间返回 304
+			name:   "IfModifiedSinceIgnored",
+			header: http.Header{"If-Modified-Since": {"Thu, 04 Jun 2099 08:00:00 GMT"}},
+			status: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := serve("/ok", tc.header)
+			require.Equal(t, tc.status, recorder.Code)
+			require.Equal(t, etag, recorder.Header().Get("ETag"))
+			if tc.status == http.StatusNotModified {
+				require.Empty(t, recorder.Body.String())
+			} else {
+				require.JSONEq(t, `{"title":"缓存"}`, recorder.Body.String())
+			}
+		})
+	}
+
+	// 校验器从缓存组装的响应与处理函数输出一致，条件请求命中时不执行处理函数
+	notModified := serve("/validated", http.Header{"If-None-Match": {etag}})
+	require.Equal(t, http.StatusNotModified, notModified.Code)
+	require.Empty(t, notModified.Body.String())
+	require.Equal(t, etag, notModified.Header().Get("ETag"))
+	require.Equal(t, "public, max-age=30", notModified.Header().Get("Cache-Control"))
+	require.Equal(t, "article-list category:7", notModified.Header().Get("Surrogate-Key"))
+	require.Zero(t, handlerCalls)
+
+	changed := serve("/validated", http.Header{"If-None-Match": {`W/"stale"`}})
+	require.Equal(t, http.StatusOK, changed.Code)
+	require.Equal(t, etag, changed.Header().Get("ETag"))
+	require.Equal(t, "article-list category:7", changed.Header().Get("Surrogate-Key"))
+	require.Equal(t, 1, handlerCalls)
+
+	// 缓存未命中时由处理函数输出并根据响应体判断
+	validated = false
+	fallback := serve("/validated", http.Header{"If-None-Match": {etag}})
+	require.Equal(t, http.StatusNotModified, fallback.Code)
+	require.Equal(t, 2, handlerCalls)
+
+	missing := serve("/missing", http.Header{"If-None-Match": {"*"}})
+	require.Equal(t, http.StatusNotFound, missing.Code)
+	require.Empty(t, missing.Header().Get("ETag"))
+	require.Empty(t, missing.Header().Get("Cache-Control"))
+	require.JSONEq(t, `{"error":"not found"}`, missing.Body.String())
+}
+
+func TestGetArticleAPINotModified(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomGetArticleRow(t, user.ID, true)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(article.ID)).Times(2).Return(article, nil)
+
+	server := newTestServer(t, store, nil, nil)
+	path := "/api/articles/" + article.ID.String()
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, path, nil)
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, articleDetailHTTPCachePolicy.CacheControl, recorder.Header().Get("Cache-Control"))
+	require.Equal(t, "article:"+article.ID.String(), recorder.Header().Get("Surrogate-Key"))
+	etag := recorder.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodGet, path, nil)
+	require.NoError(t, err)
+	request.Header.Set("If-None-Match", etag)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+	require.Empty(t, recorder.Body.String())
+}
+
+func TestGetArticleAPINotModifiedFromCache(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomGetArticleRow(t, user.ID, true)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetArticle(gomock.Any(), gomock.Any()).Times(0)
+
+	pendingViews := "1"
+	cache := mockcache.NewMockCache(ctrl)
+	cache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetArticleIDKey(article.ID)), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, _ string, dest *db.GetArticleRow) (bool, error) {
+			*dest = article
+			return true, nil
+		})
+	cache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetArticleCounterTotalsKey(article.ID)), gomock.Any()).
+		AnyTimes().
+		Return(false, nil)
+	cache.EXPECT().
+		HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterPendingKey), gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, _ string, _ ...string) ([]string, error) {
+			return []string{pendingViews, ""}, nil
+		})
+	cache.EXPECT().
+		HMGet(gomock.Any(), gomock.Eq(key.ArticleCounterFlushingKey), gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return([]string{"", ""}, nil)
+
+	server := newTestServer(t, store, nil, cache)
+	path := "/api/articles/" + article.ID.String()
+	serve := func(etag string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		if etag != "" {
+			request.Header.Set("If-None-Match", etag)
+		}
+		server.router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	first := serve("")
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Empty(t, first.Header().Get("Last-Modified"))
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	notModified := serve(etag)
+	require.Equal(t, http.StatusNotModified, notModified.Code)
+	require.Equal(t, "article:"+article.ID.String(), notModified.Header().Get("Surrogate-Key"))
+
+	// 计数变化后 ETag 随之变化
+	pendingViews = "2"
+	changed := serve(etag)
+	require.Equal(t, http.StatusOK, changed.Code)
+	require.NotEqual(t, etag, changed.Header().Get("ETag"))
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/gin-gonic/gin"
+)
+
+// redirectTarget 旧地址应跳转到的位置，前端据此替换当前地址
+type redirectTarget struct {
+	Location   string `json:"location"`
+	StatusCode int    `json:"status_code"`
+}
+
+type redirectResponse struct {
+	Redirect redirectTarget `json:"redirect"`
+}
+
+// findRedirect 先匹配后台配置的自定义跳转，再匹配文章的历史短标识
+func (server *Server) findRedirect(ctx *gin.Context, path string) (redirectTarget, bool, error) {
+	path = util.NormalizeSitePath(path)
+	if path == "" {
+		return redirectTarget{}, false, nil
+	}
+
+	redirect, err := server.store.GetRedirectBySourcePath(ctx, path)
+	if err == nil {
+		return redirectTarget{Location: redirect.TargetPath, StatusCode: int(redirect.StatusCode)}, true, nil
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return redirectTarget{}, false, err
+	}
+
+	slug, ok := strings.CutPrefix(path, "/article/")
+	if !ok || slug == "" || strings.Contains(slug, "/") {
+		return redirectTarget{}, false, nil
+	}
+	article, err := server.store.GetArticleSlugRedirect(ctx, slug)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return redirectTarget{}, false, nil
+		}
+		return redirectTarget{}, false, err
+	}
+
+	return redirectTarget{
+		Location:   util.ArticlePath(article.ID, article.Slug),
+		StatusCode: http.StatusMovedPermanently,
+	}, true, nil
+}
+
+type resolveRedirectRequest struct {
+	Path string `form:"path" binding:"required,max=255"`
+}
+
+// resolveRedirect 前端路由未命中时查询旧地址是否需要跳转
+func (server *Server) resolveRedirect(ctx *gin.Context) {
+	var req resolveRedirectRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	target, ok, err := server.findRedirect(ctx, req.Path)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !ok {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("redirect not found")))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, redirectResponse{Redirect: target})
+}
+
+// noRoute 未注册的页面地址按跳转规则返回 301 等跳转，爬虫与 nginx 兜底转发的旧地址由这里跳转到新地址
+func (server *Server) noRoute(ctx *gin.Context) {
+	method := ctx.Request.Method
+	if (method != http.MethodGet && method != http.MethodHead) || strings.HasPrefix(ctx.Request.URL.Path, "/api/") {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("route not found")))
+		return
+	}
+	server.redirectOrRenderMetaNotFound(ctx)
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveRedirectAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "CustomRedirect",
+			path: "/about-me/?ref=nav",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/about-me")).
+					Times(1).
+					Return(db.Redirect{SourcePath: "/about-me", TargetPath: "/about", StatusCode: 301}, nil)
+				store.EXPECT().GetArticleSlugRedirect(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp redirectResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				require.Equal(t, redirectTarget{Location: "/about", StatusCode: http.StatusMovedPermanently}, resp.Redirect)
+			},
+		},
+		{
+			name: "NotFound",
+			path: "/about-me",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+				store.EXPECT().GetArticleSlugRedirect(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "ExternalPath",
+			path: "https://evil.example.com/",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRedirectBySourcePath(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MissingPath",
+			path: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRedirectBySourcePath(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			path: "/about-me",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Redirect{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/redirects/resolve?path="+url.QueryEscape(tc.path), nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestNoRouteRedirect(t *testing.T) {
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "CustomRedirect",
+			method: http.MethodGet,
+			path:   "/about-me/",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/about-me")).
+					Times(1).
+					Return(db.Redirect{SourcePath: "/about-me", TargetPath: "/about", StatusCode: 301}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMovedPermanently, recorder.Code)
+				require.Equal(t, "https://blog.example.com/about", recorder.Header().Get("Location"))
+			},
+		},
+		{
+			name:   "ExternalTarget",
+			method: http.MethodHead,
+			path:   "/go",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/go")).
+					Times(1).
+					Return(db.Redirect{SourcePath: "/go", TargetPath: "https://go.dev/blog", StatusCode: 302}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusFound, recorder.Code)
+				require.Equal(t, "https://go.dev/blog", recorder.Header().Get("Location"))
+			},
+		},
+		{
+			name:   "NotFound",
+			method: http.MethodGet,
+			path:   "/missing",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "noindex")
+			},
+		},
+		{
+			name:   "APIPath",
+			method: http.MethodGet,
+			path:   "/api/unknown",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRedirectBySourcePath(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "NonGetMethod",
+			method: http.MethodPost,
+			path:   "/about-me",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRedirectBySourcePath(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil, nil)
+			server.config.Domain = "https://blog.example.com"
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -3,27 +3,67 @@ package api
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
+const (
+	sitemapXMLNS      = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapImageXMLNS = "http://www.google.com/schemas/sitemap-image/1.1"
+	// sitemapMaxURLs 单个站点地图最多包含的 URL 数量（协议上限）
+	sitemapMaxURLs int32 = 50000
+
+	sitemapIndexName    = "index"
+	sitemapPagesName    = "pages"
+	sitemapArticlesName = "articles-%d"
+)
+
+var errSitemapNotFound = errors.New("sitemap not found")
+
+type sitemapIndex struct {
+	XMLName  xml.Name            `xml:"sitemapindex"`
+	XMLNS    string              `xml:"xmlns,attr"`
+	Sitemaps []sitemapIndexEntry `xml:"sitemap"`
+}
+
+type sitemapIndexEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
 type sitemapURLSet struct {
-	XMLName xml.Name     `xml:"urlset"`
-	XMLNS   string       `xml:"xmlns,attr"`
-	URLs    []sitemapURL `xml:"url"`
+	XMLName    xml.Name     `xml:"urlset"`
+	XMLNS      string       `xml:"xmlns,attr"`
+	XMLNSImage string       `xml:"xmlns:image,attr,omitempty"`
+	URLs       []sitemapURL `xml:"url"`
 }
 
 type sitemapURL struct {
-	Loc        string `xml:"loc"`
-	LastMod    string `xml:"lastmod,omitempty"`
-	ChangeFreq string `xml:"changefreq,omitempty"`
-	Priority   string `xml:"priority,omitempty"`
+	Loc        string         `xml:"loc"`
+	LastMod    string         `xml:"lastmod,omitempty"`
+	ChangeFreq string         `xml:"changefreq,omitempty"`
+	Priority   string         `xml:"priority,omitempty"`
+	Images     []sitemapImage `xml:"image:image,omitempty"`
+}
+
+type sitemapImage struct {
+	Loc string `xml:"image:loc"`
+}
+
+// indexNowKeyFile 返回 IndexNow 密钥，供搜索引擎校验站点所有权
+func (server *Server) indexNowKeyFile(ctx *gin.Context) {
+	ctx.String(http.StatusOK, strings.TrimSpace(server.config.IndexNowKey))
 }
 
 func (server *Server) robotsTxt(ctx *gin.Context) {
@@ -43,25 +83,85 @@ func (server *Server) robotsTxt(ctx *gin.Context) {
 	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(body))
 }
 
+// sitemapXML 输出站点地图索引，页面与文章分别放在子站点地图中
 func (server *Server) sitemapXML(ctx *gin.Context) {
-	categoryRows, err := server.store.ListPublishedCategorySitemapItems(ctx)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	server.serveSitemap(ctx, sitemapIndexName, func() (any, error) {
+		categoryRows, err := server.store.ListPublishedCategorySitemapItems(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		articlePages, err := server.store.ListPublishedArticleSitemapPages(ctx, sitemapMaxURLs)
+		if err != nil {
+			return nil, err
+		}
+
+		origin := server.publicSiteOrigin(ctx)
+		index := sitemapIndex{
+			XMLNS: sitemapXMLNS,
+			Sitemaps: []sitemapIndexEntry{
+				{
+					Loc:     fmt.Sprintf("%s/sitemaps/%s.xml", origin, sitemapPagesName),
+					LastMod: sitemapDate(latestCategoryUpdate(categoryRows)),
+				},
+			},
+		}
+		for _, page := range articlePages {
+			index.Sitemaps = append(index.Sitemaps, sitemapIndexEntry{
+				Loc:     fmt.Sprintf("%s/sitemaps/%s.xml", origin, fmt.Sprintf(sitemapArticlesName, page.Page)),
+				LastMod: sitemapDate(page.LastModified),
+			})
+		}
+
+		return index, nil
+	})
+}
+
+type childSitemapRequest struct {
+	Name string `uri:"name" binding:"required"`
+}
+
+// childSitemapXML 输出首页与分类页，或按页输出文章及封面图片
+func (server *Server) childSitemapXML(ctx *gin.Context) {
+	var req childSitemapRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	articleRows, err := server.store.ListPublishedArticleSitemapItems(ctx)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	name := strings.TrimSuffix(req.Name, ".xml")
+	if name == sitemapPagesName {
+		server.serveSitemap(ctx, name, func() (any, error) {
+			return server.buildPagesSitemap(ctx)
+		})
+		return
+	}
+
+	var page int32
+	if _, err := fmt.Sscanf(name, sitemapArticlesName, &page); err != nil ||
+		fmt.Sprintf(sitemapArticlesName, page) != name || page < 1 || page > math.MaxInt32/sitemapMaxURLs {
+		ctx.JSON(http.StatusNotFound, errorResponse(errSitemapNotFound))
 		return
 	}
 
+	server.serveSitemap(ctx, name, func() (any, error) {
+		return server.buildArticlesSitemap(ctx, page)
+	})
+}
+
+func (server *Server) buildPagesSitemap(ctx *gin.Context) (sitemapURLSet, error) {
+	categoryRows, err := server.store.ListPublishedCategorySitemapItems(ctx)
+	if err != nil {
+		return sitemapURLSet{}, err
+	}
+
 	origin := server.publicSiteOrigin(ctx)
 	urlSet := sitemapURLSet{
-		XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		XMLNS: sitemapXMLNS,
 		URLs: []sitemapURL{
 			{
 				Loc:        origin + "/",
+				LastMod:    sitemapDate(latestCategoryUpdate(categoryRows)),
 				ChangeFreq: "daily",
 				Priority:   "1.0",
 			},
@@ -69,6 +169,9 @@ func (server *Server) sitemapXML(ctx *gin.Context) {
 	}
 
 	for _, category := range categoryRows {
+		if int32(len(urlSet.URLs)) >= sitemapMaxURLs {
+			break
+		}
 		urlSet.URLs = append(urlSet.URLs, sitemapURL{
 			Loc:        fmt.Sprintf("%s/category/%d", origin, category.ID),
 			LastMod:    sitemapDate(category.UpdatedAt),
@@ -77,29 +180,98 @@ func (server *Server) sitemapXML(ctx *gin.Context) {
 		})
 	}
 
+	return urlSet, nil
+}
+
+func (server *Server) buildArticlesSitemap(ctx *gin.Context, page int32) (sitemapURLSet, error) {
+	articleRows, err := server.store.ListPublishedArticleSitemapItems(ctx, db.ListPublishedArticleSitemapItemsParams{
+		Limit:  sitemapMaxURLs,
+		Offset: (page - 1) * sitemapMaxURLs,
+	})
+	if err != nil {
+		return sitemapURLSet{}, err
+	}
+	if len(articleRows) == 0 {
+		return sitemapURLSet{}, errSitemapNotFound
+	}
+
+	origin := server.publicSiteOrigin(ctx)
+	urlSet := sitemapURLSet{
+		XMLNS:      sitemapXMLNS,
+		XMLNSImage: sitemapImageXMLNS,
+	}
+
 	for _, article := range articleRows {
-		urlSet.URLs = append(urlSet.URLs, sitemapURL{
+		entry := sitemapURL{
 			Loc:        fmt.Sprintf("%s%s", origin, sitemapArticlePath(article)),
 			LastMod:    sitemapDate(articleLastModified(article.CreatedAt, article.UpdatedAt)),
 			ChangeFreq: "monthly",
 			Priority:   "0.8",
-		})
+		}
+		if cover := absoluteResourceURL(origin, article.Cover); cover != "" {
+			entry.Images = []sitemapImage{{Loc: cover}}
+		}
+		urlSet.URLs = append(urlSet.URLs, entry)
+	}
+
+	return urlSet, nil
+}
+
+// serveSitemap 优先返回缓存的 XML，未命中时生成并回填；缓存随文章列表版本号失效。
+// 未配置 DOMAIN 时地址取自请求头，这类结果不读也不写缓存，避免按某次请求的 Host 生成的地址被返回给其他请求
+func (server *Server) serveSitemap(ctx *gin.Context, name string, build func() (any, error)) {
+	var sitemapCache *cachepkg.SitemapCache
+	if util.NormalizeOrigin(server.config.Domain) != "" {
+		sitemapCache = cachepkg.NewSitemapCache(server.cache)
+	}
+
+	document, ok, err := sitemapCache.Get(ctx, name)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Error().
+			Err(err).
+			Str("module", "seo").
+			Str("action", "cache_get").
+			Str("sitemap", name).
+			Msg("获取站点地图缓存失败，降级为仅数据库")
+	}
+	if ok {
+		ctx.Data(http.StatusOK, "application/xml; charset=utf-8", []byte(document))
+		return
+	}
+
+	value, err := build()
+	if err != nil {
+		if errors.Is(err, errSitemapNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
 
 	var buffer bytes.Buffer
 	buffer.WriteString(xml.Header)
 	encoder := xml.NewEncoder(&buffer)
 	encoder.Indent("", "  ")
-	if err := encoder.Encode(urlSet); err != nil {
+	if err := encoder.Encode(value); err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	if err := sitemapCache.Set(ctx, name, buffer.String()); err != nil {
+		log.Error().
+			Err(err).
+			Str("module", "seo").
+			Str("action", "cache_set").
+			Str("sitemap", name).
+			Msg("设置站点地图缓存失败")
+	}
+
 	ctx.Data(http.StatusOK, "application/xml; charset=utf-8", buffer.Bytes())
 }
 
 func (server *Server) publicSiteOrigin(ctx *gin.Context) string {
-	if origin := normalizeOrigin(server.config.Domain); origin != "" {
+	if origin := util.NormalizeOrigin(server.config.Domain); origin != "" {
 		return origin
 	}
 
@@ -115,28 +287,11 @@ func (server *Server) publicSiteOrigin(ctx *gin.Context) string {
 		host = "localhost"
 	}
 
-	return normalizeOrigin(fmt.Sprintf("%s://%s", scheme, host))
-}
-
-func normalizeOrigin(value string) string {
-	source := strings.TrimSpace(value)
-	if source == "" {
-		return ""
-	}
-
-	parsed, err := url.Parse(source)
-	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
-		return strings.TrimRight(source, "/")
-	}
-
-	return fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
+	return util.NormalizeOrigin(fmt.Sprintf("%s://%s", scheme, host))
 }
 
 func sitemapArticlePath(article db.ListPublishedArticleSitemapItemsRow) string {
-	if article.Slug.Valid && strings.TrimSpace(article.Slug.String) != "" {
-		return "/article/" + strings.TrimSpace(article.Slug.String)
-	}
-	return "/article/" + article.ID.String()
+	return util.ArticlePath(article.ID, article.Slug)
 }
 
 func articleLastModified(createdAt time.Time, updatedAt time.Time) time.Time {
@@ -146,9 +301,20 @@ func articleLastModified(createdAt time.Time, updatedAt time.Time) time.Time {
 	return updatedAt
 }
 
+func latestCategoryUpdate(categories []db.ListPublishedCategorySitemapItemsRow) time.Time {
+	var latest time.Time
+	for _, category := range categories {
+		if category.UpdatedAt.After(latest) {
+			latest = category.UpdatedAt
+		}
+	}
+	return latest
+}
+
+// sitemapDate 输出 W3C Datetime 格式的完整时间，搜索引擎可以据此判断同一天内的多次更新
 func sitemapDate(value time.Time) string {
 	if value.IsZero() {
 		return ""
 	}
-	return value.UTC().Format("2006-01-02")
+	return value.UTC().Format(time.RFC3339)
 }
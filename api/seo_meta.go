@@ -0,0 +1,371 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// metaDescriptionLength 摘要为空时从正文截取的描述长度
+const metaDescriptionLength = 160
+
+// metaPageCacheControl 爬虫页面允许 CDN 短暂缓存
+const metaPageCacheControl = "public, max-age=600"
+
+// metaPageTemplate 为爬虫输出只含元数据的页面，普通访客仍由前端 SPA 渲染
+var metaPageTemplate = template.Must(template.New("meta").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="UTF-8">
+<title>{{.Title}} | {{.SiteName}}</title>
+<meta name="description" content="{{.Description}}">
+{{- if .NoIndex}}
+<meta name="robots" content="noindex">
+{{- else}}
+<link rel="canonical" href="{{.CanonicalURL}}">
+<meta property="og:site_name" content="{{.SiteName}}">
+<meta property="og:locale" content="zh_CN">
+<meta property="og:type" content="{{.Type}}">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:url" content="{{.CanonicalURL}}">
+{{- if .ImageURL}}
+<meta property="og:image" content="{{.ImageURL}}">
+{{- end}}
+{{- if .PublishedTime}}
+<meta property="article:published_time" content="{{.PublishedTime}}">
+<meta property="article:modified_time" content="{{.ModifiedTime}}">
+{{- end}}
+{{- if .Section}}
+<meta property="article:section" content="{{.Section}}">
+{{- end}}
+<meta name="twitter:card" content="{{.TwitterCard}}">
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .ImageURL}}
+<meta name="twitter:image" content="{{.ImageURL}}">
+{{- end}}
+<script type="application/ld+json">{{.JSONLD}}</script>
+{{- end}}
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Description}}</p>
+{{- if not .NoIndex}}
+<a href="{{.CanonicalURL}}">{{.CanonicalURL}}</a>
+{{- end}}
+</body>
+</html>
+`))
+
+type metaPage struct {
+	SiteName      string
+	Title         string
+	Description   string
+	CanonicalURL  string
+	ImageURL      string
+	Type          string
+	TwitterCard   string
+	PublishedTime string
+	ModifiedTime  string
+	Section       string
+	NoIndex       bool
+	JSONLD        template.JS
+}
+
+type jsonLDThing struct {
+	Type string `json:"@type"`
+	Name string `json:"name,omitempty"`
+	ID   string `json:"@id,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type blogPostingJSONLD struct {
+	Context          string       `json:"@context"`
+	Type             string       `json:"@type"`
+	Headline         string       `json:"headline"`
+	Description      string       `json:"description"`
+	URL              string       `json:"url"`
+	Image            []string     `json:"image,omitempty"`
+	DatePublished    string       `json:"datePublished"`
+	DateModified     string       `json:"dateModified"`
+	ArticleSection   string       `json:"articleSection,omitempty"`
+	Author           *jsonLDThing `json:"author,omitempty"`
+	Publisher        jsonLDThing  `json:"publisher"`
+	MainEntityOfPage jsonLDThing  `json:"mainEntityOfPage"`
+}
+
+type collectionPageJSONLD struct {
+	Context     string      `json:"@context"`
+	Type        string      `json:"@type"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	URL         string      `json:"url"`
+	IsPartOf    jsonLDThing `json:"isPartOf"`
+}
+
+type articlePageRequest struct {
+	Key string `uri:"key" binding:"required"`
+}
+
+// articlePage 输出文章的 Open Graph、Twitter Card 与 BlogPosting 结构化数据，路径参数支持 ID 或 slug
+func (server *Server) articlePage(ctx *gin.Context) {
+	var req articlePageRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		server.renderMetaNotFound(ctx)
+		return
+	}
+
+	article, err := server.loadPublishedArticle(ctx, req.Key)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			server.redirectOrRenderMetaNotFound(ctx)
+			return
+		}
+		if errors.Is(err, errArticleAccessRestricted) {
+			server.renderMetaNotFound(ctx)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	origin := server.publicSiteOrigin(ctx)
+	canonicalURL := origin + util.ArticlePath(article.ID, article.Slug)
+	imageURL := absoluteResourceURL(origin, article.Cover)
+	description := metaDescription(article.Summary, article.Content)
+	publishedAt := article.CreatedAt.UTC().Format(time.RFC3339)
+	modifiedAt := articleLastModified(article.CreatedAt, article.UpdatedAt).UTC().Format(time.RFC3339)
+
+	posting := blogPostingJSONLD{
+		Context:          "https://schema.org",
+		Type:             "BlogPosting",
+		Headline:         article.Title,
+		Description:      description,
+		URL:              canonicalURL,
+		DatePublished:    publishedAt,
+		DateModified:     modifiedAt,
+		ArticleSection:   article.CategoryName.String,
+		Publisher:        jsonLDThing{Type: "Organization", Name: server.siteName(), URL: origin},
+		MainEntityOfPage: jsonLDThing{Type: "WebPage", ID: canonicalURL},
+	}
+	if imageURL != "" {
+		posting.Image = []string{imageURL}
+	}
+	if author := server.articleAuthorName(ctx, article.Owner); author != "" {
+		posting.Author = &jsonLDThing{Type: "Person", Name: author}
+	}
+
+	twitterCard := "summary"
+	if imageURL != "" {
+		twitterCard = "summary_large_image"
+	}
+
+	server.renderMetaPage(ctx, http.StatusOK, metaPage{
+		Title:         article.Title,
+		Description:   description,
+		CanonicalURL:  canonicalURL,
+		ImageURL:      imageURL,
+		Type:          "article",
+		TwitterCard:   twitterCard,
+		PublishedTime: publishedAt,
+		ModifiedTime:  modifiedAt,
+		Section:       article.CategoryName.String,
+	}, posting)
+}
+
+// categoryPage 输出分类页的 Open Graph 与 CollectionPage 结构化数据
+func (server *Server) categoryPage(ctx *gin.Context) {
+	var req getCategoryRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		server.redirectOrRenderMetaNotFound(ctx)
+		return
+	}
+
+	category, err := server.store.GetCategory(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			server.redirectOrRenderMetaNotFound(ctx)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	origin := server.publicSiteOrigin(ctx)
+	canonicalURL := fmt.Sprintf("%s/category/%d", origin, category.ID)
+	description := fmt.Sprintf("%s 分类下的技术文章与开发笔记", category.Name)
+
+	server.renderMetaPage(ctx, http.StatusOK, metaPage{
+		Title:        category.Name,
+		Description:  description,
+		CanonicalURL: canonicalURL,
+		Type:         "website",
+		TwitterCard:  "summary",
+	}, collectionPageJSONLD{
+		Context:     "https://schema.org",
+		Type:        "CollectionPage",
+		Name:        category.Name,
+		Description: description,
+		URL:         canonicalURL,
+		IsPartOf:    jsonLDThing{Type: "WebSite", Name: server.siteName(), URL: origin},
+	})
+}
+
+// loadPublishedArticle 优先读取文章详情缓存，未命中时查询数据库并回填
+func (server *Server) loadPublishedArticle(ctx *gin.Context, idOrSlug string) (db.GetArticleRow, error) {
+	articleCache := cachepkg.NewArticleCache(server.cache)
+
+	var (
+		article db.GetArticleRow
+		ok      bool
+		err     error
+	)
+	articleID, parseErr := uuid.Parse(idOrSlug)
+	if parseErr == nil {
+		article, ok, err = articleCache.GetByID(ctx, articleID)
+	} else {
+		var row db.GetArticleBySlugRow
+		row, ok, err = articleCache.GetBySlug(ctx, idOrSlug)
+		article = db.GetArticleRow(row)
+	}
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Error().
+			Err(err).
+			Str("module", "seo").
+			Str("action", "cache_get").
+			Str("article", idOrSlug).
+			Msg("获取文章缓存失败，降级为仅数据库")
+	}
+	if !ok {
+		if parseErr == nil {
+			article, err = server.store.GetArticle(ctx, articleID)
+		} else {
+			var row db.GetArticleBySlugRow
+			row, err = server.store.GetArticleBySlug(ctx, pgtype.Text{String: idOrSlug, Valid: true})
+			article = db.GetArticleRow(row)
+		}
+		if err != nil {
+			return db.GetArticleRow{}, err
+		}
+		if !article.IsPublish {
+			return db.GetArticleRow{}, errArticleAccessRestricted
+		}
+
+		if parseErr == nil {
+			err = articleCache.SetByID(ctx, article)
+		} else {
+			err = articleCache.SetBySlug(ctx, idOrSlug, db.GetArticleBySlugRow(article))
+		}
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("module", "seo").
+				Str("action", "cache_set").
+				Str("article", idOrSlug).
+				Msg("设置文章缓存失败")
+		}
+	}
+
+	return article, nil
+}
+
+// articleAuthorName 作者信息只用于结构化数据，查询失败时省略
+func (server *Server) articleAuthorName(ctx *gin.Context, owner uuid.UUID) string {
+	user, err := server.store.GetUser(ctx, owner)
+	if err != nil {
+		log.Warn().Err(err).Str("module", "seo").Str("user_id", owner.String()).Msg("获取文章作者失败")
+		return ""
+	}
+	if strings.TrimSpace(user.FullName) != "" {
+		return user.FullName
+	}
+	return user.Username
+}
+
+// redirectOrRenderMetaNotFound 旧地址对爬虫直接返回 301 等跳转，其余情况输出 404 页面
+func (server *Server) redirectOrRenderMetaNotFound(ctx *gin.Context) {
+	target, ok, err := server.findRedirect(ctx, ctx.Request.URL.Path)
+	if err != nil {
+		log.Error().Err(err).Str("module", "seo").Str("path", ctx.Request.URL.Path).Msg("查询跳转规则失败")
+	}
+	if !ok {
+		server.renderMetaNotFound(ctx)
+		return
+	}
+
+	ctx.Redirect(target.StatusCode, absoluteResourceURL(server.publicSiteOrigin(ctx), target.Location))
+}
+
+func (server *Server) renderMetaNotFound(ctx *gin.Context) {
+	server.renderMetaPage(ctx, http.StatusNotFound, metaPage{
+		Title:       "页面不存在",
+		Description: "你访问的页面不存在或已下线。",
+		NoIndex:     true,
+	}, nil)
+}
+
+func (server *Server) renderMetaPage(ctx *gin.Context, code int, page metaPage, jsonLD any) {
+	page.SiteName = server.siteName()
+	if jsonLD != nil {
+		// json.Marshal 会转义 <、>、&，可以安全地嵌入 script 标签
+		data, err := json.Marshal(jsonLD)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		page.JSONLD = template.JS(data)
+	}
+
+	var buffer bytes.Buffer
+	if err := metaPageTemplate.Execute(&buffer, page); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if code == http.StatusOK {
+		ctx.Header("Cache-Control", metaPageCacheControl)
+	}
+	ctx.Data(code, "text/html; charset=utf-8", buffer.Bytes())
+}
+
+func (server *Server) siteName() string {
+	if name := strings.TrimSpace(server.config.SiteName); name != "" {
+		return name
+	}
+	return util.DefaultSiteName
+}
+
+// metaDescription 优先使用摘要，摘要为空时截取正文纯文本
+func metaDescription(summary string, content string) string {
+	if description := strings.TrimSpace(summary); description != "" {
+		return description
+	}
+	return util.TruncateRunes(util.PlainText(content), metaDescriptionLength)
+}
+
+// absoluteResourceURL 将封面等站内资源路径转换为分享卡片要求的绝对地址
+func absoluteResourceURL(origin string, resource string) string {
+	resource = strings.TrimSpace(resource)
+	if resource == "" {
+		return ""
+	}
+	if strings.HasPrefix(resource, "http://") || strings.HasPrefix(resource, "https://") {
+		return resource
+	}
+	return origin + "/" + strings.TrimLeft(resource, "/")
+}
@@ -0,0 +1,281 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticlePageAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	article := randomGetArticleRow(t, user.ID, true)
+	article.Title = "Redis 缓存一致性 </script>"
+	article.Summary = "延迟双删与版本号"
+	article.Cover = "/resources/articles/" + article.ID.String() + "/cover.jpg"
+	article.Slug = pgtype.Text{String: "redis-cache-consistency", Valid: true}
+	article.CategoryName = pgtype.Text{String: "后端", Valid: true}
+	article.CreatedAt = time.Date(2026, 6, 3, 8, 0, 0, 0, time.UTC)
+	article.UpdatedAt = time.Date(2026, 6, 4, 8, 0, 0, 0, time.UTC)
+	unpublishedArticle := article
+	unpublishedArticle.IsPublish = false
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK_Slug",
+			path: "/article/redis-cache-consistency",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(pgtype.Text{String: "redis-cache-consistency", Valid: true})).
+					Times(1).
+					Return(db.GetArticleBySlugRow(article), nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Equal(t, metaPageCacheControl, recorder.Header().Get("Cache-Control"))
+
+				body := recorder.Body.String()
+				require.Contains(t, body, `<link rel="canonical" href="https://blog.example.com/article/redis-cache-consistency">`)
+				require.Contains(t, body, `<meta property="og:type" content="article">`)
+				require.Contains(t, body, `<meta property="og:description" content="延迟双删与版本号">`)
+				require.Contains(t, body, `<meta property="og:image" content="https://blog.example.com/resources/articles/`+article.ID.String()+`/cover.jpg">`)
+				require.Contains(t, body, `<meta property="article:published_time" content="2026-06-03T08:00:00Z">`)
+				require.Contains(t, body, `<meta name="twitter:card" content="summary_large_image">`)
+				require.Contains(t, body, `"@type":"BlogPosting"`)
+				require.Contains(t, body, `"dateModified":"2026-06-04T08:00:00Z"`)
+				require.Contains(t, body, `"articleSection":"后端"`)
+				require.Contains(t, body, `"author":{"@type":"Person","name":"`+user.FullName+`"}`)
+				// 标题中的 HTML 不会提前结束 script 标签
+				require.Equal(t, 1, strings.Count(body, "</script>"))
+			},
+		},
+		{
+			name: "OK_IDWithoutAuthor",
+			path: "/article/" + article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				withoutCover := article
+				withoutCover.Cover = ""
+				withoutCover.Summary = ""
+				withoutCover.Content = "<p>第一段</p><p>第二段</p>"
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(article.ID)).
+					Times(1).
+					Return(withoutCover, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				body := recorder.Body.String()
+				require.Contains(t, body, `<meta name="description" content="第一段 第二段">`)
+				require.Contains(t, body, `<meta name="twitter:card" content="summary">`)
+				require.NotContains(t, body, "og:image")
+				require.NotContains(t, body, `"author"`)
+			},
+		},
+		{
+			name: "Unpublished",
+			path: "/article/redis-cache-consistency",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetArticleBySlugRow(unpublishedArticle), nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `<meta name="robots" content="noindex">`)
+				require.NotContains(t, recorder.Body.String(), unpublishedArticle.Summary)
+			},
+		},
+		{
+			name: "NotFound",
+			path: "/article/" + article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetArticleRow{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/article/"+article.ID.String())).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetArticleSlugRedirect(gomock.Any(), gomock.Eq(article.ID.String())).
+					Times(1).
+					Return(db.GetArticleSlugRedirectRow{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Cache-Control"))
+			},
+		},
+		{
+			name: "MovedPermanently",
+			path: "/article/old-redis-slug",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(pgtype.Text{String: "old-redis-slug", Valid: true})).
+					Times(1).
+					Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/article/old-redis-slug")).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetArticleSlugRedirect(gomock.Any(), gomock.Eq("old-redis-slug")).
+					Times(1).
+					Return(db.GetArticleSlugRedirectRow{ID: article.ID, Slug: article.Slug}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMovedPermanently, recorder.Code)
+				require.Equal(t, "https://blog.example.com/article/redis-cache-consistency", recorder.Header().Get("Location"))
+			},
+		},
+		{
+			name: "InternalError",
+			path: "/article/" + article.ID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetArticleRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil, nil)
+			server.config.Domain = "https://blog.example.com/"
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCategoryPageAPI(t *testing.T) {
+	category := db.Category{ID: 7, Name: "Go"}
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			path: "/category/7",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCategory(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				body := recorder.Body.String()
+				require.Contains(t, body, "<title>Go | Nostalgia</title>")
+				require.Contains(t, body, `<meta property="og:url" content="https://blog.example.com/category/7">`)
+				require.Contains(t, body, `<meta property="og:type" content="website">`)
+				require.Contains(t, body, `"@type":"CollectionPage"`)
+				require.Contains(t, body, `"isPartOf":{"@type":"WebSite","name":"Nostalgia","url":"https://blog.example.com"}`)
+			},
+		},
+		{
+			name: "InvalidIDCustomRedirect",
+			path: "/category/golang",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCategory(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/category/golang")).
+					Times(1).
+					Return(db.Redirect{TargetPath: "https://go.dev/blog", StatusCode: http.StatusPermanentRedirect}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPermanentRedirect, recorder.Code)
+				require.Equal(t, "https://go.dev/blog", recorder.Header().Get("Location"))
+			},
+		},
+		{
+			name: "NotFound",
+			path: "/category/7",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCategory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Category{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/category/7")).
+					Times(1).
+					Return(db.Redirect{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `<meta name="robots" content="noindex">`)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil, nil)
+			server.config.Domain = "https://blog.example.com/"
+			server.config.SiteName = "Nostalgia"
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
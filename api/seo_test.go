@@ -11,6 +11,8 @@ import (
 
 	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
 	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
 	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/golang/mock/gomock"
@@ -44,7 +46,120 @@ func TestRobotsTxtAPI(t *testing.T) {
 	require.Contains(t, body, "Sitemap: https://blog.example.com/sitemap.xml")
 }
 
+func TestIndexNowKeyFileAPI(t *testing.T) {
+	const indexNowKey = "a1b2c3d4e5f6"
+
+	server, err := NewServer(util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+		IndexNowKey:         indexNowKey,
+	}, nil, nil, nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/"+indexNowKey+".txt", nil)
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, indexNowKey, recorder.Body.String())
+
+	// 未配置密钥时不注册验证文件，请求落到跳转规则查询
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetRedirectBySourcePath(gomock.Any(), gomock.Eq("/"+indexNowKey+".txt")).
+		Times(1).
+		Return(db.Redirect{}, db.ErrRecordNotFound)
+	server = newTestServer(t, store, nil, nil)
+	recorder = httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
 func TestSitemapXMLAPI(t *testing.T) {
+	categoryRows := []db.ListPublishedCategorySitemapItemsRow{
+		{ID: 1, UpdatedAt: time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC)},
+		{ID: 2, UpdatedAt: time.Date(2026, 6, 2, 8, 0, 0, 0, time.UTC)},
+	}
+	articlePages := []db.ListPublishedArticleSitemapPagesRow{
+		{Page: 1, LastModified: time.Date(2026, 6, 4, 8, 0, 0, 0, time.UTC)},
+		{Page: 2, LastModified: time.Date(2026, 6, 5, 9, 30, 0, 0, time.UTC)},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPublishedCategorySitemapItems(gomock.Any()).
+					Times(1).
+					Return(categoryRows, nil)
+				store.EXPECT().
+					ListPublishedArticleSitemapPages(gomock.Any(), gomock.Eq(sitemapMaxURLs)).
+					Times(1).
+					Return(articlePages, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+				body := recorder.Body.String()
+				require.Contains(t, body, "<sitemapindex")
+				require.Contains(t, body, "<loc>https://blog.example.com/sitemaps/pages.xml</loc>")
+				require.Contains(t, body, "<lastmod>2026-06-02T08:00:00Z</lastmod>")
+				require.Contains(t, body, "<loc>https://blog.example.com/sitemaps/articles-1.xml</loc>")
+				require.Contains(t, body, "<loc>https://blog.example.com/sitemaps/articles-2.xml</loc>")
+				require.Contains(t, body, "<lastmod>2026-06-05T09:30:00Z</lastmod>")
+
+				var index sitemapIndex
+				require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &index))
+				require.Len(t, index.Sitemaps, 3)
+			},
+		},
+		{
+			name: "StoreError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPublishedCategorySitemapItems(gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().ListPublishedArticleSitemapPages(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.True(t, strings.Contains(recorder.Body.String(), "sql: connection is already closed"))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil, nil)
+			server.config = util.Config{Domain: "https://blog.example.com/"}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestChildSitemapXMLAPI(t *testing.T) {
 	ownerID := uuid.New()
 	categoryRows := []db.ListPublishedCategorySitemapItemsRow{
 		{ID: 1, UpdatedAt: time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC)},
@@ -55,6 +170,7 @@ func TestSitemapXMLAPI(t *testing.T) {
 			ID:        uuid.MustParse("3b0daee2-05da-4346-a3f4-ba68a463bb28"),
 			Slug:      pgtype.Text{String: "redis-cache-consistency", Valid: true},
 			Owner:     ownerID,
+			Cover:     "/resources/articles/3b0daee2-05da-4346-a3f4-ba68a463bb28/cover.jpg",
 			CreatedAt: time.Date(2026, 6, 3, 8, 0, 0, 0, time.UTC),
 			UpdatedAt: time.Date(2026, 6, 4, 8, 0, 0, 0, time.UTC),
 		},
@@ -69,50 +185,100 @@ func TestSitemapXMLAPI(t *testing.T) {
 
 	testCases := []struct {
 		name          string
+		path          string
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name: "OK",
+			name: "Pages",
+			path: "/sitemaps/pages.xml",
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListPublishedCategorySitemapItems(gomock.Any()).
 					Times(1).
 					Return(categoryRows, nil)
-				store.EXPECT().
-					ListPublishedArticleSitemapItems(gomock.Any()).
-					Times(1).
-					Return(articleRows, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				require.Equal(t, "application/xml; charset=utf-8", recorder.Header().Get("Content-Type"))
 				body := recorder.Body.String()
 				require.Contains(t, body, "<loc>https://blog.example.com/</loc>")
 				require.Contains(t, body, "<loc>https://blog.example.com/category/1</loc>")
 				require.Contains(t, body, "<loc>https://blog.example.com/category/2</loc>")
-				require.Contains(t, body, "<loc>https://blog.example.com/article/redis-cache-consistency</loc>")
-				require.Contains(t, body, "<loc>https://blog.example.com/article/4b0daee2-05da-4346-a3f4-ba68a463bb28</loc>")
-				require.Contains(t, body, "<lastmod>2026-06-04</lastmod>")
-				require.Contains(t, body, "<lastmod>2026-06-05</lastmod>")
 
 				var urlSet sitemapURLSet
 				require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &urlSet))
-				require.Len(t, urlSet.URLs, 5)
+				require.Len(t, urlSet.URLs, 3)
+			},
+		},
+		{
+			name: "Articles",
+			path: "/sitemaps/articles-2.xml",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPublishedArticleSitemapItems(gomock.Any(), gomock.Eq(db.ListPublishedArticleSitemapItemsParams{
+						Limit:  sitemapMaxURLs,
+						Offset: sitemapMaxURLs,
+					})).
+					Times(1).
+					Return(articleRows, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+				body := recorder.Body.String()
+				require.Contains(t, body, `xmlns:image="http://www.google.com/schemas/sitemap-image/1.1"`)
+				require.Contains(t, body, "<loc>https://blog.example.com/article/redis-cache-consistency</loc>")
+				require.Contains(t, body, "<loc>https://blog.example.com/article/4b0daee2-05da-4346-a3f4-ba68a463bb28</loc>")
+				require.Contains(t, body, "<image:loc>https://blog.example.com/resources/articles/3b0daee2-05da-4346-a3f4-ba68a463bb28/cover.jpg</image:loc>")
+				require.Equal(t, 1, strings.Count(body, "<image:image>"))
+				require.Contains(t, body, "<lastmod>2026-06-04T08:00:00Z</lastmod>")
+				require.Contains(t, body, "<lastmod>2026-06-05T08:00:00Z</lastmod>")
+			},
+		},
+		{
+			name: "EmptyPage",
+			path: "/sitemaps/articles-3.xml",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPublishedArticleSitemapItems(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListPublishedArticleSitemapItemsRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidName",
+			path: "/sitemaps/articles-0.xml",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPublishedArticleSitemapItems(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownName",
+			path: "/sitemaps/articles-1abc.xml",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPublishedArticleSitemapItems(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
 			name: "StoreError",
+			path: "/sitemaps/articles-1.xml",
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					ListPublishedCategorySitemapItems(gomock.Any()).
+					ListPublishedArticleSitemapItems(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(nil, sql.ErrConnDone)
-				store.EXPECT().ListPublishedArticleSitemapItems(gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
-				require.True(t, strings.Contains(recorder.Body.String(), "sql: connection is already closed"))
 			},
 		},
 	}
@@ -125,14 +291,13 @@ func TestSitemapXMLAPI(t *testing.T) {
 			defer ctrl.Finish()
 
 			store := mockdb.NewMockStore(ctrl)
-			redisCache := mockcache.NewMockCache(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store, nil, redisCache)
+			server := newTestServer(t, store, nil, nil)
 			server.config = util.Config{Domain: "https://blog.example.com/"}
 			recorder := httptest.NewRecorder()
 
-			request, err := http.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+			request, err := http.NewRequest(http.MethodGet, tc.path, nil)
 			require.NoError(t, err)
 
 			server.router.ServeHTTP(recorder, request)
@@ -140,3 +305,108 @@ func TestSitemapXMLAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestSitemapXMLAPICache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	redisCache := mockcache.NewMockCache(ctrl)
+	cachedIndex := xml.Header + "<sitemapindex></sitemapindex>"
+
+	redisCache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(0)), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, _ string, dest *int64) (bool, error) {
+			*dest = 3
+			return true, nil
+		})
+	redisCache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetSitemapKey(3, sitemapIndexName)), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, _ string, dest *string) (bool, error) {
+			*dest = cachedIndex
+			return true, nil
+		})
+	store.EXPECT().ListPublishedCategorySitemapItems(gomock.Any()).Times(0)
+	store.EXPECT().ListPublishedArticleSitemapPages(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil, redisCache)
+	server.config.Domain = "https://blog.example.com"
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, cachedIndex, recorder.Body.String())
+}
+
+// 未配置 DOMAIN 时地址来自请求头，不能读写共享缓存
+func TestSitemapXMLAPISkipsCacheWithoutDomain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	redisCache := mockcache.NewMockCache(ctrl)
+	redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	redisCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().
+		ListPublishedCategorySitemapItems(gomock.Any()).
+		Times(1).
+		Return([]db.ListPublishedCategorySitemapItemsRow{}, nil)
+	store.EXPECT().
+		ListPublishedArticleSitemapPages(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.ListPublishedArticleSitemapPagesRow{}, nil)
+
+	server := newTestServer(t, store, nil, redisCache)
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+	require.NoError(t, err)
+	request.Header.Set("X-Forwarded-Host", "attacker.example")
+
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "http://attacker.example/sitemaps/pages.xml")
+}
+
+func TestChildSitemapXMLAPICacheMissStoresDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	redisCache := mockcache.NewMockCache(ctrl)
+
+	redisCache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(0)), gomock.Any()).
+		Times(2).
+		Return(false, nil)
+	redisCache.EXPECT().
+		Get(gomock.Any(), gomock.Eq(key.GetSitemapKey(0, sitemapPagesName)), gomock.Any()).
+		Times(1).
+		Return(false, nil)
+	store.EXPECT().
+		ListPublishedCategorySitemapItems(gomock.Any()).
+		Times(1).
+		Return([]db.ListPublishedCategorySitemapItemsRow{}, nil)
+	redisCache.EXPECT().
+		Set(gomock.Any(), gomock.Eq(key.GetSitemapKey(0, sitemapPagesName)), gomock.Any(), durationBetween(cachepkg.SitemapTTL, cachepkg.SitemapTTL+cachepkg.SitemapTTL/10)).
+		Times(1).
+		DoAndReturn(func(_ any, _ string, value any, _ any) error {
+			require.Contains(t, value, "<urlset")
+			return nil
+		})
+
+	server := newTestServer(t, store, nil, redisCache)
+	server.config.Domain = "https://blog.example.com"
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/sitemaps/pages.xml", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
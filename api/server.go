@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	"github.com/MonitorAllen/nostalgia/token"
@@ -75,6 +77,14 @@ func (server *Server) setupRouter() {
 	router.GET("/readyz", server.readyz)
 	router.GET("/robots.txt", server.robotsTxt)
 	router.GET("/sitemap.xml", server.sitemapXML)
+	router.GET("/sitemaps/:name", server.childSitemapXML)
+	// IndexNow 要求在站点根目录提供与密钥同名的验证文件
+	if key := strings.TrimSpace(server.config.IndexNowKey); indexnow.ValidKey(key) {
+		router.GET("/"+key+".txt", server.indexNowKeyFile)
+	}
+	// 爬虫访问的文章与分类页面由 nginx 转发到这里输出元数据
+	router.GET("/article/:key", server.articlePage)
+	router.GET("/category/:id", server.categoryPage)
 
 	public := router.Group("/api")
 	{
@@ -88,17 +98,23 @@ func (server *Server) setupRouter() {
 		public.GET("/users/verify_email", server.verifyEmail)
 		public.GET("/users/contributions", server.contributions)
 
-		public.GET("/articles/:id", server.getArticle)
-		public.GET("/articles/slug/:slug", server.getArticleBySlug)
-		public.GET("/articles", server.listArticle)
+		public.GET("/articles/:id", httpCacheMiddleware(articleDetailHTTPCachePolicy, server.cachedGetArticleResponse), server.getArticle)
+		public.GET("/articles/slug/:slug", httpCacheMiddleware(articleDetailHTTPCachePolicy, server.cachedGetArticleBySlugResponse), server.getArticleBySlug)
+		public.GET("/articles/:id/related", httpCacheMiddleware(relatedArticleHTTPCachePolicy, server.cachedListRelatedArticlesResponse), server.listRelatedArticles)
+		public.GET("/articles", httpCacheMiddleware(articleHTTPCachePolicy, server.cachedListArticleResponse), server.listArticle)
 		public.PATCH("/articles/increment_likes", server.incrementArticleLikes)
 		public.PATCH("/articles/increment_views", server.incrementArticleViews)
 		public.GET("/articles/search", server.searchArticle)
+		public.GET("/articles/suggest", server.suggestArticles)
+		public.POST("/articles/search/clicks", server.recordSearchClick)
 
 		public.GET("/comments/:article_id", server.listCommentsByArticleID)
+		public.GET("/comments/:article_id/replies/:parent_id", server.listCommentReplies)
+
+		public.GET("/categories", httpCacheMiddleware(categoryHTTPCachePolicy, server.cachedListCategoriesResponse), server.listCategories)
+		public.GET("/categories/:id", httpCacheMiddleware(categoryHTTPCachePolicy, nil), server.getCategory)
 
-		public.GET("/categories", server.listCategories)
-		public.GET("/categories/:id", server.getCategory)
+		public.GET("/redirects/resolve", server.resolveRedirect)
 	}
 
 	authRoutes := router.Group("/api").Use(authMiddleware(server.tokenMaker))
@@ -109,6 +125,8 @@ func (server *Server) setupRouter() {
 		authRoutes.POST("/upload_file/", server.uploadFile).Use(uploadFileMiddleware(server.config))
 	}
 
+	router.NoRoute(server.noRoute)
+
 	server.router = router
 }
 
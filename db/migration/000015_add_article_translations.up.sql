@@ -0,0 +1,14 @@
+CREATE TABLE article_translations (
+    id bigserial PRIMARY KEY,
+    source_article_id uuid NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    translated_article_id uuid NOT NULL UNIQUE REFERENCES articles (id) ON DELETE CASCADE,
+    locale varchar(16) NOT NULL,
+    model text NOT NULL DEFAULT '',
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT article_translations_source_locale_unique UNIQUE (source_article_id, locale)
+);
+
+COMMENT ON COLUMN article_translations.source_article_id IS '原文文章';
+COMMENT ON COLUMN article_translations.translated_article_id IS '译文草稿';
+COMMENT ON COLUMN article_translations.locale IS '译文语言';
@@ -0,0 +1,12 @@
+CREATE TABLE ai_prompt_template_versions (
+    id bigserial PRIMARY KEY,
+    purpose varchar(64) NOT NULL,
+    version integer NOT NULL,
+    prompt_templates jsonb NOT NULL DEFAULT '{}'::jsonb,
+    rollback_of integer,
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT ai_prompt_template_versions_purpose_version_unique UNIQUE (purpose, version)
+);
+
+COMMENT ON COLUMN ai_prompt_template_versions.rollback_of IS '回滚来源版本';
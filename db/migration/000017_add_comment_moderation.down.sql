@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS comments_held_idx;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_status_check,
+    DROP COLUMN IF EXISTS moderation_reason,
+    DROP COLUMN IF EXISTS status;
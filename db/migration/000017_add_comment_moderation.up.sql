@@ -0,0 +1,9 @@
+ALTER TABLE comments
+    ADD COLUMN status varchar(16) NOT NULL DEFAULT 'published',
+    ADD COLUMN moderation_reason text NOT NULL DEFAULT '',
+    ADD CONSTRAINT comments_status_check CHECK (status IN ('published', 'held', 'rejected'));
+
+CREATE INDEX comments_held_idx ON comments (created_at DESC) WHERE status = 'held';
+
+COMMENT ON COLUMN comments.status IS '审核状态';
+COMMENT ON COLUMN comments.moderation_reason IS '审核原因';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tags_name_prefix_pgroonga_idx;
+DROP INDEX IF EXISTS articles_title_prefix_pgroonga_idx;
@@ -0,0 +1,5 @@
+CREATE INDEX articles_title_prefix_pgroonga_idx ON articles
+    USING pgroonga (title pgroonga_text_term_search_ops_v2);
+
+CREATE INDEX tags_name_prefix_pgroonga_idx ON tags
+    USING pgroonga (name pgroonga_text_term_search_ops_v2);
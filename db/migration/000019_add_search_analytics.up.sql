@@ -0,0 +1,22 @@
+CREATE TABLE search_queries (
+    id           bigserial PRIMARY KEY,
+    query        varchar(100) NOT NULL,
+    result_count bigint       NOT NULL DEFAULT 0,
+    created_at   timestamptz  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX search_queries_created_at_idx ON search_queries (created_at);
+
+CREATE TABLE search_clicks (
+    id              bigserial PRIMARY KEY,
+    search_query_id bigint      NOT NULL REFERENCES search_queries (id) ON DELETE CASCADE,
+    article_id      uuid        NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    position        int         NOT NULL,
+    created_at      timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX search_clicks_search_query_id_idx ON search_clicks (search_query_id);
+
+COMMENT ON COLUMN search_queries.query IS '规范化并脱敏后的查询词';
+COMMENT ON COLUMN search_queries.result_count IS '命中数量';
+COMMENT ON COLUMN search_clicks.position IS '点击结果在列表中的位置，从 1 开始';
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS articles_weighted_search_pgroonga_idx;
+
+CREATE INDEX articles_search_pgroonga_idx ON articles
+    USING pgroonga ((title || ' ' || summary || ' ' || content));
+
+DROP TRIGGER IF EXISTS categories_refresh_article_search_category ON categories;
+DROP TRIGGER IF EXISTS articles_set_search_category ON articles;
+DROP TRIGGER IF EXISTS tags_refresh_article_search_tags ON tags;
+
+DROP FUNCTION IF EXISTS refresh_category_search_name();
+DROP FUNCTION IF EXISTS set_article_search_category();
+DROP FUNCTION IF EXISTS refresh_article_search_tags();
+DROP FUNCTION IF EXISTS article_search_tags(uuid);
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS search_category,
+    DROP COLUMN IF EXISTS search_tags;
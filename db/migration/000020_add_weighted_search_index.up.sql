@@ -0,0 +1,61 @@
+-- 标签与分类名冗余到文章表，供 PGroonga 多列索引使用
+ALTER TABLE articles
+    ADD COLUMN search_tags     text NOT NULL DEFAULT '',
+    ADD COLUMN search_category text NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN articles.search_tags IS '标签名，由触发器维护';
+COMMENT ON COLUMN articles.search_category IS '分类名，由触发器维护';
+
+CREATE FUNCTION article_search_tags(article uuid) RETURNS text AS $$
+    SELECT COALESCE(string_agg(t.name, ' ' ORDER BY t.name), '')
+    FROM tags t
+    WHERE t.article_id = article;
+$$ LANGUAGE sql STABLE;
+
+CREATE FUNCTION refresh_article_search_tags() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE articles SET search_tags = article_search_tags(OLD.article_id) WHERE id = OLD.article_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE articles SET search_tags = article_search_tags(NEW.article_id) WHERE id = NEW.article_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tags_refresh_article_search_tags
+    AFTER INSERT OR UPDATE OR DELETE ON tags
+    FOR EACH ROW EXECUTE FUNCTION refresh_article_search_tags();
+
+CREATE FUNCTION set_article_search_category() RETURNS trigger AS $$
+BEGIN
+    NEW.search_category := COALESCE((SELECT c.name FROM categories c WHERE c.id = NEW.category_id), '');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER articles_set_search_category
+    BEFORE INSERT OR UPDATE OF category_id ON articles
+    FOR EACH ROW EXECUTE FUNCTION set_article_search_category();
+
+CREATE FUNCTION refresh_category_search_name() RETURNS trigger AS $$
+BEGIN
+    UPDATE articles SET search_category = NEW.name WHERE category_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER categories_refresh_article_search_category
+    AFTER UPDATE OF name ON categories
+    FOR EACH ROW EXECUTE FUNCTION refresh_category_search_name();
+
+UPDATE articles a
+SET search_tags     = article_search_tags(a.id),
+    search_category = COALESCE((SELECT c.name FROM categories c WHERE c.id = a.category_id), '');
+
+-- 正文去除 HTML 标签后再分词，查询时的表达式必须与索引保持一致
+DROP INDEX IF EXISTS articles_search_pgroonga_idx;
+
+CREATE INDEX articles_weighted_search_pgroonga_idx ON articles
+    USING pgroonga ((ARRAY [title, summary, regexp_replace(content, '<[^>]*>', ' ', 'g'), search_tags, search_category]));
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS indexnow_submissions;
@@ -0,0 +1,15 @@
+CREATE TABLE indexnow_submissions (
+    id          bigserial PRIMARY KEY,
+    endpoint    varchar     NOT NULL,
+    urls        text[]      NOT NULL,
+    status_code int         NOT NULL DEFAULT 0,
+    error       text        NOT NULL DEFAULT '',
+    attempt     int         NOT NULL DEFAULT 1,
+    created_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX indexnow_submissions_created_at_idx ON indexnow_submissions (created_at);
+
+COMMENT ON COLUMN indexnow_submissions.status_code IS '搜索引擎响应状态码，请求未发出时为 0';
+COMMENT ON COLUMN indexnow_submissions.error IS '失败原因，成功时为空';
+COMMENT ON COLUMN indexnow_submissions.attempt IS '第几次尝试，从 1 开始';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS redirects;
+DROP TABLE IF EXISTS article_slug_histories;
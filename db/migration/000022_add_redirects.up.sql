@@ -0,0 +1,22 @@
+CREATE TABLE article_slug_histories (
+    slug       varchar(100) PRIMARY KEY,
+    article_id uuid         NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    created_at timestamptz  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX article_slug_histories_article_id_idx ON article_slug_histories (article_id);
+
+COMMENT ON TABLE article_slug_histories IS '文章曾经使用过的短标识，用于旧链接跳转';
+
+CREATE TABLE redirects (
+    id          bigserial PRIMARY KEY,
+    source_path varchar(255)  NOT NULL UNIQUE,
+    target_path varchar(2048) NOT NULL,
+    status_code int           NOT NULL DEFAULT 301,
+    created_at  timestamptz   NOT NULL DEFAULT now(),
+    updated_at  timestamptz   NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN redirects.source_path IS '站内路径，以 / 开头，不含查询参数';
+COMMENT ON COLUMN redirects.target_path IS '站内路径或完整的 http(s) 地址';
+COMMENT ON COLUMN redirects.status_code IS '301、302、307 或 308';
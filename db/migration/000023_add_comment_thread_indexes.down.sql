@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS comments_parent_idx;
+DROP INDEX IF EXISTS comments_article_thread_idx;
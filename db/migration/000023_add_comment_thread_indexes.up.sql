@@ -0,0 +1,2 @@
+CREATE INDEX comments_article_thread_idx ON comments (article_id, parent_id, id) WHERE status = 'published';
+CREATE INDEX comments_parent_idx ON comments (parent_id, id) WHERE status = 'published';
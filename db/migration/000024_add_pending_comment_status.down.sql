@@ -0,0 +1,7 @@
+UPDATE comments SET status = 'held' WHERE status = 'pending';
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_status_check,
+    ADD CONSTRAINT comments_status_check CHECK (status IN ('published', 'held', 'rejected'));
+
+COMMENT ON COLUMN comments.status IS '审核状态';
@@ -0,0 +1,5 @@
+ALTER TABLE comments
+    DROP CONSTRAINT comments_status_check,
+    ADD CONSTRAINT comments_status_check CHECK (status IN ('pending', 'published', 'held', 'rejected'));
+
+COMMENT ON COLUMN comments.status IS '审核状态，pending 表示等待 AI 审核';
@@ -0,0 +1,6 @@
+CREATE TABLE article_counter_flushes (
+    batch_id   varchar(64) PRIMARY KEY,
+    flushed_at timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE article_counter_flushes IS '已写回数据库的计数批次，重试同一批次时跳过';
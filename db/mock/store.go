@@ -7,6 +7,7 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -66,6 +67,21 @@ func (mr *MockStoreMockRecorder) BlockUserSessions(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockStore)(nil).BlockUserSessions), arg0, arg1)
 }
 
+// CountAIPromptTemplateVersions mocks base method.
+func (m *MockStore) CountAIPromptTemplateVersions(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAIPromptTemplateVersions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAIPromptTemplateVersions indicates an expected call of CountAIPromptTemplateVersions.
+func (mr *MockStoreMockRecorder) CountAIPromptTemplateVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAIPromptTemplateVersions", reflect.TypeOf((*MockStore)(nil).CountAIPromptTemplateVersions), arg0, arg1)
+}
+
 // CountAdminUsers mocks base method.
 func (m *MockStore) CountAdminUsers(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +127,21 @@ func (mr *MockStoreMockRecorder) CountAllArticles(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllArticles", reflect.TypeOf((*MockStore)(nil).CountAllArticles), arg0, arg1)
 }
 
+// CountArticleComments mocks base method.
+func (m *MockStore) CountArticleComments(arg0 context.Context, arg1 uuid.UUID) (db.CountArticleCommentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountArticleComments", arg0, arg1)
+	ret0, _ := ret[0].(db.CountArticleCommentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountArticleComments indicates an expected call of CountArticleComments.
+func (mr *MockStoreMockRecorder) CountArticleComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountArticleComments", reflect.TypeOf((*MockStore)(nil).CountArticleComments), arg0, arg1)
+}
+
 // CountArticles mocks base method.
 func (m *MockStore) CountArticles(arg0 context.Context, arg1 db.CountArticlesParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +202,36 @@ func (mr *MockStoreMockRecorder) CountCategories(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCategories", reflect.TypeOf((*MockStore)(nil).CountCategories), arg0)
 }
 
+// CountHeldComments mocks base method.
+func (m *MockStore) CountHeldComments(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHeldComments", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountHeldComments indicates an expected call of CountHeldComments.
+func (mr *MockStoreMockRecorder) CountHeldComments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHeldComments", reflect.TypeOf((*MockStore)(nil).CountHeldComments), arg0)
+}
+
+// CountRedirects mocks base method.
+func (m *MockStore) CountRedirects(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRedirects", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRedirects indicates an expected call of CountRedirects.
+func (mr *MockStoreMockRecorder) CountRedirects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRedirects", reflect.TypeOf((*MockStore)(nil).CountRedirects), arg0)
+}
+
 // CountSearchArticles mocks base method.
 func (m *MockStore) CountSearchArticles(arg0 context.Context, arg1 db.CountSearchArticlesParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +247,21 @@ func (mr *MockStoreMockRecorder) CountSearchArticles(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchArticles", reflect.TypeOf((*MockStore)(nil).CountSearchArticles), arg0, arg1)
 }
 
+// CreateAIPromptTemplateVersion mocks base method.
+func (m *MockStore) CreateAIPromptTemplateVersion(arg0 context.Context, arg1 db.CreateAIPromptTemplateVersionParams) (db.AiPromptTemplateVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAIPromptTemplateVersion", arg0, arg1)
+	ret0, _ := ret[0].(db.AiPromptTemplateVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAIPromptTemplateVersion indicates an expected call of CreateAIPromptTemplateVersion.
+func (mr *MockStoreMockRecorder) CreateAIPromptTemplateVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAIPromptTemplateVersion", reflect.TypeOf((*MockStore)(nil).CreateAIPromptTemplateVersion), arg0, arg1)
+}
+
 // CreateArticle mocks base method.
 func (m *MockStore) CreateArticle(arg0 context.Context, arg1 db.CreateArticleParams) (db.Article, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +277,51 @@ func (mr *MockStoreMockRecorder) CreateArticle(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*MockStore)(nil).CreateArticle), arg0, arg1)
 }
 
+// CreateArticleCounterFlush mocks base method.
+func (m *MockStore) CreateArticleCounterFlush(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleCounterFlush", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleCounterFlush indicates an expected call of CreateArticleCounterFlush.
+func (mr *MockStoreMockRecorder) CreateArticleCounterFlush(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleCounterFlush", reflect.TypeOf((*MockStore)(nil).CreateArticleCounterFlush), arg0, arg1)
+}
+
+// CreateArticleTranslation mocks base method.
+func (m *MockStore) CreateArticleTranslation(arg0 context.Context, arg1 db.CreateArticleTranslationParams) (db.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleTranslation", arg0, arg1)
+	ret0, _ := ret[0].(db.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleTranslation indicates an expected call of CreateArticleTranslation.
+func (mr *MockStoreMockRecorder) CreateArticleTranslation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleTranslation", reflect.TypeOf((*MockStore)(nil).CreateArticleTranslation), arg0, arg1)
+}
+
+// CreateArticleTranslationTx mocks base method.
+func (m *MockStore) CreateArticleTranslationTx(arg0 context.Context, arg1 db.CreateArticleTranslationTxParams) (db.CreateArticleTranslationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleTranslationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateArticleTranslationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleTranslationTx indicates an expected call of CreateArticleTranslationTx.
+func (mr *MockStoreMockRecorder) CreateArticleTranslationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleTranslationTx", reflect.TypeOf((*MockStore)(nil).CreateArticleTranslationTx), arg0, arg1)
+}
+
 // CreateAutomationArticle mocks base method.
 func (m *MockStore) CreateAutomationArticle(arg0 context.Context, arg1 db.CreateAutomationArticleParams) (db.Article, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +412,66 @@ func (mr *MockStoreMockRecorder) CreateComment(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockStore)(nil).CreateComment), arg0, arg1)
 }
 
+// CreateIndexNowSubmission mocks base method.
+func (m *MockStore) CreateIndexNowSubmission(arg0 context.Context, arg1 db.CreateIndexNowSubmissionParams) (db.IndexnowSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndexNowSubmission", arg0, arg1)
+	ret0, _ := ret[0].(db.IndexnowSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndexNowSubmission indicates an expected call of CreateIndexNowSubmission.
+func (mr *MockStoreMockRecorder) CreateIndexNowSubmission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndexNowSubmission", reflect.TypeOf((*MockStore)(nil).CreateIndexNowSubmission), arg0, arg1)
+}
+
+// CreateRedirect mocks base method.
+func (m *MockStore) CreateRedirect(arg0 context.Context, arg1 db.CreateRedirectParams) (db.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedirect", arg0, arg1)
+	ret0, _ := ret[0].(db.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRedirect indicates an expected call of CreateRedirect.
+func (mr *MockStoreMockRecorder) CreateRedirect(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedirect", reflect.TypeOf((*MockStore)(nil).CreateRedirect), arg0, arg1)
+}
+
+// CreateSearchClick mocks base method.
+func (m *MockStore) CreateSearchClick(arg0 context.Context, arg1 db.CreateSearchClickParams) (db.SearchClick, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchClick", arg0, arg1)
+	ret0, _ := ret[0].(db.SearchClick)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchClick indicates an expected call of CreateSearchClick.
+func (mr *MockStoreMockRecorder) CreateSearchClick(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchClick", reflect.TypeOf((*MockStore)(nil).CreateSearchClick), arg0, arg1)
+}
+
+// CreateSearchQuery mocks base method.
+func (m *MockStore) CreateSearchQuery(arg0 context.Context, arg1 db.CreateSearchQueryParams) (db.SearchQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchQuery", arg0, arg1)
+	ret0, _ := ret[0].(db.SearchQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchQuery indicates an expected call of CreateSearchQuery.
+func (mr *MockStoreMockRecorder) CreateSearchQuery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchQuery", reflect.TypeOf((*MockStore)(nil).CreateSearchQuery), arg0, arg1)
+}
+
 // CreateSession mocks base method.
 func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +561,20 @@ func (mr *MockStoreMockRecorder) DeleteArticle(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*MockStore)(nil).DeleteArticle), arg0, arg1)
 }
 
+// DeleteArticleSlugHistory mocks base method.
+func (m *MockStore) DeleteArticleSlugHistory(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleSlugHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleSlugHistory indicates an expected call of DeleteArticleSlugHistory.
+func (mr *MockStoreMockRecorder) DeleteArticleSlugHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleSlugHistory", reflect.TypeOf((*MockStore)(nil).DeleteArticleSlugHistory), arg0, arg1)
+}
+
 // DeleteArticleTx mocks base method.
 func (m *MockStore) DeleteArticleTx(arg0 context.Context, arg1 db.DeleteArticleTxParams) error {
 	m.ctrl.T.Helper()
@@ -492,6 +687,66 @@ func (mr *MockStoreMockRecorder) DeleteCommentsByCategoryID(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentsByCategoryID", reflect.TypeOf((*MockStore)(nil).DeleteCommentsByCategoryID), arg0, arg1)
 }
 
+// DeleteExpiredArticleCounterFlushes mocks base method.
+func (m *MockStore) DeleteExpiredArticleCounterFlushes(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredArticleCounterFlushes", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredArticleCounterFlushes indicates an expected call of DeleteExpiredArticleCounterFlushes.
+func (mr *MockStoreMockRecorder) DeleteExpiredArticleCounterFlushes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredArticleCounterFlushes", reflect.TypeOf((*MockStore)(nil).DeleteExpiredArticleCounterFlushes), arg0, arg1)
+}
+
+// DeleteExpiredSessions mocks base method.
+func (m *MockStore) DeleteExpiredSessions(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredSessions indicates an expected call of DeleteExpiredSessions.
+func (mr *MockStoreMockRecorder) DeleteExpiredSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredSessions", reflect.TypeOf((*MockStore)(nil).DeleteExpiredSessions), arg0, arg1)
+}
+
+// DeleteExpiredVerifyEmails mocks base method.
+func (m *MockStore) DeleteExpiredVerifyEmails(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredVerifyEmails", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredVerifyEmails indicates an expected call of DeleteExpiredVerifyEmails.
+func (mr *MockStoreMockRecorder) DeleteExpiredVerifyEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredVerifyEmails", reflect.TypeOf((*MockStore)(nil).DeleteExpiredVerifyEmails), arg0, arg1)
+}
+
+// DeleteRedirect mocks base method.
+func (m *MockStore) DeleteRedirect(arg0 context.Context, arg1 int64) (db.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRedirect", arg0, arg1)
+	ret0, _ := ret[0].(db.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRedirect indicates an expected call of DeleteRedirect.
+func (mr *MockStoreMockRecorder) DeleteRedirect(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedirect", reflect.TypeOf((*MockStore)(nil).DeleteRedirect), arg0, arg1)
+}
+
 // DisableVisitorUser mocks base method.
 func (m *MockStore) DisableVisitorUser(arg0 context.Context, arg1 db.DisableVisitorUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +792,36 @@ func (mr *MockStoreMockRecorder) EnableVisitorUser(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableVisitorUser", reflect.TypeOf((*MockStore)(nil).EnableVisitorUser), arg0, arg1)
 }
 
+// FlushArticleCountersTx mocks base method.
+func (m *MockStore) FlushArticleCountersTx(arg0 context.Context, arg1 db.FlushArticleCountersTxParams) (db.FlushArticleCountersTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushArticleCountersTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FlushArticleCountersTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushArticleCountersTx indicates an expected call of FlushArticleCountersTx.
+func (mr *MockStoreMockRecorder) FlushArticleCountersTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushArticleCountersTx", reflect.TypeOf((*MockStore)(nil).FlushArticleCountersTx), arg0, arg1)
+}
+
+// GetAIPromptTemplateVersion mocks base method.
+func (m *MockStore) GetAIPromptTemplateVersion(arg0 context.Context, arg1 db.GetAIPromptTemplateVersionParams) (db.AiPromptTemplateVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIPromptTemplateVersion", arg0, arg1)
+	ret0, _ := ret[0].(db.AiPromptTemplateVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIPromptTemplateVersion indicates an expected call of GetAIPromptTemplateVersion.
+func (mr *MockStoreMockRecorder) GetAIPromptTemplateVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIPromptTemplateVersion", reflect.TypeOf((*MockStore)(nil).GetAIPromptTemplateVersion), arg0, arg1)
+}
+
 // GetAIProviderConfig mocks base method.
 func (m *MockStore) GetAIProviderConfig(arg0 context.Context, arg1 string) (db.AiProviderConfig, error) {
 	m.ctrl.T.Helper()
@@ -597,6 +882,36 @@ func (mr *MockStoreMockRecorder) GetArticleForUpdate(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleForUpdate", reflect.TypeOf((*MockStore)(nil).GetArticleForUpdate), arg0, arg1)
 }
 
+// GetArticleSlugRedirect mocks base method.
+func (m *MockStore) GetArticleSlugRedirect(arg0 context.Context, arg1 string) (db.GetArticleSlugRedirectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleSlugRedirect", arg0, arg1)
+	ret0, _ := ret[0].(db.GetArticleSlugRedirectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleSlugRedirect indicates an expected call of GetArticleSlugRedirect.
+func (mr *MockStoreMockRecorder) GetArticleSlugRedirect(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleSlugRedirect", reflect.TypeOf((*MockStore)(nil).GetArticleSlugRedirect), arg0, arg1)
+}
+
+// GetArticleTranslation mocks base method.
+func (m *MockStore) GetArticleTranslation(arg0 context.Context, arg1 db.GetArticleTranslationParams) (db.ArticleTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleTranslation", arg0, arg1)
+	ret0, _ := ret[0].(db.ArticleTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleTranslation indicates an expected call of GetArticleTranslation.
+func (mr *MockStoreMockRecorder) GetArticleTranslation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleTranslation", reflect.TypeOf((*MockStore)(nil).GetArticleTranslation), arg0, arg1)
+}
+
 // GetAutomationArticleRequestByIdempotencyKey mocks base method.
 func (m *MockStore) GetAutomationArticleRequestByIdempotencyKey(arg0 context.Context, arg1 string) (db.AutomationArticleRequest, error) {
 	m.ctrl.T.Helper()
@@ -672,6 +987,51 @@ func (mr *MockStoreMockRecorder) GetFirstAdminUser(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstAdminUser", reflect.TypeOf((*MockStore)(nil).GetFirstAdminUser), arg0)
 }
 
+// GetLatestAIPromptTemplateVersion mocks base method.
+func (m *MockStore) GetLatestAIPromptTemplateVersion(arg0 context.Context, arg1 string) (db.AiPromptTemplateVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestAIPromptTemplateVersion", arg0, arg1)
+	ret0, _ := ret[0].(db.AiPromptTemplateVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestAIPromptTemplateVersion indicates an expected call of GetLatestAIPromptTemplateVersion.
+func (mr *MockStoreMockRecorder) GetLatestAIPromptTemplateVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAIPromptTemplateVersion", reflect.TypeOf((*MockStore)(nil).GetLatestAIPromptTemplateVersion), arg0, arg1)
+}
+
+// GetRedirectBySourcePath mocks base method.
+func (m *MockStore) GetRedirectBySourcePath(arg0 context.Context, arg1 string) (db.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirectBySourcePath", arg0, arg1)
+	ret0, _ := ret[0].(db.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirectBySourcePath indicates an expected call of GetRedirectBySourcePath.
+func (mr *MockStoreMockRecorder) GetRedirectBySourcePath(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectBySourcePath", reflect.TypeOf((*MockStore)(nil).GetRedirectBySourcePath), arg0, arg1)
+}
+
+// GetSearchAnalyticsSummary mocks base method.
+func (m *MockStore) GetSearchAnalyticsSummary(arg0 context.Context, arg1 db.GetSearchAnalyticsSummaryParams) (db.GetSearchAnalyticsSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchAnalyticsSummary", arg0, arg1)
+	ret0, _ := ret[0].(db.GetSearchAnalyticsSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAnalyticsSummary indicates an expected call of GetSearchAnalyticsSummary.
+func (mr *MockStoreMockRecorder) GetSearchAnalyticsSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAnalyticsSummary", reflect.TypeOf((*MockStore)(nil).GetSearchAnalyticsSummary), arg0, arg1)
+}
+
 // GetSession mocks base method.
 func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -717,6 +1077,21 @@ func (mr *MockStoreMockRecorder) GetUserByUsername(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStore)(nil).GetUserByUsername), arg0, arg1)
 }
 
+// IncrementArticleCounters mocks base method.
+func (m *MockStore) IncrementArticleCounters(arg0 context.Context, arg1 db.IncrementArticleCountersParams) ([]db.IncrementArticleCountersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementArticleCounters", arg0, arg1)
+	ret0, _ := ret[0].([]db.IncrementArticleCountersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementArticleCounters indicates an expected call of IncrementArticleCounters.
+func (mr *MockStoreMockRecorder) IncrementArticleCounters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementArticleCounters", reflect.TypeOf((*MockStore)(nil).IncrementArticleCounters), arg0, arg1)
+}
+
 // IncrementArticleLikes mocks base method.
 func (m *MockStore) IncrementArticleLikes(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -745,6 +1120,36 @@ func (mr *MockStoreMockRecorder) IncrementArticleViews(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementArticleViews", reflect.TypeOf((*MockStore)(nil).IncrementArticleViews), arg0, arg1)
 }
 
+// IsResourceReferenced mocks base method.
+func (m *MockStore) IsResourceReferenced(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsResourceReferenced", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsResourceReferenced indicates an expected call of IsResourceReferenced.
+func (mr *MockStoreMockRecorder) IsResourceReferenced(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsResourceReferenced", reflect.TypeOf((*MockStore)(nil).IsResourceReferenced), arg0, arg1)
+}
+
+// ListAIPromptTemplateVersions mocks base method.
+func (m *MockStore) ListAIPromptTemplateVersions(arg0 context.Context, arg1 db.ListAIPromptTemplateVersionsParams) ([]db.ListAIPromptTemplateVersionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAIPromptTemplateVersions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListAIPromptTemplateVersionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAIPromptTemplateVersions indicates an expected call of ListAIPromptTemplateVersions.
+func (mr *MockStoreMockRecorder) ListAIPromptTemplateVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIPromptTemplateVersions", reflect.TypeOf((*MockStore)(nil).ListAIPromptTemplateVersions), arg0, arg1)
+}
+
 // ListAdminUsers mocks base method.
 func (m *MockStore) ListAdminUsers(arg0 context.Context, arg1 db.ListAdminUsersParams) ([]db.ListAdminUsersRow, error) {
 	m.ctrl.T.Helper()
@@ -790,6 +1195,21 @@ func (mr *MockStoreMockRecorder) ListAllCategories(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCategories", reflect.TypeOf((*MockStore)(nil).ListAllCategories), arg0)
 }
 
+// ListArticleCounters mocks base method.
+func (m *MockStore) ListArticleCounters(arg0 context.Context, arg1 []uuid.UUID) ([]db.ListArticleCountersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleCounters", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListArticleCountersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleCounters indicates an expected call of ListArticleCounters.
+func (mr *MockStoreMockRecorder) ListArticleCounters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleCounters", reflect.TypeOf((*MockStore)(nil).ListArticleCounters), arg0, arg1)
+}
+
 // ListArticleResourceRefsByCategoryID mocks base method.
 func (m *MockStore) ListArticleResourceRefsByCategoryID(arg0 context.Context, arg1 int64) ([]db.ListArticleResourceRefsByCategoryIDRow, error) {
 	m.ctrl.T.Helper()
@@ -850,34 +1270,94 @@ func (mr *MockStoreMockRecorder) ListCategoriesCountArticles(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoriesCountArticles", reflect.TypeOf((*MockStore)(nil).ListCategoriesCountArticles), arg0, arg1)
 }
 
-// ListCommentsByArticleID mocks base method.
-func (m *MockStore) ListCommentsByArticleID(arg0 context.Context, arg1 uuid.UUID) ([]db.ListCommentsByArticleIDRow, error) {
+// ListCommentReplies mocks base method.
+func (m *MockStore) ListCommentReplies(arg0 context.Context, arg1 db.ListCommentRepliesParams) ([]db.ListCommentRepliesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentReplies", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCommentRepliesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentReplies indicates an expected call of ListCommentReplies.
+func (mr *MockStoreMockRecorder) ListCommentReplies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentReplies", reflect.TypeOf((*MockStore)(nil).ListCommentReplies), arg0, arg1)
+}
+
+// ListCommentReplyPreviews mocks base method.
+func (m *MockStore) ListCommentReplyPreviews(arg0 context.Context, arg1 db.ListCommentReplyPreviewsParams) ([]db.ListCommentReplyPreviewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentReplyPreviews", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCommentReplyPreviewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentReplyPreviews indicates an expected call of ListCommentReplyPreviews.
+func (mr *MockStoreMockRecorder) ListCommentReplyPreviews(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentReplyPreviews", reflect.TypeOf((*MockStore)(nil).ListCommentReplyPreviews), arg0, arg1)
+}
+
+// ListHeldComments mocks base method.
+func (m *MockStore) ListHeldComments(arg0 context.Context, arg1 db.ListHeldCommentsParams) ([]db.ListHeldCommentsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCommentsByArticleID", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListCommentsByArticleIDRow)
+	ret := m.ctrl.Call(m, "ListHeldComments", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListHeldCommentsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCommentsByArticleID indicates an expected call of ListCommentsByArticleID.
-func (mr *MockStoreMockRecorder) ListCommentsByArticleID(arg0, arg1 interface{}) *gomock.Call {
+// ListHeldComments indicates an expected call of ListHeldComments.
+func (mr *MockStoreMockRecorder) ListHeldComments(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByArticleID", reflect.TypeOf((*MockStore)(nil).ListCommentsByArticleID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeldComments", reflect.TypeOf((*MockStore)(nil).ListHeldComments), arg0, arg1)
+}
+
+// ListPopularArticleIDs mocks base method.
+func (m *MockStore) ListPopularArticleIDs(arg0 context.Context, arg1 int32) ([]db.ListPopularArticleIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPopularArticleIDs", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListPopularArticleIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPopularArticleIDs indicates an expected call of ListPopularArticleIDs.
+func (mr *MockStoreMockRecorder) ListPopularArticleIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPopularArticleIDs", reflect.TypeOf((*MockStore)(nil).ListPopularArticleIDs), arg0, arg1)
 }
 
 // ListPublishedArticleSitemapItems mocks base method.
-func (m *MockStore) ListPublishedArticleSitemapItems(arg0 context.Context) ([]db.ListPublishedArticleSitemapItemsRow, error) {
+func (m *MockStore) ListPublishedArticleSitemapItems(arg0 context.Context, arg1 db.ListPublishedArticleSitemapItemsParams) ([]db.ListPublishedArticleSitemapItemsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPublishedArticleSitemapItems", arg0)
+	ret := m.ctrl.Call(m, "ListPublishedArticleSitemapItems", arg0, arg1)
 	ret0, _ := ret[0].([]db.ListPublishedArticleSitemapItemsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPublishedArticleSitemapItems indicates an expected call of ListPublishedArticleSitemapItems.
-func (mr *MockStoreMockRecorder) ListPublishedArticleSitemapItems(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) ListPublishedArticleSitemapItems(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedArticleSitemapItems", reflect.TypeOf((*MockStore)(nil).ListPublishedArticleSitemapItems), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedArticleSitemapItems", reflect.TypeOf((*MockStore)(nil).ListPublishedArticleSitemapItems), arg0, arg1)
+}
+
+// ListPublishedArticleSitemapPages mocks base method.
+func (m *MockStore) ListPublishedArticleSitemapPages(arg0 context.Context, arg1 int32) ([]db.ListPublishedArticleSitemapPagesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublishedArticleSitemapPages", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListPublishedArticleSitemapPagesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublishedArticleSitemapPages indicates an expected call of ListPublishedArticleSitemapPages.
+func (mr *MockStoreMockRecorder) ListPublishedArticleSitemapPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedArticleSitemapPages", reflect.TypeOf((*MockStore)(nil).ListPublishedArticleSitemapPages), arg0, arg1)
 }
 
 // ListPublishedCategorySitemapItems mocks base method.
@@ -895,6 +1375,140 @@ func (mr *MockStoreMockRecorder) ListPublishedCategorySitemapItems(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedCategorySitemapItems", reflect.TypeOf((*MockStore)(nil).ListPublishedCategorySitemapItems), arg0)
 }
 
+// ListRedirects mocks base method.
+func (m *MockStore) ListRedirects(arg0 context.Context, arg1 db.ListRedirectsParams) ([]db.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedirects", arg0, arg1)
+	ret0, _ := ret[0].([]db.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedirects indicates an expected call of ListRedirects.
+func (mr *MockStoreMockRecorder) ListRedirects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedirects", reflect.TypeOf((*MockStore)(nil).ListRedirects), arg0, arg1)
+}
+
+// ListRelatedArticles mocks base method.
+func (m *MockStore) ListRelatedArticles(arg0 context.Context, arg1 db.ListRelatedArticlesParams) ([]db.ListRelatedArticlesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelatedArticles", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRelatedArticlesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelatedArticles indicates an expected call of ListRelatedArticles.
+func (mr *MockStoreMockRecorder) ListRelatedArticles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedArticles", reflect.TypeOf((*MockStore)(nil).ListRelatedArticles), arg0, arg1)
+}
+
+// ListSearchArticleCategoryFacets mocks base method.
+func (m *MockStore) ListSearchArticleCategoryFacets(arg0 context.Context, arg1 db.ListSearchArticleCategoryFacetsParams) ([]db.ListSearchArticleCategoryFacetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSearchArticleCategoryFacets", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSearchArticleCategoryFacetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSearchArticleCategoryFacets indicates an expected call of ListSearchArticleCategoryFacets.
+func (mr *MockStoreMockRecorder) ListSearchArticleCategoryFacets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchArticleCategoryFacets", reflect.TypeOf((*MockStore)(nil).ListSearchArticleCategoryFacets), arg0, arg1)
+}
+
+// ListSearchArticleYearFacets mocks base method.
+func (m *MockStore) ListSearchArticleYearFacets(arg0 context.Context, arg1 db.ListSearchArticleYearFacetsParams) ([]db.ListSearchArticleYearFacetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSearchArticleYearFacets", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSearchArticleYearFacetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSearchArticleYearFacets indicates an expected call of ListSearchArticleYearFacets.
+func (mr *MockStoreMockRecorder) ListSearchArticleYearFacets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchArticleYearFacets", reflect.TypeOf((*MockStore)(nil).ListSearchArticleYearFacets), arg0, arg1)
+}
+
+// ListSearchVocabulary mocks base method.
+func (m *MockStore) ListSearchVocabulary(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSearchVocabulary", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSearchVocabulary indicates an expected call of ListSearchVocabulary.
+func (mr *MockStoreMockRecorder) ListSearchVocabulary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchVocabulary", reflect.TypeOf((*MockStore)(nil).ListSearchVocabulary), arg0)
+}
+
+// ListTopLevelComments mocks base method.
+func (m *MockStore) ListTopLevelComments(arg0 context.Context, arg1 db.ListTopLevelCommentsParams) ([]db.ListTopLevelCommentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopLevelComments", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTopLevelCommentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopLevelComments indicates an expected call of ListTopLevelComments.
+func (mr *MockStoreMockRecorder) ListTopLevelComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopLevelComments", reflect.TypeOf((*MockStore)(nil).ListTopLevelComments), arg0, arg1)
+}
+
+// ListTopSearchQueries mocks base method.
+func (m *MockStore) ListTopSearchQueries(arg0 context.Context, arg1 db.ListTopSearchQueriesParams) ([]db.ListTopSearchQueriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopSearchQueries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTopSearchQueriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopSearchQueries indicates an expected call of ListTopSearchQueries.
+func (mr *MockStoreMockRecorder) ListTopSearchQueries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopSearchQueries", reflect.TypeOf((*MockStore)(nil).ListTopSearchQueries), arg0, arg1)
+}
+
+// ListZeroResultSearchQueries mocks base method.
+func (m *MockStore) ListZeroResultSearchQueries(arg0 context.Context, arg1 db.ListZeroResultSearchQueriesParams) ([]db.ListZeroResultSearchQueriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZeroResultSearchQueries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListZeroResultSearchQueriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZeroResultSearchQueries indicates an expected call of ListZeroResultSearchQueries.
+func (mr *MockStoreMockRecorder) ListZeroResultSearchQueries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZeroResultSearchQueries", reflect.TypeOf((*MockStore)(nil).ListZeroResultSearchQueries), arg0, arg1)
+}
+
+// LockAIPromptTemplateVersions mocks base method.
+func (m *MockStore) LockAIPromptTemplateVersions(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAIPromptTemplateVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAIPromptTemplateVersions indicates an expected call of LockAIPromptTemplateVersions.
+func (mr *MockStoreMockRecorder) LockAIPromptTemplateVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAIPromptTemplateVersions", reflect.TypeOf((*MockStore)(nil).LockAIPromptTemplateVersions), arg0, arg1)
+}
+
 // MarkAutomationArticleRequestCreated mocks base method.
 func (m *MockStore) MarkAutomationArticleRequestCreated(arg0 context.Context, arg1 db.MarkAutomationArticleRequestCreatedParams) (db.AutomationArticleRequest, error) {
 	m.ctrl.T.Helper()
@@ -924,6 +1538,36 @@ func (mr *MockStoreMockRecorder) Ping(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), arg0)
 }
 
+// ResolvePendingComment mocks base method.
+func (m *MockStore) ResolvePendingComment(arg0 context.Context, arg1 db.ResolvePendingCommentParams) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePendingComment", arg0, arg1)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePendingComment indicates an expected call of ResolvePendingComment.
+func (mr *MockStoreMockRecorder) ResolvePendingComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePendingComment", reflect.TypeOf((*MockStore)(nil).ResolvePendingComment), arg0, arg1)
+}
+
+// SaveAIConfigTx mocks base method.
+func (m *MockStore) SaveAIConfigTx(arg0 context.Context, arg1 db.SaveAIConfigTxParams) (db.SaveAIConfigTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAIConfigTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SaveAIConfigTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAIConfigTx indicates an expected call of SaveAIConfigTx.
+func (mr *MockStoreMockRecorder) SaveAIConfigTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAIConfigTx", reflect.TypeOf((*MockStore)(nil).SaveAIConfigTx), arg0, arg1)
+}
+
 // SearchArticles mocks base method.
 func (m *MockStore) SearchArticles(arg0 context.Context, arg1 db.SearchArticlesParams) ([]db.SearchArticlesRow, error) {
 	m.ctrl.T.Helper()
@@ -953,6 +1597,36 @@ func (mr *MockStoreMockRecorder) SetArticleDefaultCategoryIdByCategoryId(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticleDefaultCategoryIdByCategoryId", reflect.TypeOf((*MockStore)(nil).SetArticleDefaultCategoryIdByCategoryId), arg0, arg1)
 }
 
+// SuggestArticleTitles mocks base method.
+func (m *MockStore) SuggestArticleTitles(arg0 context.Context, arg1 db.SuggestArticleTitlesParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestArticleTitles", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestArticleTitles indicates an expected call of SuggestArticleTitles.
+func (mr *MockStoreMockRecorder) SuggestArticleTitles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestArticleTitles", reflect.TypeOf((*MockStore)(nil).SuggestArticleTitles), arg0, arg1)
+}
+
+// SuggestTags mocks base method.
+func (m *MockStore) SuggestTags(arg0 context.Context, arg1 db.SuggestTagsParams) ([]db.SuggestTagsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTags", arg0, arg1)
+	ret0, _ := ret[0].([]db.SuggestTagsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTags indicates an expected call of SuggestTags.
+func (mr *MockStoreMockRecorder) SuggestTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTags", reflect.TypeOf((*MockStore)(nil).SuggestTags), arg0, arg1)
+}
+
 // UpdateArticle mocks base method.
 func (m *MockStore) UpdateArticle(arg0 context.Context, arg1 db.UpdateArticleParams) (db.Article, error) {
 	m.ctrl.T.Helper()
@@ -1013,6 +1687,36 @@ func (mr *MockStoreMockRecorder) UpdateCategoryTx(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryTx", reflect.TypeOf((*MockStore)(nil).UpdateCategoryTx), arg0, arg1)
 }
 
+// UpdateCommentModeration mocks base method.
+func (m *MockStore) UpdateCommentModeration(arg0 context.Context, arg1 db.UpdateCommentModerationParams) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentModeration", arg0, arg1)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCommentModeration indicates an expected call of UpdateCommentModeration.
+func (mr *MockStoreMockRecorder) UpdateCommentModeration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentModeration", reflect.TypeOf((*MockStore)(nil).UpdateCommentModeration), arg0, arg1)
+}
+
+// UpdateRedirect mocks base method.
+func (m *MockStore) UpdateRedirect(arg0 context.Context, arg1 db.UpdateRedirectParams) (db.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedirect", arg0, arg1)
+	ret0, _ := ret[0].(db.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRedirect indicates an expected call of UpdateRedirect.
+func (mr *MockStoreMockRecorder) UpdateRedirect(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedirect", reflect.TypeOf((*MockStore)(nil).UpdateRedirect), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -1073,6 +1777,20 @@ func (mr *MockStoreMockRecorder) UpsertAIProviderConfig(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAIProviderConfig", reflect.TypeOf((*MockStore)(nil).UpsertAIProviderConfig), arg0, arg1)
 }
 
+// UpsertArticleSlugHistory mocks base method.
+func (m *MockStore) UpsertArticleSlugHistory(arg0 context.Context, arg1 db.UpsertArticleSlugHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertArticleSlugHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertArticleSlugHistory indicates an expected call of UpsertArticleSlugHistory.
+func (mr *MockStoreMockRecorder) UpsertArticleSlugHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArticleSlugHistory", reflect.TypeOf((*MockStore)(nil).UpsertArticleSlugHistory), arg0, arg1)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(arg0 context.Context, arg1 db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,48 @@
+-- name: CreateAIPromptTemplateVersion :one
+INSERT INTO ai_prompt_template_versions (
+    purpose,
+    version,
+    prompt_templates,
+    rollback_of,
+    created_by
+)
+SELECT sqlc.arg(purpose),
+       COALESCE(MAX(version), 0) + 1,
+       sqlc.arg(prompt_templates),
+       sqlc.narg(rollback_of),
+       sqlc.narg(created_by)
+FROM ai_prompt_template_versions
+WHERE purpose = sqlc.arg(purpose)
+RETURNING *;
+
+-- name: GetAIPromptTemplateVersion :one
+SELECT *
+FROM ai_prompt_template_versions
+WHERE purpose = sqlc.arg(purpose)
+  AND version = sqlc.arg(version)
+LIMIT 1;
+
+-- name: GetLatestAIPromptTemplateVersion :one
+SELECT *
+FROM ai_prompt_template_versions
+WHERE purpose = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: ListAIPromptTemplateVersions :many
+SELECT v.*,
+       u.username AS author_username
+FROM ai_prompt_template_versions v
+         LEFT JOIN users u ON u.id = v.created_by
+WHERE v.purpose = $1
+ORDER BY v.version DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountAIPromptTemplateVersions :one
+SELECT count(*)
+FROM ai_prompt_template_versions
+WHERE purpose = $1;
+
+-- name: LockAIPromptTemplateVersions :exec
+-- 事务级锁，同一用途的版本号按 MAX(version) + 1 串行分配
+SELECT pg_advisory_xact_lock(hashtext('ai_prompt_template_versions:' || sqlc.arg(purpose)::text));
@@ -106,7 +106,8 @@ SELECT id,
        created_at,
        updated_at,
        deleted_at,
-       category_id
+       category_id,
+       slug
 FROM articles
 WHERE id = $1
 LIMIT 1 FOR NO KEY UPDATE;
@@ -165,6 +166,15 @@ SET title          = COALESCE(sqlc.narg(title), title),
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
+-- name: IncrementArticleCounters :many
+-- 批量写回缓冲的浏览量与点赞数，返回写回后的计数
+UPDATE articles AS a
+SET views = a.views + d.views,
+    likes = a.likes + d.likes
+FROM unnest(sqlc.arg(ids)::uuid[], sqlc.arg(views)::int[], sqlc.arg(likes)::int[]) AS d(id, views, likes)
+WHERE a.id = d.id
+RETURNING a.id, a.views, a.likes;
+
 -- name: IncrementArticleLikes :exec
 UPDATE articles
 SET likes = likes + 1
@@ -208,16 +218,42 @@ SELECT count(*)
 FROM articles a
 WHERE (sqlc.narg(title)::text IS NULL OR a.title ILIKE '%' || sqlc.narg(title)::text || '%');
 
+-- name: ListPopularArticleIDs :many
+-- 缓存预热使用，按浏览量取已发布文章
+SELECT id,
+       slug
+FROM articles
+WHERE is_publish = true
+  AND deleted_at = '0001-01-01 00:00:00Z'
+ORDER BY views DESC, created_at DESC
+LIMIT $1;
+
 -- name: ListPublishedArticleSitemapItems :many
+-- 按创建时间稳定排序，保证分页后的子站点地图内容不随文章更新而漂移
 SELECT id,
        slug,
        owner,
+       cover,
        created_at,
        updated_at
 FROM articles
 WHERE is_publish = true
   AND deleted_at = '0001-01-01 00:00:00Z'
-ORDER BY GREATEST(created_at, updated_at) DESC;
+ORDER BY created_at, id
+LIMIT $1 OFFSET $2;
+
+-- name: ListPublishedArticleSitemapPages :many
+-- 与 ListPublishedArticleSitemapItems 使用相同排序，返回每个分页的最后修改时间
+WITH ranked AS (SELECT GREATEST(created_at, updated_at) AS last_modified,
+                       (row_number() OVER (ORDER BY created_at, id) - 1) / sqlc.arg(page_size)::integer AS page_index
+                FROM articles
+                WHERE is_publish = true
+                  AND deleted_at = '0001-01-01 00:00:00Z')
+SELECT (page_index + 1)::integer      AS page,
+       MAX(last_modified)::timestamptz AS last_modified
+FROM ranked
+GROUP BY page_index
+ORDER BY page_index;
 
 -- name: ListPublishedCategorySitemapItems :many
 SELECT c.id,
@@ -229,6 +265,50 @@ WHERE a.is_publish = true
 GROUP BY c.id
 ORDER BY c.id;
 
+-- name: ListRelatedArticles :many
+-- 相关度：标题与摘要的相似度 + 共同标签数 * 3 + 同分类 2，权重为 0 的字段不参与匹配
+WITH source_tags AS (SELECT DISTINCT name
+                     FROM tags
+                     WHERE article_id = sqlc.arg(article_id)),
+     text_matches AS (SELECT a.id,
+                             pgroonga_score(a.tableoid, a.ctid) AS score
+                      FROM articles a
+                      WHERE sqlc.arg(keyword)::text <> ''
+                        AND ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+                          &@~ (sqlc.arg(keyword)::text, ARRAY [10, 3, 0, 0, 0], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition),
+     candidates AS (SELECT a.id,
+                           a.title,
+                           a.summary,
+                           a.cover,
+                           a.slug,
+                           a.read_time,
+                           a.created_at,
+                           COALESCE(tm.score, 0)::float8                                                AS text_score,
+                           (SELECT count(DISTINCT t.name)
+                            FROM tags t
+                                     INNER JOIN source_tags st ON st.name = t.name
+                            WHERE t.article_id = a.id)                                                   AS shared_tags,
+                           a.category_id = (SELECT category_id FROM articles WHERE id = sqlc.arg(article_id)) AS same_category
+                    FROM articles a
+                             LEFT JOIN text_matches tm ON tm.id = a.id
+                    WHERE a.id <> sqlc.arg(article_id)
+                      AND a.is_publish = true
+                      AND a.deleted_at = '0001-01-01 00:00:00Z')
+SELECT id,
+       title,
+       summary,
+       cover,
+       slug,
+       read_time,
+       created_at,
+       (text_score + shared_tags * 3 + CASE WHEN same_category THEN 2 ELSE 0 END)::float8 AS score
+FROM candidates
+WHERE text_score > 0
+   OR shared_tags > 0
+   OR same_category
+ORDER BY score DESC, created_at DESC
+LIMIT sqlc.arg(max_results);
+
 -- name: DeleteArticle :exec
 DELETE
 FROM articles
@@ -244,12 +324,20 @@ SELECT id, slug
 FROM articles
 WHERE category_id = $1;
 
+-- name: IsResourceReferenced :one
+-- 清理临时上传文件前确认没有文章正文或封面仍在引用
+SELECT EXISTS (
+    SELECT 1 FROM articles
+    WHERE cover = sqlc.arg(path)::text OR strpos(content, sqlc.arg(path)::text) > 0
+) AS referenced;
+
 -- name: SetArticleDefaultCategoryIdByCategoryId :exec
 UPDATE articles
 SET category_id = 1
 WHERE category_id = $1;
 
 -- name: SearchArticles :many
+-- 字段权重：标题 10、摘要 3、正文 1、标签 6、分类名 4
 SELECT a.id,
        a.title,
        a.summary,
@@ -265,21 +353,94 @@ SELECT a.id,
        a.created_at,
        a.updated_at,
        a.deleted_at,
+       a.category_id,
+       a.created_by_automation,
+       a.automation_status,
        c.name                             as category_name,
        u.username,
-       pgroonga_score(a.tableoid, a.ctid) AS score
+       pgroonga_score(a.tableoid, a.ctid) AS score,
+       pgroonga_highlight_html(a.title, pgroonga_query_extract_keywords(sqlc.arg(keyword)::text))::text AS highlighted_title,
+       COALESCE(
+           pgroonga_snippet_html(
+               regexp_replace(a.content, '<[^>]*>', ' ', 'g'),
+               pgroonga_query_extract_keywords(sqlc.arg(keyword)::text),
+               sqlc.arg(snippet_width)::integer
+           ),
+           '{}'
+       )::text[] AS snippets
 FROM articles a
          LEFT JOIN categories c on c.id = a.category_id
          LEFT JOIN users u on a.owner = u.id
-WHERE (title || ' ' || summary || ' ' || content) &@~ sqlc.arg(keyword)::text
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ (sqlc.arg(keyword)::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
   AND (sqlc.narg('is_publish')::boolean IS NULL OR a.is_publish = sqlc.narg('is_publish'))
+  AND (sqlc.narg('category_id')::bigint IS NULL OR a.category_id = sqlc.narg('category_id'))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = sqlc.narg('tag')
+  ))
+  AND (sqlc.narg('start_time')::timestamptz IS NULL OR a.created_at >= sqlc.narg('start_time'))
+  AND (sqlc.narg('end_time')::timestamptz IS NULL OR a.created_at < sqlc.narg('end_time'))
   AND a.deleted_at = '0001-01-01 00:00:00Z'
-ORDER BY score DESC, a.created_at DESC
+ORDER BY CASE WHEN sqlc.arg(sort_by)::text = 'newest' THEN a.created_at END DESC,
+         CASE WHEN sqlc.arg(sort_by)::text = 'most_viewed' THEN a.views END DESC,
+         score DESC,
+         a.created_at DESC
 LIMIT $1 OFFSET $2;
 
 -- name: CountSearchArticles :one
 SELECT count(*)
 FROM articles a
-WHERE (title || ' ' || summary || ' ' || content) &@~ sqlc.arg(keyword)::text
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ (sqlc.arg(keyword)::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
   AND (sqlc.narg('is_publish')::boolean IS NULL OR a.is_publish = sqlc.narg('is_publish'))
+  AND (sqlc.narg('category_id')::bigint IS NULL OR a.category_id = sqlc.narg('category_id'))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = sqlc.narg('tag')
+  ))
+  AND (sqlc.narg('start_time')::timestamptz IS NULL OR a.created_at >= sqlc.narg('start_time'))
+  AND (sqlc.narg('end_time')::timestamptz IS NULL OR a.created_at < sqlc.narg('end_time'))
   AND a.deleted_at = '0001-01-01 00:00:00Z';
+
+-- name: ListSearchArticleCategoryFacets :many
+-- 分类分面忽略分类筛选，便于切换分类
+SELECT a.category_id,
+       COALESCE(c.name, '')::text AS category_name,
+       count(*)                   AS count
+FROM articles a
+         LEFT JOIN categories c on c.id = a.category_id
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ (sqlc.arg(keyword)::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
+  AND (sqlc.narg('is_publish')::boolean IS NULL OR a.is_publish = sqlc.narg('is_publish'))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = sqlc.narg('tag')
+  ))
+  AND (sqlc.narg('start_time')::timestamptz IS NULL OR a.created_at >= sqlc.narg('start_time'))
+  AND (sqlc.narg('end_time')::timestamptz IS NULL OR a.created_at < sqlc.narg('end_time'))
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY a.category_id, c.name
+ORDER BY count DESC, a.category_id;
+
+-- name: ListSearchArticleYearFacets :many
+-- 年份分面忽略日期筛选，便于切换年份；年份按 time_zone 换算，需与日期筛选使用同一时区
+SELECT EXTRACT(YEAR FROM a.created_at AT TIME ZONE sqlc.arg(time_zone)::text)::integer AS year,
+       count(*)                                                                         AS count
+FROM articles a
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ (sqlc.arg(keyword)::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
+  AND (sqlc.narg('is_publish')::boolean IS NULL OR a.is_publish = sqlc.narg('is_publish'))
+  AND (sqlc.narg('category_id')::bigint IS NULL OR a.category_id = sqlc.narg('category_id'))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = sqlc.narg('tag')
+  ))
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY year
+ORDER BY year DESC;
+
+-- name: SuggestArticleTitles :many
+SELECT a.title
+FROM articles a
+WHERE a.title &^ sqlc.arg(prefix)::text
+  AND a.is_publish = true
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+ORDER BY a.views DESC, a.created_at DESC
+LIMIT sqlc.arg(max_results);
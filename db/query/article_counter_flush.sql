@@ -0,0 +1,15 @@
+-- name: CreateArticleCounterFlush :execrows
+-- 批次已经写回过时不插入，返回 0
+INSERT INTO article_counter_flushes (batch_id)
+VALUES ($1)
+ON CONFLICT (batch_id) DO NOTHING;
+
+-- name: DeleteExpiredArticleCounterFlushes :execrows
+DELETE FROM article_counter_flushes
+WHERE flushed_at < sqlc.arg(expired_before);
+
+-- name: ListArticleCounters :many
+-- 重试已写回的批次时读取当前计数
+SELECT id, views, likes
+FROM articles
+WHERE id = ANY (sqlc.arg(ids)::uuid[]);
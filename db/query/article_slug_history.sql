@@ -0,0 +1,22 @@
+-- name: DeleteArticleSlugHistory :exec
+-- 短标识重新被文章使用时移除对应的历史记录
+DELETE FROM article_slug_histories
+WHERE slug = $1;
+
+-- name: GetArticleSlugRedirect :one
+-- 根据旧短标识查找仍公开的文章及其当前短标识
+SELECT a.id,
+       a.slug
+FROM article_slug_histories h
+         JOIN articles a ON a.id = h.article_id
+WHERE h.slug = $1
+  AND a.is_publish = true
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+LIMIT 1;
+
+-- name: UpsertArticleSlugHistory :exec
+-- 同一个旧短标识只指向最近使用它的文章
+INSERT INTO article_slug_histories (slug, article_id)
+VALUES ($1, $2)
+ON CONFLICT (slug) DO UPDATE SET article_id = EXCLUDED.article_id,
+                                 created_at = now();
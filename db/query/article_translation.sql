@@ -0,0 +1,23 @@
+-- name: CreateArticleTranslation :one
+INSERT INTO article_translations (
+    source_article_id,
+    translated_article_id,
+    locale,
+    model,
+    created_by
+) VALUES (
+    sqlc.arg(source_article_id),
+    sqlc.arg(translated_article_id),
+    sqlc.arg(locale),
+    sqlc.arg(model),
+    sqlc.narg(created_by)
+)
+RETURNING *;
+
+-- name: GetArticleTranslation :one
+SELECT *
+FROM article_translations
+WHERE source_article_id = sqlc.arg(source_article_id)
+  AND lower(locale) = lower(sqlc.arg(locale))
+LIMIT 1;
+
@@ -1,17 +1,57 @@
 -- name: CreateComment :one
 INSERT INTO comments
-    (content, article_id, parent_id, from_user_id, to_user_id)
+    (content, article_id, parent_id, from_user_id, to_user_id, status, moderation_reason)
 VALUES
-    ($1, $2, $3, $4, $5)
+    ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
--- name: ListCommentsByArticleID :many
+-- name: ListTopLevelComments :many
+-- 按 id 游标分页顶层评论，回复数用于提示按需加载
+SELECT c.*, from_u.username as from_user_name, to_u.username as to_user_name,
+    (SELECT count(*) FROM comments r
+     WHERE r.parent_id = c.id AND r.deleted_at = '0001-01-01 00:00:00.000000 +00:00'
+       AND r.status = 'published') AS reply_count
+FROM comments c
+LEFT JOIN users from_u on c.from_user_id = from_u.id
+LEFT JOIN users to_u on c.to_user_id = to_u.id
+WHERE
+    c.article_id = sqlc.arg(article_id) AND c.parent_id = 0 AND c.id > sqlc.arg(after_id)
+    AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
+ORDER BY c.id
+LIMIT sqlc.arg(max_results);
+
+-- name: ListCommentReplyPreviews :many
+-- 每条顶层评论预取前几条回复，其余回复按需加载
+SELECT r.id, r.content, r.article_id, r.parent_id, r.likes, r.from_user_id, r.to_user_id, r.created_at, r.deleted_at, r.status, r.moderation_reason, r.from_user_name, r.to_user_name
+FROM (
+    SELECT c.*, from_u.username as from_user_name, to_u.username as to_user_name,
+        row_number() OVER (PARTITION BY c.parent_id ORDER BY c.id) AS position
+    FROM comments c
+    LEFT JOIN users from_u on c.from_user_id = from_u.id
+    LEFT JOIN users to_u on c.to_user_id = to_u.id
+    WHERE
+        c.parent_id = ANY(sqlc.arg(parent_ids)::bigint[])
+        AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
+) r
+WHERE r.position <= sqlc.arg(max_per_parent)::int
+ORDER BY r.parent_id, r.id;
+
+-- name: ListCommentReplies :many
 SELECT c.*, from_u.username as from_user_name, to_u.username as to_user_name FROM comments c
 LEFT JOIN users from_u on c.from_user_id = from_u.id
 LEFT JOIN users to_u on c.to_user_id = to_u.id
 WHERE
-    c.article_id = $1 AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00'
-ORDER BY c.id;
+    c.article_id = sqlc.arg(article_id) AND c.parent_id = sqlc.arg(parent_id) AND c.id > sqlc.arg(after_id)
+    AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
+ORDER BY c.id
+LIMIT sqlc.arg(max_results);
+
+-- name: CountArticleComments :one
+-- 文章头部展示的评论总数，只统计已发布的评论
+SELECT count(*) AS total, count(*) FILTER (WHERE parent_id = 0) AS top_level FROM comments
+WHERE
+    article_id = $1 AND deleted_at = '0001-01-01 00:00:00.000000 +00:00'
+    AND status = 'published';
 
 -- name: GetComment :one
 SELECT * FROM comments
@@ -23,6 +63,33 @@ SET likes = likes + 1
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateCommentModeration :one
+UPDATE comments
+SET status = sqlc.arg(status),
+    moderation_reason = sqlc.arg(moderation_reason)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ResolvePendingComment :one
+-- 只更新仍在等待 AI 审核的评论，管理员已处理的评论不会被覆盖
+UPDATE comments
+SET status = sqlc.arg(status),
+    moderation_reason = sqlc.arg(moderation_reason)
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: ListHeldComments :many
+SELECT c.*, a.title as article_title, from_u.username as from_user_name FROM comments c
+LEFT JOIN articles a on c.article_id = a.id
+LEFT JOIN users from_u on c.from_user_id = from_u.id
+WHERE c.status = 'held'
+ORDER BY c.created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountHeldComments :one
+SELECT count(*) FROM comments
+WHERE status = 'held';
+
 -- name: DeleteComment :exec
 DELETE FROM comments WHERE id = $1;
 
@@ -0,0 +1,4 @@
+-- name: CreateIndexNowSubmission :one
+INSERT INTO indexnow_submissions (endpoint, urls, status_code, error, attempt)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
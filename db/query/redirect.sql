@@ -0,0 +1,30 @@
+-- name: CountRedirects :one
+SELECT count(*) FROM redirects;
+
+-- name: CreateRedirect :one
+INSERT INTO redirects (source_path, target_path, status_code)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: DeleteRedirect :one
+DELETE FROM redirects
+WHERE id = $1
+RETURNING *;
+
+-- name: GetRedirectBySourcePath :one
+SELECT * FROM redirects
+WHERE source_path = $1
+LIMIT 1;
+
+-- name: ListRedirects :many
+SELECT * FROM redirects
+ORDER BY source_path
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateRedirect :one
+UPDATE redirects
+SET target_path = COALESCE(sqlc.narg(target_path), target_path),
+    status_code = COALESCE(sqlc.narg(status_code), status_code),
+    updated_at  = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,52 @@
+-- name: CreateSearchQuery :one
+INSERT INTO search_queries (query, result_count)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: CreateSearchClick :one
+INSERT INTO search_clicks (search_query_id, article_id, position)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetSearchAnalyticsSummary :one
+SELECT count(*)                                                  AS searches,
+       count(*) FILTER (WHERE q.result_count = 0)                AS zero_result_searches,
+       count(*) FILTER (WHERE EXISTS (
+           SELECT 1 FROM search_clicks c WHERE c.search_query_id = q.id
+       ))                                                        AS clicked_searches,
+       COALESCE((
+           SELECT avg(c.position)
+           FROM search_clicks c
+                    JOIN search_queries cq ON cq.id = c.search_query_id
+           WHERE cq.created_at >= sqlc.arg(start_time)
+             AND cq.created_at < sqlc.arg(end_time)
+       ), 0)::float8                                             AS avg_click_position
+FROM search_queries q
+WHERE q.created_at >= sqlc.arg(start_time)
+  AND q.created_at < sqlc.arg(end_time);
+
+-- name: ListTopSearchQueries :many
+SELECT q.query,
+       count(*)                                   AS searches,
+       count(*) FILTER (WHERE EXISTS (
+           SELECT 1 FROM search_clicks c WHERE c.search_query_id = q.id
+       ))                                         AS clicked_searches,
+       avg(q.result_count)::float8                AS avg_result_count
+FROM search_queries q
+WHERE q.created_at >= sqlc.arg(start_time)
+  AND q.created_at < sqlc.arg(end_time)
+GROUP BY q.query
+ORDER BY searches DESC, q.query
+LIMIT sqlc.arg(max_results);
+
+-- name: ListZeroResultSearchQueries :many
+SELECT q.query,
+       count(*)                      AS searches,
+       max(q.created_at)::timestamptz AS last_searched_at
+FROM search_queries q
+WHERE q.result_count = 0
+  AND q.created_at >= sqlc.arg(start_time)
+  AND q.created_at < sqlc.arg(end_time)
+GROUP BY q.query
+ORDER BY searches DESC, q.query
+LIMIT sqlc.arg(max_results);
@@ -19,3 +19,7 @@ LIMIT 1;
 UPDATE sessions
 SET is_blocked = true
 WHERE user_id = $1;
+
+-- name: DeleteExpiredSessions :execrows
+DELETE FROM sessions
+WHERE expires_at < sqlc.arg(expired_before);
@@ -0,0 +1,21 @@
+-- name: SuggestTags :many
+SELECT t.name::text AS name,
+       count(*)     AS article_count
+FROM tags t
+         JOIN articles a ON a.id = t.article_id
+WHERE t.name &^ sqlc.arg(prefix)::text
+  AND a.is_publish = true
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY t.name
+ORDER BY article_count DESC, t.name
+LIMIT sqlc.arg(max_results);
+
+-- name: ListSearchVocabulary :many
+SELECT DISTINCT t.name::text AS term
+FROM tags t
+         JOIN articles a ON a.id = t.article_id
+WHERE a.is_publish = true
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+UNION
+SELECT c.name::text AS term
+FROM categories c;
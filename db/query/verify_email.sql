@@ -16,4 +16,8 @@ WHERE
     AND secret_code = @secret_code
     AND is_used = FALSE
     AND expired_at > now()
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: DeleteExpiredVerifyEmails :execrows
+DELETE FROM verify_emails
+WHERE expired_at < sqlc.arg(expired_before);
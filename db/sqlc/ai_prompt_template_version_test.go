@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAIPromptTemplateVersionIncrementsVersion(t *testing.T) {
+	purpose := "ai_test_" + util.RandomString(8)
+	author := getOrCreateAdminUser(t)
+
+	first, err := testStore.CreateAIPromptTemplateVersion(context.Background(), CreateAIPromptTemplateVersionParams{
+		Purpose:         purpose,
+		PromptTemplates: []byte(`{"improve":"v1 {{text}}"}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), first.Version)
+	require.False(t, first.CreatedBy.Valid)
+
+	second, err := testStore.CreateAIPromptTemplateVersion(context.Background(), CreateAIPromptTemplateVersionParams{
+		Purpose:         purpose,
+		PromptTemplates: []byte(`{"improve":"v2 {{text}}"}`),
+		RollbackOf:      pgtype.Int4{Int32: 1, Valid: true},
+		CreatedBy:       pgtype.UUID{Bytes: author.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), second.Version)
+
+	latest, err := testStore.GetLatestAIPromptTemplateVersion(context.Background(), purpose)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, latest.ID)
+
+	versions, err := testStore.ListAIPromptTemplateVersions(context.Background(), ListAIPromptTemplateVersionsParams{
+		Purpose: purpose,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, int32(2), versions[0].Version)
+	require.Equal(t, author.Username, versions[0].AuthorUsername.String)
+
+	count, err := testStore.CountAIPromptTemplateVersions(context.Background(), purpose)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func TestSaveAIConfigTxAllocatesVersionsConcurrently(t *testing.T) {
+	purpose := "ai_test_" + util.RandomString(8)
+
+	n := 5
+	errs := make(chan error, n)
+	versions := make(chan int32, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := testStore.SaveAIConfigTx(context.Background(), SaveAIConfigTxParams{
+				UpsertAIProviderConfigParams: randomUpsertAIProviderConfigParams(purpose),
+				AfterSave: func(q Querier, config AiProviderConfig) error {
+					version, err := q.CreateAIPromptTemplateVersion(context.Background(), CreateAIPromptTemplateVersionParams{
+						Purpose:         purpose,
+						PromptTemplates: config.PromptTemplates,
+					})
+					versions <- version.Version
+					return err
+				},
+			})
+			errs <- err
+		}()
+	}
+
+	seen := make(map[int32]bool, n)
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+		version := <-versions
+		require.False(t, seen[version])
+		seen[version] = true
+	}
+	for version := int32(1); version <= int32(n); version++ {
+		require.True(t, seen[version])
+	}
+}
+
+func TestSaveAIConfigTxRollsBackOnAfterSaveError(t *testing.T) {
+	purpose := "ai_test_" + util.RandomString(8)
+	afterSaveErr := errors.New("record version failed")
+
+	_, err := testStore.SaveAIConfigTx(context.Background(), SaveAIConfigTxParams{
+		UpsertAIProviderConfigParams: randomUpsertAIProviderConfigParams(purpose),
+		AfterSave: func(q Querier, config AiProviderConfig) error {
+			return afterSaveErr
+		},
+	})
+	require.ErrorIs(t, err, afterSaveErr)
+
+	_, err = testStore.GetAIProviderConfig(context.Background(), purpose)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func randomUpsertAIProviderConfigParams(purpose string) UpsertAIProviderConfigParams {
+	return UpsertAIProviderConfigParams{
+		Purpose:         purpose,
+		Provider:        "openai",
+		ApiProtocol:     "chat/completions",
+		BaseUrl:         "https://ai.example.com/v1",
+		Model:           util.RandomString(8),
+		TimeoutMs:       30000,
+		MaxInputChars:   6000,
+		MaxContextChars: 4000,
+		MaxSuggestions:  3,
+		PromptTemplates: []byte(`{}`),
+	}
+}
@@ -48,18 +48,36 @@ func (q *Queries) CountArticles(ctx context.Context, arg CountArticlesParams) (i
 const countSearchArticles = `-- name: CountSearchArticles :one
 SELECT count(*)
 FROM articles a
-WHERE (title || ' ' || summary || ' ' || content) &@~ $1::text
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ ($1::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
   AND ($2::boolean IS NULL OR a.is_publish = $2)
+  AND ($3::bigint IS NULL OR a.category_id = $3)
+  AND ($4::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = $4
+  ))
+  AND ($5::timestamptz IS NULL OR a.created_at >= $5)
+  AND ($6::timestamptz IS NULL OR a.created_at < $6)
   AND a.deleted_at = '0001-01-01 00:00:00Z'
 `
 
 type CountSearchArticlesParams struct {
-	Keyword   string      `json:"keyword"`
-	IsPublish pgtype.Bool `json:"is_publish"`
+	Keyword    string             `json:"keyword"`
+	IsPublish  pgtype.Bool        `json:"is_publish"`
+	CategoryID pgtype.Int8        `json:"category_id"`
+	Tag        pgtype.Text        `json:"tag"`
+	StartTime  pgtype.Timestamptz `json:"start_time"`
+	EndTime    pgtype.Timestamptz `json:"end_time"`
 }
 
 func (q *Queries) CountSearchArticles(ctx context.Context, arg CountSearchArticlesParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSearchArticles, arg.Keyword, arg.IsPublish)
+	row := q.db.QueryRow(ctx, countSearchArticles,
+		arg.Keyword,
+		arg.IsPublish,
+		arg.CategoryID,
+		arg.Tag,
+		arg.StartTime,
+		arg.EndTime,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -75,7 +93,7 @@ INSERT INTO articles (id,
                       category_id,
                       cover)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id
+RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id, search_tags, search_category
 `
 
 type CreateArticleParams struct {
@@ -122,6 +140,8 @@ func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (A
 		&i.CreatedByAutomation,
 		&i.AutomationStatus,
 		&i.AutomationRequestID,
+		&i.SearchTags,
+		&i.SearchCategory,
 	)
 	return i, err
 }
@@ -160,7 +180,7 @@ INSERT INTO articles (
   'pending_review',
   $11
 )
-RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id
+RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id, search_tags, search_category
 `
 
 type CreateAutomationArticleParams struct {
@@ -213,6 +233,8 @@ func (q *Queries) CreateAutomationArticle(ctx context.Context, arg CreateAutomat
 		&i.CreatedByAutomation,
 		&i.AutomationStatus,
 		&i.AutomationRequestID,
+		&i.SearchTags,
+		&i.SearchCategory,
 	)
 	return i, err
 }
@@ -395,25 +417,27 @@ SELECT id,
        created_at,
        updated_at,
        deleted_at,
-       category_id
+       category_id,
+       slug
 FROM articles
 WHERE id = $1
 LIMIT 1 FOR NO KEY UPDATE
 `
 
 type GetArticleForUpdateRow struct {
-	ID         uuid.UUID `json:"id"`
-	Title      string    `json:"title"`
-	Summary    string    `json:"summary"`
-	Content    string    `json:"content"`
-	Views      int32     `json:"views"`
-	Likes      int32     `json:"likes"`
-	IsPublish  bool      `json:"is_publish"`
-	Owner      uuid.UUID `json:"owner"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
-	DeletedAt  time.Time `json:"deleted_at"`
-	CategoryID int64     `json:"category_id"`
+	ID         uuid.UUID   `json:"id"`
+	Title      string      `json:"title"`
+	Summary    string      `json:"summary"`
+	Content    string      `json:"content"`
+	Views      int32       `json:"views"`
+	Likes      int32       `json:"likes"`
+	IsPublish  bool        `json:"is_publish"`
+	Owner      uuid.UUID   `json:"owner"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+	DeletedAt  time.Time   `json:"deleted_at"`
+	CategoryID int64       `json:"category_id"`
+	Slug       pgtype.Text `json:"slug"`
 }
 
 func (q *Queries) GetArticleForUpdate(ctx context.Context, id uuid.UUID) (GetArticleForUpdateRow, error) {
@@ -432,10 +456,53 @@ func (q *Queries) GetArticleForUpdate(ctx context.Context, id uuid.UUID) (GetArt
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.CategoryID,
+		&i.Slug,
 	)
 	return i, err
 }
 
+const incrementArticleCounters = `-- name: IncrementArticleCounters :many
+UPDATE articles AS a
+SET views = a.views + d.views,
+    likes = a.likes + d.likes
+FROM unnest($1::uuid[], $2::int[], $3::int[]) AS d(id, views, likes)
+WHERE a.id = d.id
+RETURNING a.id, a.views, a.likes
+`
+
+type IncrementArticleCountersParams struct {
+	Ids   []uuid.UUID `json:"ids"`
+	Views []int32     `json:"views"`
+	Likes []int32     `json:"likes"`
+}
+
+type IncrementArticleCountersRow struct {
+	ID    uuid.UUID `json:"id"`
+	Views int32     `json:"views"`
+	Likes int32     `json:"likes"`
+}
+
+// 批量写回缓冲的浏览量与点赞数，返回写回后的计数
+func (q *Queries) IncrementArticleCounters(ctx context.Context, arg IncrementArticleCountersParams) ([]IncrementArticleCountersRow, error) {
+	rows, err := q.db.Query(ctx, incrementArticleCounters, arg.Ids, arg.Views, arg.Likes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []IncrementArticleCountersRow{}
+	for rows.Next() {
+		var i IncrementArticleCountersRow
+		if err := rows.Scan(&i.ID, &i.Views, &i.Likes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const incrementArticleLikes = `-- name: IncrementArticleLikes :exec
 UPDATE articles
 SET likes = likes + 1
@@ -458,6 +525,21 @@ func (q *Queries) IncrementArticleViews(ctx context.Context, id uuid.UUID) error
 	return err
 }
 
+const isResourceReferenced = `-- name: IsResourceReferenced :one
+SELECT EXISTS (
+    SELECT 1 FROM articles
+    WHERE cover = $1::text OR strpos(content, $1::text) > 0
+) AS referenced
+`
+
+// 清理临时上传文件前确认没有文章正文或封面仍在引用
+func (q *Queries) IsResourceReferenced(ctx context.Context, path string) (bool, error) {
+	row := q.db.QueryRow(ctx, isResourceReferenced, path)
+	var referenced bool
+	err := row.Scan(&referenced)
+	return referenced, err
+}
+
 const listAllArticles = `-- name: ListAllArticles :many
 SELECT a.id,
        title,
@@ -683,28 +765,73 @@ func (q *Queries) ListArticles(ctx context.Context, arg ListArticlesParams) ([]L
 	return items, nil
 }
 
+const listPopularArticleIDs = `-- name: ListPopularArticleIDs :many
+SELECT id,
+       slug
+FROM articles
+WHERE is_publish = true
+  AND deleted_at = '0001-01-01 00:00:00Z'
+ORDER BY views DESC, created_at DESC
+LIMIT $1
+`
+
+type ListPopularArticleIDsRow struct {
+	ID   uuid.UUID   `json:"id"`
+	Slug pgtype.Text `json:"slug"`
+}
+
+// 缓存预热使用，按浏览量取已发布文章
+func (q *Queries) ListPopularArticleIDs(ctx context.Context, limit int32) ([]ListPopularArticleIDsRow, error) {
+	rows, err := q.db.Query(ctx, listPopularArticleIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPopularArticleIDsRow{}
+	for rows.Next() {
+		var i ListPopularArticleIDsRow
+		if err := rows.Scan(&i.ID, &i.Slug); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPublishedArticleSitemapItems = `-- name: ListPublishedArticleSitemapItems :many
 SELECT id,
        slug,
        owner,
+       cover,
        created_at,
        updated_at
 FROM articles
 WHERE is_publish = true
   AND deleted_at = '0001-01-01 00:00:00Z'
-ORDER BY GREATEST(created_at, updated_at) DESC
+ORDER BY created_at, id
+LIMIT $1 OFFSET $2
 `
 
+type ListPublishedArticleSitemapItemsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
 type ListPublishedArticleSitemapItemsRow struct {
 	ID        uuid.UUID   `json:"id"`
 	Slug      pgtype.Text `json:"slug"`
 	Owner     uuid.UUID   `json:"owner"`
+	Cover     string      `json:"cover"`
 	CreatedAt time.Time   `json:"created_at"`
 	UpdatedAt time.Time   `json:"updated_at"`
 }
 
-func (q *Queries) ListPublishedArticleSitemapItems(ctx context.Context) ([]ListPublishedArticleSitemapItemsRow, error) {
-	rows, err := q.db.Query(ctx, listPublishedArticleSitemapItems)
+// 按创建时间稳定排序，保证分页后的子站点地图内容不随文章更新而漂移
+func (q *Queries) ListPublishedArticleSitemapItems(ctx context.Context, arg ListPublishedArticleSitemapItemsParams) ([]ListPublishedArticleSitemapItemsRow, error) {
+	rows, err := q.db.Query(ctx, listPublishedArticleSitemapItems, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -716,6 +843,7 @@ func (q *Queries) ListPublishedArticleSitemapItems(ctx context.Context) ([]ListP
 			&i.ID,
 			&i.Slug,
 			&i.Owner,
+			&i.Cover,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -729,6 +857,45 @@ func (q *Queries) ListPublishedArticleSitemapItems(ctx context.Context) ([]ListP
 	return items, nil
 }
 
+const listPublishedArticleSitemapPages = `-- name: ListPublishedArticleSitemapPages :many
+WITH ranked AS (SELECT GREATEST(created_at, updated_at) AS last_modified,
+                       (row_number() OVER (ORDER BY created_at, id) - 1) / $1::integer AS page_index
+                FROM articles
+                WHERE is_publish = true
+                  AND deleted_at = '0001-01-01 00:00:00Z')
+SELECT (page_index + 1)::integer      AS page,
+       MAX(last_modified)::timestamptz AS last_modified
+FROM ranked
+GROUP BY page_index
+ORDER BY page_index
+`
+
+type ListPublishedArticleSitemapPagesRow struct {
+	Page         int32     `json:"page"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// 与 ListPublishedArticleSitemapItems 使用相同排序，返回每个分页的最后修改时间
+func (q *Queries) ListPublishedArticleSitemapPages(ctx context.Context, pageSize int32) ([]ListPublishedArticleSitemapPagesRow, error) {
+	rows, err := q.db.Query(ctx, listPublishedArticleSitemapPages, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPublishedArticleSitemapPagesRow{}
+	for rows.Next() {
+		var i ListPublishedArticleSitemapPagesRow
+		if err := rows.Scan(&i.Page, &i.LastModified); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPublishedCategorySitemapItems = `-- name: ListPublishedCategorySitemapItems :many
 SELECT c.id,
        MAX(GREATEST(a.created_at, a.updated_at))::timestamptz AS updated_at
@@ -765,6 +932,213 @@ func (q *Queries) ListPublishedCategorySitemapItems(ctx context.Context) ([]List
 	return items, nil
 }
 
+const listRelatedArticles = `-- name: ListRelatedArticles :many
+WITH source_tags AS (SELECT DISTINCT name
+                     FROM tags
+                     WHERE article_id = $1),
+     text_matches AS (SELECT a.id,
+                             pgroonga_score(a.tableoid, a.ctid) AS score
+                      FROM articles a
+                      WHERE $2::text <> ''
+                        AND ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+                          &@~ ($2::text, ARRAY [10, 3, 0, 0, 0], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition),
+     candidates AS (SELECT a.id,
+                           a.title,
+                           a.summary,
+                           a.cover,
+                           a.slug,
+                           a.read_time,
+                           a.created_at,
+                           COALESCE(tm.score, 0)::float8                                                AS text_score,
+                           (SELECT count(DISTINCT t.name)
+                            FROM tags t
+                                     INNER JOIN source_tags st ON st.name = t.name
+                            WHERE t.article_id = a.id)                                                   AS shared_tags,
+                           a.category_id = (SELECT category_id FROM articles WHERE id = $1) AS same_category
+                    FROM articles a
+                             LEFT JOIN text_matches tm ON tm.id = a.id
+                    WHERE a.id <> $1
+                      AND a.is_publish = true
+                      AND a.deleted_at = '0001-01-01 00:00:00Z')
+SELECT id,
+       title,
+       summary,
+       cover,
+       slug,
+       read_time,
+       created_at,
+       (text_score + shared_tags * 3 + CASE WHEN same_category THEN 2 ELSE 0 END)::float8 AS score
+FROM candidates
+WHERE text_score > 0
+   OR shared_tags > 0
+   OR same_category
+ORDER BY score DESC, created_at DESC
+LIMIT $3
+`
+
+type ListRelatedArticlesParams struct {
+	ArticleID  uuid.UUID `json:"article_id"`
+	Keyword    string    `json:"keyword"`
+	MaxResults int32     `json:"max_results"`
+}
+
+type ListRelatedArticlesRow struct {
+	ID        uuid.UUID   `json:"id"`
+	Title     string      `json:"title"`
+	Summary   string      `json:"summary"`
+	Cover     string      `json:"cover"`
+	Slug      pgtype.Text `json:"slug"`
+	ReadTime  string      `json:"read_time"`
+	CreatedAt time.Time   `json:"created_at"`
+	Score     float64     `json:"score"`
+}
+
+// 相关度：标题与摘要的相似度 + 共同标签数 * 3 + 同分类 2，权重为 0 的字段不参与匹配
+func (q *Queries) ListRelatedArticles(ctx context.Context, arg ListRelatedArticlesParams) ([]ListRelatedArticlesRow, error) {
+	rows, err := q.db.Query(ctx, listRelatedArticles, arg.ArticleID, arg.Keyword, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRelatedArticlesRow{}
+	for rows.Next() {
+		var i ListRelatedArticlesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Summary,
+			&i.Cover,
+			&i.Slug,
+			&i.ReadTime,
+			&i.CreatedAt,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSearchArticleCategoryFacets = `-- name: ListSearchArticleCategoryFacets :many
+SELECT a.category_id,
+       COALESCE(c.name, '')::text AS category_name,
+       count(*)                   AS count
+FROM articles a
+         LEFT JOIN categories c on c.id = a.category_id
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ ($1::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
+  AND ($2::boolean IS NULL OR a.is_publish = $2)
+  AND ($3::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = $3
+  ))
+  AND ($4::timestamptz IS NULL OR a.created_at >= $4)
+  AND ($5::timestamptz IS NULL OR a.created_at < $5)
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY a.category_id, c.name
+ORDER BY count DESC, a.category_id
+`
+
+type ListSearchArticleCategoryFacetsParams struct {
+	Keyword   string             `json:"keyword"`
+	IsPublish pgtype.Bool        `json:"is_publish"`
+	Tag       pgtype.Text        `json:"tag"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+}
+
+type ListSearchArticleCategoryFacetsRow struct {
+	CategoryID   int64  `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Count        int64  `json:"count"`
+}
+
+// 分类分面忽略分类筛选，便于切换分类
+func (q *Queries) ListSearchArticleCategoryFacets(ctx context.Context, arg ListSearchArticleCategoryFacetsParams) ([]ListSearchArticleCategoryFacetsRow, error) {
+	rows, err := q.db.Query(ctx, listSearchArticleCategoryFacets,
+		arg.Keyword,
+		arg.IsPublish,
+		arg.Tag,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSearchArticleCategoryFacetsRow{}
+	for rows.Next() {
+		var i ListSearchArticleCategoryFacetsRow
+		if err := rows.Scan(&i.CategoryID, &i.CategoryName, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSearchArticleYearFacets = `-- name: ListSearchArticleYearFacets :many
+SELECT EXTRACT(YEAR FROM a.created_at AT TIME ZONE $1::text)::integer AS year,
+       count(*)                                                                         AS count
+FROM articles a
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ ($2::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
+  AND ($3::boolean IS NULL OR a.is_publish = $3)
+  AND ($4::bigint IS NULL OR a.category_id = $4)
+  AND ($5::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = $5
+  ))
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY year
+ORDER BY year DESC
+`
+
+type ListSearchArticleYearFacetsParams struct {
+	TimeZone   string      `json:"time_zone"`
+	Keyword    string      `json:"keyword"`
+	IsPublish  pgtype.Bool `json:"is_publish"`
+	CategoryID pgtype.Int8 `json:"category_id"`
+	Tag        pgtype.Text `json:"tag"`
+}
+
+type ListSearchArticleYearFacetsRow struct {
+	Year  int32 `json:"year"`
+	Count int64 `json:"count"`
+}
+
+// 年份分面忽略日期筛选，便于切换年份；年份按 time_zone 换算，需与日期筛选使用同一时区
+func (q *Queries) ListSearchArticleYearFacets(ctx context.Context, arg ListSearchArticleYearFacetsParams) ([]ListSearchArticleYearFacetsRow, error) {
+	rows, err := q.db.Query(ctx, listSearchArticleYearFacets,
+		arg.TimeZone,
+		arg.Keyword,
+		arg.IsPublish,
+		arg.CategoryID,
+		arg.Tag,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSearchArticleYearFacetsRow{}
+	for rows.Next() {
+		var i ListSearchArticleYearFacetsRow
+		if err := rows.Scan(&i.Year, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchArticles = `-- name: SearchArticles :many
 SELECT a.id,
        a.title,
@@ -781,53 +1155,93 @@ SELECT a.id,
        a.created_at,
        a.updated_at,
        a.deleted_at,
+       a.category_id,
+       a.created_by_automation,
+       a.automation_status,
        c.name                             as category_name,
        u.username,
-       pgroonga_score(a.tableoid, a.ctid) AS score
+       pgroonga_score(a.tableoid, a.ctid) AS score,
+       pgroonga_highlight_html(a.title, pgroonga_query_extract_keywords($3::text))::text AS highlighted_title,
+       COALESCE(
+           pgroonga_snippet_html(
+               regexp_replace(a.content, '<[^>]*>', ' ', 'g'),
+               pgroonga_query_extract_keywords($3::text),
+               $4::integer
+           ),
+           '{}'
+       )::text[] AS snippets
 FROM articles a
          LEFT JOIN categories c on c.id = a.category_id
          LEFT JOIN users u on a.owner = u.id
-WHERE (title || ' ' || summary || ' ' || content) &@~ $3::text
-  AND ($4::boolean IS NULL OR a.is_publish = $4)
+WHERE ARRAY [a.title, a.summary, regexp_replace(a.content, '<[^>]*>', ' ', 'g'), a.search_tags, a.search_category]
+          &@~ ($3::text, ARRAY [10, 3, 1, 6, 4], 'articles_weighted_search_pgroonga_idx')::pgroonga_full_text_search_condition
+  AND ($5::boolean IS NULL OR a.is_publish = $5)
+  AND ($6::bigint IS NULL OR a.category_id = $6)
+  AND ($7::text IS NULL OR EXISTS (
+      SELECT 1 FROM tags t WHERE t.article_id = a.id AND t.name = $7
+  ))
+  AND ($8::timestamptz IS NULL OR a.created_at >= $8)
+  AND ($9::timestamptz IS NULL OR a.created_at < $9)
   AND a.deleted_at = '0001-01-01 00:00:00Z'
-ORDER BY score DESC, a.created_at DESC
+ORDER BY CASE WHEN $10::text = 'newest' THEN a.created_at END DESC,
+         CASE WHEN $10::text = 'most_viewed' THEN a.views END DESC,
+         score DESC,
+         a.created_at DESC
 LIMIT $1 OFFSET $2
 `
 
 type SearchArticlesParams struct {
-	Limit     int32       `json:"limit"`
-	Offset    int32       `json:"offset"`
-	Keyword   string      `json:"keyword"`
-	IsPublish pgtype.Bool `json:"is_publish"`
+	Limit        int32              `json:"limit"`
+	Offset       int32              `json:"offset"`
+	Keyword      string             `json:"keyword"`
+	SnippetWidth int32              `json:"snippet_width"`
+	IsPublish    pgtype.Bool        `json:"is_publish"`
+	CategoryID   pgtype.Int8        `json:"category_id"`
+	Tag          pgtype.Text        `json:"tag"`
+	StartTime    pgtype.Timestamptz `json:"start_time"`
+	EndTime      pgtype.Timestamptz `json:"end_time"`
+	SortBy       string             `json:"sort_by"`
 }
 
 type SearchArticlesRow struct {
-	ID            uuid.UUID   `json:"id"`
-	Title         string      `json:"title"`
-	Summary       string      `json:"summary"`
-	Views         int32       `json:"views"`
-	Likes         int32       `json:"likes"`
-	IsPublish     bool        `json:"is_publish"`
-	Cover         string      `json:"cover"`
-	Slug          pgtype.Text `json:"slug"`
-	CheckOutdated bool        `json:"check_outdated"`
-	LastUpdated   time.Time   `json:"last_updated"`
-	ReadTime      string      `json:"read_time"`
-	Owner         uuid.UUID   `json:"owner"`
-	CreatedAt     time.Time   `json:"created_at"`
-	UpdatedAt     time.Time   `json:"updated_at"`
-	DeletedAt     time.Time   `json:"deleted_at"`
-	CategoryName  pgtype.Text `json:"category_name"`
-	Username      pgtype.Text `json:"username"`
-	Score         interface{} `json:"score"`
+	ID                  uuid.UUID   `json:"id"`
+	Title               string      `json:"title"`
+	Summary             string      `json:"summary"`
+	Views               int32       `json:"views"`
+	Likes               int32       `json:"likes"`
+	IsPublish           bool        `json:"is_publish"`
+	Cover               string      `json:"cover"`
+	Slug                pgtype.Text `json:"slug"`
+	CheckOutdated       bool        `json:"check_outdated"`
+	LastUpdated         time.Time   `json:"last_updated"`
+	ReadTime            string      `json:"read_time"`
+	Owner               uuid.UUID   `json:"owner"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+	DeletedAt           time.Time   `json:"deleted_at"`
+	CategoryID          int64       `json:"category_id"`
+	CreatedByAutomation bool        `json:"created_by_automation"`
+	AutomationStatus    string      `json:"automation_status"`
+	CategoryName        pgtype.Text `json:"category_name"`
+	Username            pgtype.Text `json:"username"`
+	Score               interface{} `json:"score"`
+	HighlightedTitle    string      `json:"highlighted_title"`
+	Snippets            []string    `json:"snippets"`
 }
 
+// 字段权重：标题 10、摘要 3、正文 1、标签 6、分类名 4
 func (q *Queries) SearchArticles(ctx context.Context, arg SearchArticlesParams) ([]SearchArticlesRow, error) {
 	rows, err := q.db.Query(ctx, searchArticles,
 		arg.Limit,
 		arg.Offset,
 		arg.Keyword,
+		arg.SnippetWidth,
 		arg.IsPublish,
+		arg.CategoryID,
+		arg.Tag,
+		arg.StartTime,
+		arg.EndTime,
+		arg.SortBy,
 	)
 	if err != nil {
 		return nil, err
@@ -852,9 +1266,14 @@ func (q *Queries) SearchArticles(ctx context.Context, arg SearchArticlesParams)
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.CategoryID,
+			&i.CreatedByAutomation,
+			&i.AutomationStatus,
 			&i.CategoryName,
 			&i.Username,
 			&i.Score,
+			&i.HighlightedTitle,
+			&i.Snippets,
 		); err != nil {
 			return nil, err
 		}
@@ -877,6 +1296,41 @@ func (q *Queries) SetArticleDefaultCategoryIdByCategoryId(ctx context.Context, c
 	return err
 }
 
+const suggestArticleTitles = `-- name: SuggestArticleTitles :many
+SELECT a.title
+FROM articles a
+WHERE a.title &^ $1::text
+  AND a.is_publish = true
+  AND a.deleted_at = '0001-01-01 00:00:00Z'
+ORDER BY a.views DESC, a.created_at DESC
+LIMIT $2
+`
+
+type SuggestArticleTitlesParams struct {
+	Prefix     string `json:"prefix"`
+	MaxResults int32  `json:"max_results"`
+}
+
+func (q *Queries) SuggestArticleTitles(ctx context.Context, arg SuggestArticleTitlesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, suggestArticleTitles, arg.Prefix, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		items = append(items, title)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateArticle = `-- name: UpdateArticle :one
 UPDATE articles
 SET title          = COALESCE($1, title),
@@ -897,7 +1351,7 @@ SET title          = COALESCE($1, title),
     END,
     updated_at     = COALESCE($11, updated_at)
 WHERE id = $12
-RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id
+RETURNING id, title, summary, content, views, likes, is_publish, owner, created_at, updated_at, deleted_at, category_id, slug, cover, last_updated, check_outdated, read_time, created_by_automation, automation_status, automation_request_id, search_tags, search_category
 `
 
 type UpdateArticleParams struct {
@@ -952,6 +1406,8 @@ func (q *Queries) UpdateArticle(ctx context.Context, arg UpdateArticleParams) (A
 		&i.CreatedByAutomation,
 		&i.AutomationStatus,
 		&i.AutomationRequestID,
+		&i.SearchTags,
+		&i.SearchCategory,
 	)
 	return i, err
 }
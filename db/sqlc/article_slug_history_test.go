@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func updateArticleSlug(t *testing.T, article Article, slug string) Article {
+	result, err := testStore.UpdateArticleTx(context.Background(), UpdateArticleTxParams{
+		UpdateArticleParams: UpdateArticleParams{
+			ID:   article.ID,
+			Slug: pgtype.Text{String: slug, Valid: true},
+		},
+		AfterUpdate: func(article Article) error {
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, slug, result.Article.Slug.String)
+	return result.Article
+}
+
+func TestUpdateArticleTxRecordsSlugHistory(t *testing.T) {
+	article := createRandomArticle(t, true, 0)
+	oldSlug := "old-" + util.RandomString(8)
+	newSlug := "new-" + util.RandomString(8)
+
+	updateArticleSlug(t, article, oldSlug)
+	_, err := testStore.GetArticleSlugRedirect(context.Background(), oldSlug)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	updateArticleSlug(t, article, newSlug)
+	redirect, err := testStore.GetArticleSlugRedirect(context.Background(), oldSlug)
+	require.NoError(t, err)
+	require.Equal(t, article.ID, redirect.ID)
+	require.Equal(t, newSlug, redirect.Slug.String)
+
+	// 改回旧短标识后，历史记录中只保留刚被替换的那个
+	updateArticleSlug(t, article, oldSlug)
+	_, err = testStore.GetArticleSlugRedirect(context.Background(), oldSlug)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	redirect, err = testStore.GetArticleSlugRedirect(context.Background(), newSlug)
+	require.NoError(t, err)
+	require.Equal(t, oldSlug, redirect.Slug.String)
+}
+
+func TestGetArticleSlugRedirectSkipsUnpublished(t *testing.T) {
+	article := createRandomArticle(t, false, 0)
+	oldSlug := "old-" + util.RandomString(8)
+
+	updateArticleSlug(t, article, oldSlug)
+	updateArticleSlug(t, article, "new-"+util.RandomString(8))
+
+	_, err := testStore.GetArticleSlugRedirect(context.Background(), oldSlug)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -334,6 +334,75 @@ func TestIncrementArticleViews(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestIncrementArticleCounters(t *testing.T) {
+	articleA := createRandomArticle(t, true, 0)
+	articleB := createRandomArticle(t, true, 0)
+
+	rows, err := testStore.IncrementArticleCounters(context.Background(), IncrementArticleCountersParams{
+		Ids:   []uuid.UUID{articleA.ID, articleB.ID},
+		Views: []int32{5, 0},
+		Likes: []int32{2, 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	gotA, err := testStore.GetArticle(context.Background(), articleA.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(5), gotA.Views)
+	require.Equal(t, int32(2), gotA.Likes)
+
+	gotB, err := testStore.GetArticle(context.Background(), articleB.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), gotB.Views)
+	require.Equal(t, int32(1), gotB.Likes)
+}
+
+func TestFlushArticleCountersTx(t *testing.T) {
+	article := createRandomArticle(t, true, 0)
+	arg := FlushArticleCountersTxParams{
+		BatchID: uuid.NewString(),
+		IncrementArticleCountersParams: IncrementArticleCountersParams{
+			Ids:   []uuid.UUID{article.ID},
+			Views: []int32{4},
+			Likes: []int32{1},
+		},
+	}
+
+	result, err := testStore.FlushArticleCountersTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, []IncrementArticleCountersRow{{ID: article.ID, Views: 4, Likes: 1}}, result.Articles)
+
+	// 同一批次重试时不再累加，返回当前计数
+	result, err = testStore.FlushArticleCountersTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Equal(t, []IncrementArticleCountersRow{{ID: article.ID, Views: 4, Likes: 1}}, result.Articles)
+
+	got, err := testStore.GetArticle(context.Background(), article.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), got.Views)
+	require.Equal(t, int32(1), got.Likes)
+}
+
+func TestListPopularArticleIDs(t *testing.T) {
+	popular := createRandomArticle(t, true, 0)
+	unpublished := createRandomArticle(t, false, 0)
+
+	_, err := testStore.IncrementArticleCounters(context.Background(), IncrementArticleCountersParams{
+		Ids:   []uuid.UUID{popular.ID, unpublished.ID},
+		Views: []int32{1_000_000, 2_000_000},
+		Likes: []int32{0, 0},
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.ListPopularArticleIDs(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, popular.ID, rows[0].ID)
+	require.Equal(t, popular.Slug, rows[0].Slug)
+}
+
 func TestSearchArticles(t *testing.T) {
 	user := createRandomUser(t)
 	category := createRandomCategory(t)
@@ -399,9 +468,10 @@ func TestSearchArticles(t *testing.T) {
 	// 测试场景 1: 搜索 特征码 (uniqueTestTag)
 	// -------------------------------------------------------
 	searchArg := SearchArticlesParams{
-		Limit:   10,
-		Offset:  0,
-		Keyword: uniqueTestTag, // 只搜这个随机字符串
+		Limit:        10,
+		Offset:       0,
+		Keyword:      uniqueTestTag, // 只搜这个随机字符串
+		SnippetWidth: 100,
 		IsPublish: pgtype.Bool{
 			Bool:  true,
 			Valid: true,
@@ -414,7 +484,30 @@ func TestSearchArticles(t *testing.T) {
 	// 断言：无论数据库里有多少脏数据，包含这个 uniqueTestTag 且已发布的，只有 A 和 B
 	require.Len(t, results, 2)
 
-	// ... 后续验证 ID 的逻辑不变 ...
+	// 标题命中时返回高亮标题，正文命中时返回去除标签后的片段
+	for _, result := range results {
+		switch result.ID {
+		case articleA.ID:
+			require.Contains(t, result.HighlightedTitle, `<span class="keyword">`)
+		case articleB.ID:
+			require.NotEmpty(t, result.Snippets)
+			require.Contains(t, result.Snippets[0], `<span class="keyword">`)
+		}
+	}
+
+	// 标题权重高于正文，标题命中的文章排在前面
+	require.Equal(t, articleA.ID, results[0].ID)
+
+	// 分类名同样参与检索，由触发器冗余到文章表
+	categoryHits, err := testStore.SearchArticles(context.Background(), SearchArticlesParams{
+		Limit:        10,
+		Keyword:      category.Name,
+		SnippetWidth: 100,
+		IsPublish:    searchArg.IsPublish,
+		CategoryID:   pgtype.Int8{Int64: category.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, categoryHits, 3)
 
 	// -------------------------------------------------------
 	// 测试场景 2: Count
@@ -429,4 +522,97 @@ func TestSearchArticles(t *testing.T) {
 	count, err := testStore.CountSearchArticles(context.Background(), countArg)
 	require.NoError(t, err)
 	require.Equal(t, int64(2), count)
+
+	// -------------------------------------------------------
+	// 测试场景 3: 筛选与分面
+	// -------------------------------------------------------
+	countArg.CategoryID = pgtype.Int8{Int64: category.ID, Valid: true}
+	countArg.StartTime = pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+	count, err = testStore.CountSearchArticles(context.Background(), countArg)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	categoryFacets, err := testStore.ListSearchArticleCategoryFacets(context.Background(), ListSearchArticleCategoryFacetsParams{
+		Keyword:   uniqueTestTag,
+		IsPublish: countArg.IsPublish,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ListSearchArticleCategoryFacetsRow{{CategoryID: category.ID, CategoryName: category.Name, Count: 2}}, categoryFacets)
+
+	// 草稿也计入不限发布状态的年份分面
+	yearFacets, err := testStore.ListSearchArticleYearFacets(context.Background(), ListSearchArticleYearFacetsParams{
+		TimeZone:   "UTC",
+		Keyword:    uniqueTestTag,
+		CategoryID: countArg.CategoryID,
+	})
+	require.NoError(t, err)
+	require.Len(t, yearFacets, 1)
+	require.Equal(t, int64(3), yearFacets[0].Count)
+}
+
+func TestListRelatedArticles(t *testing.T) {
+	source := createRandomArticle(t, true, 0)
+	sameCategory := createRandomArticle(t, true, source.CategoryID)
+	draft := createRandomArticle(t, false, source.CategoryID)
+	unrelated := createRandomArticle(t, true, 0)
+
+	// 标题相似但分类不同的文章也会被推荐
+	user := createRandomUser(t)
+	category := createRandomCategory(t)
+	similar, err := testStore.CreateArticle(context.Background(), CreateArticleParams{
+		ID:         uuid.New(),
+		Title:      fmt.Sprintf("Notes on %s", source.Title),
+		Summary:    util.RandomString(10),
+		Content:    util.RandomString(50),
+		IsPublish:  true,
+		Owner:      user.ID,
+		CategoryID: category.ID,
+	})
+	require.NoError(t, err)
+
+	articles, err := testStore.ListRelatedArticles(context.Background(), ListRelatedArticlesParams{
+		ArticleID:  source.ID,
+		Keyword:    fmt.Sprintf("%q", source.Title),
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+	require.Contains(t, ids, sameCategory.ID)
+	require.Contains(t, ids, similar.ID)
+	require.NotContains(t, ids, source.ID)
+	require.NotContains(t, ids, draft.ID)
+	require.NotContains(t, ids, unrelated.ID)
+}
+
+func TestListPublishedArticleSitemapPages(t *testing.T) {
+	createRandomArticle(t, true, 0)
+	createRandomArticle(t, true, 0)
+	createRandomArticle(t, false, 0)
+
+	items, err := testStore.ListPublishedArticleSitemapItems(context.Background(), ListPublishedArticleSitemapItemsParams{
+		Limit:  100000,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(items), 2)
+
+	pages, err := testStore.ListPublishedArticleSitemapPages(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, pages, (len(items)+1)/2)
+	for i, page := range pages {
+		require.Equal(t, int32(i+1), page.Page)
+		require.False(t, page.LastModified.IsZero())
+	}
+
+	// 第二页与按相同排序偏移查询的结果一致
+	secondPage, err := testStore.ListPublishedArticleSitemapItems(context.Background(), ListPublishedArticleSitemapItemsParams{
+		Limit:  2,
+		Offset: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, items[2].ID, secondPage[0].ID)
 }
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateArticleTranslationTx(t *testing.T) {
+	source := createRandomArticle(t, true, 0)
+
+	arg := CreateArticleTranslationTxParams{
+		SourceArticleID: source.ID,
+		Article: CreateArticleParams{
+			ID:         uuid.New(),
+			Title:      "Translated " + source.Title,
+			Summary:    source.Summary,
+			Content:    "<p>translated</p>",
+			IsPublish:  false,
+			Owner:      source.Owner,
+			CategoryID: source.CategoryID,
+			Cover:      source.Cover,
+		},
+		Locale: "en",
+		Model:  "writer-model",
+	}
+
+	result, err := testStore.CreateArticleTranslationTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Article.ID, result.Article.ID)
+	require.False(t, result.Article.IsPublish)
+	require.Equal(t, source.ID, result.Translation.SourceArticleID)
+	require.Equal(t, result.Article.ID, result.Translation.TranslatedArticleID)
+	require.Equal(t, "en", result.Translation.Locale)
+
+	translation, err := testStore.GetArticleTranslation(context.Background(), GetArticleTranslationParams{
+		SourceArticleID: source.ID,
+		Locale:          "en",
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Translation.ID, translation.ID)
+
+	// 语言标签不区分大小写
+	translation, err = testStore.GetArticleTranslation(context.Background(), GetArticleTranslationParams{
+		SourceArticleID: source.ID,
+		Locale:          "EN",
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Translation.ID, translation.ID)
+
+	arg.Article.ID = uuid.New()
+	_, err = testStore.CreateArticleTranslationTx(context.Background(), arg)
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
@@ -17,7 +17,7 @@ const addCommentLikes = `-- name: AddCommentLikes :one
 UPDATE comments
 SET likes = likes + 1
 WHERE id = $1
-RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at
+RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at, status, moderation_reason
 `
 
 func (q *Queries) AddCommentLikes(ctx context.Context, id int64) (Comment, error) {
@@ -33,24 +33,60 @@ func (q *Queries) AddCommentLikes(ctx context.Context, id int64) (Comment, error
 		&i.ToUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationReason,
 	)
 	return i, err
 }
 
+const countArticleComments = `-- name: CountArticleComments :one
+SELECT count(*) AS total, count(*) FILTER (WHERE parent_id = 0) AS top_level FROM comments
+WHERE
+    article_id = $1 AND deleted_at = '0001-01-01 00:00:00.000000 +00:00'
+    AND status = 'published'
+`
+
+type CountArticleCommentsRow struct {
+	Total    int64 `json:"total"`
+	TopLevel int64 `json:"top_level"`
+}
+
+// 文章头部展示的评论总数，只统计已发布的评论
+func (q *Queries) CountArticleComments(ctx context.Context, articleID uuid.UUID) (CountArticleCommentsRow, error) {
+	row := q.db.QueryRow(ctx, countArticleComments, articleID)
+	var i CountArticleCommentsRow
+	err := row.Scan(&i.Total, &i.TopLevel)
+	return i, err
+}
+
+const countHeldComments = `-- name: CountHeldComments :one
+SELECT count(*) FROM comments
+WHERE status = 'held'
+`
+
+func (q *Queries) CountHeldComments(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countHeldComments)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createComment = `-- name: CreateComment :one
 INSERT INTO comments
-    (content, article_id, parent_id, from_user_id, to_user_id)
+    (content, article_id, parent_id, from_user_id, to_user_id, status, moderation_reason)
 VALUES
-    ($1, $2, $3, $4, $5)
-RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at
+    ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at, status, moderation_reason
 `
 
 type CreateCommentParams struct {
-	Content    string    `json:"content"`
-	ArticleID  uuid.UUID `json:"article_id"`
-	ParentID   int64     `json:"parent_id"`
-	FromUserID uuid.UUID `json:"from_user_id"`
-	ToUserID   uuid.UUID `json:"to_user_id"`
+	Content          string    `json:"content"`
+	ArticleID        uuid.UUID `json:"article_id"`
+	ParentID         int64     `json:"parent_id"`
+	FromUserID       uuid.UUID `json:"from_user_id"`
+	ToUserID         uuid.UUID `json:"to_user_id"`
+	Status           string    `json:"status"`
+	ModerationReason string    `json:"moderation_reason"`
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error) {
@@ -60,6 +96,8 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		arg.ParentID,
 		arg.FromUserID,
 		arg.ToUserID,
+		arg.Status,
+		arg.ModerationReason,
 	)
 	var i Comment
 	err := row.Scan(
@@ -72,6 +110,8 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		&i.ToUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationReason,
 	)
 	return i, err
 }
@@ -116,7 +156,7 @@ func (q *Queries) DeleteCommentsByCategoryID(ctx context.Context, categoryID int
 }
 
 const getComment = `-- name: GetComment :one
-SELECT id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at FROM comments
+SELECT id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at, status, moderation_reason FROM comments
 WHERE id = $1 LIMIT 1
 `
 
@@ -133,42 +173,269 @@ func (q *Queries) GetComment(ctx context.Context, id int64) (Comment, error) {
 		&i.ToUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationReason,
 	)
 	return i, err
 }
 
-const listCommentsByArticleID = `-- name: ListCommentsByArticleID :many
-SELECT c.id, c.content, c.article_id, c.parent_id, c.likes, c.from_user_id, c.to_user_id, c.created_at, c.deleted_at, from_u.username as from_user_name, to_u.username as to_user_name FROM comments c
+const listCommentReplies = `-- name: ListCommentReplies :many
+SELECT c.id, c.content, c.article_id, c.parent_id, c.likes, c.from_user_id, c.to_user_id, c.created_at, c.deleted_at, c.status, c.moderation_reason, from_u.username as from_user_name, to_u.username as to_user_name FROM comments c
+LEFT JOIN users from_u on c.from_user_id = from_u.id
+LEFT JOIN users to_u on c.to_user_id = to_u.id
+WHERE
+    c.article_id = $1 AND c.parent_id = $2 AND c.id > $3
+    AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
+ORDER BY c.id
+LIMIT $4
+`
+
+type ListCommentRepliesParams struct {
+	ArticleID  uuid.UUID `json:"article_id"`
+	ParentID   int64     `json:"parent_id"`
+	AfterID    int64     `json:"after_id"`
+	MaxResults int32     `json:"max_results"`
+}
+
+type ListCommentRepliesRow struct {
+	ID               int64       `json:"id"`
+	Content          string      `json:"content"`
+	ArticleID        uuid.UUID   `json:"article_id"`
+	ParentID         int64       `json:"parent_id"`
+	Likes            int32       `json:"likes"`
+	FromUserID       uuid.UUID   `json:"from_user_id"`
+	ToUserID         uuid.UUID   `json:"to_user_id"`
+	CreatedAt        time.Time   `json:"created_at"`
+	DeletedAt        time.Time   `json:"deleted_at"`
+	Status           string      `json:"status"`
+	ModerationReason string      `json:"moderation_reason"`
+	FromUserName     pgtype.Text `json:"from_user_name"`
+	ToUserName       pgtype.Text `json:"to_user_name"`
+}
+
+func (q *Queries) ListCommentReplies(ctx context.Context, arg ListCommentRepliesParams) ([]ListCommentRepliesRow, error) {
+	rows, err := q.db.Query(ctx, listCommentReplies,
+		arg.ArticleID,
+		arg.ParentID,
+		arg.AfterID,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCommentRepliesRow{}
+	for rows.Next() {
+		var i ListCommentRepliesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.ArticleID,
+			&i.ParentID,
+			&i.Likes,
+			&i.FromUserID,
+			&i.ToUserID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationReason,
+			&i.FromUserName,
+			&i.ToUserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentReplyPreviews = `-- name: ListCommentReplyPreviews :many
+SELECT r.id, r.content, r.article_id, r.parent_id, r.likes, r.from_user_id, r.to_user_id, r.created_at, r.deleted_at, r.status, r.moderation_reason, r.from_user_name, r.to_user_name
+FROM (
+    SELECT c.id, c.content, c.article_id, c.parent_id, c.likes, c.from_user_id, c.to_user_id, c.created_at, c.deleted_at, c.status, c.moderation_reason, from_u.username as from_user_name, to_u.username as to_user_name,
+        row_number() OVER (PARTITION BY c.parent_id ORDER BY c.id) AS position
+    FROM comments c
+    LEFT JOIN users from_u on c.from_user_id = from_u.id
+    LEFT JOIN users to_u on c.to_user_id = to_u.id
+    WHERE
+        c.parent_id = ANY($1::bigint[])
+        AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
+) r
+WHERE r.position <= $2::int
+ORDER BY r.parent_id, r.id
+`
+
+type ListCommentReplyPreviewsParams struct {
+	ParentIds    []int64 `json:"parent_ids"`
+	MaxPerParent int32   `json:"max_per_parent"`
+}
+
+type ListCommentReplyPreviewsRow struct {
+	ID               int64       `json:"id"`
+	Content          string      `json:"content"`
+	ArticleID        uuid.UUID   `json:"article_id"`
+	ParentID         int64       `json:"parent_id"`
+	Likes            int32       `json:"likes"`
+	FromUserID       uuid.UUID   `json:"from_user_id"`
+	ToUserID         uuid.UUID   `json:"to_user_id"`
+	CreatedAt        time.Time   `json:"created_at"`
+	DeletedAt        time.Time   `json:"deleted_at"`
+	Status           string      `json:"status"`
+	ModerationReason string      `json:"moderation_reason"`
+	FromUserName     pgtype.Text `json:"from_user_name"`
+	ToUserName       pgtype.Text `json:"to_user_name"`
+}
+
+// 每条顶层评论预取前几条回复，其余回复按需加载
+func (q *Queries) ListCommentReplyPreviews(ctx context.Context, arg ListCommentReplyPreviewsParams) ([]ListCommentReplyPreviewsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentReplyPreviews, arg.ParentIds, arg.MaxPerParent)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCommentReplyPreviewsRow{}
+	for rows.Next() {
+		var i ListCommentReplyPreviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.ArticleID,
+			&i.ParentID,
+			&i.Likes,
+			&i.FromUserID,
+			&i.ToUserID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationReason,
+			&i.FromUserName,
+			&i.ToUserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHeldComments = `-- name: ListHeldComments :many
+SELECT c.id, c.content, c.article_id, c.parent_id, c.likes, c.from_user_id, c.to_user_id, c.created_at, c.deleted_at, c.status, c.moderation_reason, a.title as article_title, from_u.username as from_user_name FROM comments c
+LEFT JOIN articles a on c.article_id = a.id
+LEFT JOIN users from_u on c.from_user_id = from_u.id
+WHERE c.status = 'held'
+ORDER BY c.created_at DESC
+LIMIT $1 OFFSET $2
+`
+
+type ListHeldCommentsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListHeldCommentsRow struct {
+	ID               int64       `json:"id"`
+	Content          string      `json:"content"`
+	ArticleID        uuid.UUID   `json:"article_id"`
+	ParentID         int64       `json:"parent_id"`
+	Likes            int32       `json:"likes"`
+	FromUserID       uuid.UUID   `json:"from_user_id"`
+	ToUserID         uuid.UUID   `json:"to_user_id"`
+	CreatedAt        time.Time   `json:"created_at"`
+	DeletedAt        time.Time   `json:"deleted_at"`
+	Status           string      `json:"status"`
+	ModerationReason string      `json:"moderation_reason"`
+	ArticleTitle     pgtype.Text `json:"article_title"`
+	FromUserName     pgtype.Text `json:"from_user_name"`
+}
+
+func (q *Queries) ListHeldComments(ctx context.Context, arg ListHeldCommentsParams) ([]ListHeldCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listHeldComments, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListHeldCommentsRow{}
+	for rows.Next() {
+		var i ListHeldCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.ArticleID,
+			&i.ParentID,
+			&i.Likes,
+			&i.FromUserID,
+			&i.ToUserID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationReason,
+			&i.ArticleTitle,
+			&i.FromUserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopLevelComments = `-- name: ListTopLevelComments :many
+SELECT c.id, c.content, c.article_id, c.parent_id, c.likes, c.from_user_id, c.to_user_id, c.created_at, c.deleted_at, c.status, c.moderation_reason, from_u.username as from_user_name, to_u.username as to_user_name,
+    (SELECT count(*) FROM comments r
+     WHERE r.parent_id = c.id AND r.deleted_at = '0001-01-01 00:00:00.000000 +00:00'
+       AND r.status = 'published') AS reply_count
+FROM comments c
 LEFT JOIN users from_u on c.from_user_id = from_u.id
 LEFT JOIN users to_u on c.to_user_id = to_u.id
 WHERE
-    c.article_id = $1 AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00'
+    c.article_id = $1 AND c.parent_id = 0 AND c.id > $2
+    AND c.deleted_at = '0001-01-01 00:00:00.000000 +00:00' AND c.status = 'published'
 ORDER BY c.id
+LIMIT $3
 `
 
-type ListCommentsByArticleIDRow struct {
-	ID           int64       `json:"id"`
-	Content      string      `json:"content"`
-	ArticleID    uuid.UUID   `json:"article_id"`
-	ParentID     int64       `json:"parent_id"`
-	Likes        int32       `json:"likes"`
-	FromUserID   uuid.UUID   `json:"from_user_id"`
-	ToUserID     uuid.UUID   `json:"to_user_id"`
-	CreatedAt    time.Time   `json:"created_at"`
-	DeletedAt    time.Time   `json:"deleted_at"`
-	FromUserName pgtype.Text `json:"from_user_name"`
-	ToUserName   pgtype.Text `json:"to_user_name"`
-}
-
-func (q *Queries) ListCommentsByArticleID(ctx context.Context, articleID uuid.UUID) ([]ListCommentsByArticleIDRow, error) {
-	rows, err := q.db.Query(ctx, listCommentsByArticleID, articleID)
+type ListTopLevelCommentsParams struct {
+	ArticleID  uuid.UUID `json:"article_id"`
+	AfterID    int64     `json:"after_id"`
+	MaxResults int32     `json:"max_results"`
+}
+
+type ListTopLevelCommentsRow struct {
+	ID               int64       `json:"id"`
+	Content          string      `json:"content"`
+	ArticleID        uuid.UUID   `json:"article_id"`
+	ParentID         int64       `json:"parent_id"`
+	Likes            int32       `json:"likes"`
+	FromUserID       uuid.UUID   `json:"from_user_id"`
+	ToUserID         uuid.UUID   `json:"to_user_id"`
+	CreatedAt        time.Time   `json:"created_at"`
+	DeletedAt        time.Time   `json:"deleted_at"`
+	Status           string      `json:"status"`
+	ModerationReason string      `json:"moderation_reason"`
+	FromUserName     pgtype.Text `json:"from_user_name"`
+	ToUserName       pgtype.Text `json:"to_user_name"`
+	ReplyCount       int64       `json:"reply_count"`
+}
+
+// 按 id 游标分页顶层评论，回复数用于提示按需加载
+func (q *Queries) ListTopLevelComments(ctx context.Context, arg ListTopLevelCommentsParams) ([]ListTopLevelCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listTopLevelComments, arg.ArticleID, arg.AfterID, arg.MaxResults)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListCommentsByArticleIDRow{}
+	items := []ListTopLevelCommentsRow{}
 	for rows.Next() {
-		var i ListCommentsByArticleIDRow
+		var i ListTopLevelCommentsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Content,
@@ -179,8 +446,11 @@ func (q *Queries) ListCommentsByArticleID(ctx context.Context, articleID uuid.UU
 			&i.ToUserID,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationReason,
 			&i.FromUserName,
 			&i.ToUserName,
+			&i.ReplyCount,
 		); err != nil {
 			return nil, err
 		}
@@ -191,3 +461,70 @@ func (q *Queries) ListCommentsByArticleID(ctx context.Context, articleID uuid.UU
 	}
 	return items, nil
 }
+
+const resolvePendingComment = `-- name: ResolvePendingComment :one
+UPDATE comments
+SET status = $1,
+    moderation_reason = $2
+WHERE id = $3 AND status = 'pending'
+RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at, status, moderation_reason
+`
+
+type ResolvePendingCommentParams struct {
+	Status           string `json:"status"`
+	ModerationReason string `json:"moderation_reason"`
+	ID               int64  `json:"id"`
+}
+
+// 只更新仍在等待 AI 审核的评论，管理员已处理的评论不会被覆盖
+func (q *Queries) ResolvePendingComment(ctx context.Context, arg ResolvePendingCommentParams) (Comment, error) {
+	row := q.db.QueryRow(ctx, resolvePendingComment, arg.Status, arg.ModerationReason, arg.ID)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.Content,
+		&i.ArticleID,
+		&i.ParentID,
+		&i.Likes,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationReason,
+	)
+	return i, err
+}
+
+const updateCommentModeration = `-- name: UpdateCommentModeration :one
+UPDATE comments
+SET status = $1,
+    moderation_reason = $2
+WHERE id = $3
+RETURNING id, content, article_id, parent_id, likes, from_user_id, to_user_id, created_at, deleted_at, status, moderation_reason
+`
+
+type UpdateCommentModerationParams struct {
+	Status           string `json:"status"`
+	ModerationReason string `json:"moderation_reason"`
+	ID               int64  `json:"id"`
+}
+
+func (q *Queries) UpdateCommentModeration(ctx context.Context, arg UpdateCommentModerationParams) (Comment, error) {
+	row := q.db.QueryRow(ctx, updateCommentModeration, arg.Status, arg.ModerationReason, arg.ID)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.Content,
+		&i.ArticleID,
+		&i.ParentID,
+		&i.Likes,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationReason,
+	)
+	return i, err
+}
@@ -18,6 +18,7 @@ func TestCreateComment(t *testing.T) {
 		ParentID:   0,
 		FromUserID: sendCommentUser.ID,
 		ToUserID:   article.Owner,
+		Status:     "published",
 	}
 
 	comment, err := testStore.CreateComment(context.Background(), arg)
@@ -31,5 +32,162 @@ func TestCreateComment(t *testing.T) {
 	require.Equal(t, arg.ToUserID, comment.ToUserID)
 	require.NotZero(t, comment.CreatedAt)
 	require.True(t, comment.DeletedAt.IsZero())
+	require.Equal(t, "published", comment.Status)
+	require.Empty(t, comment.ModerationReason)
+}
+
+func TestUpdateCommentModeration(t *testing.T) {
+	article := createRandomArticle(t, false, 1)
+	sendCommentUser := createRandomUser(t)
+
+	comment, err := testStore.CreateComment(context.Background(), CreateCommentParams{
+		Content:    util.RandomString(32),
+		ArticleID:  article.ID,
+		FromUserID: sendCommentUser.ID,
+		ToUserID:   article.Owner,
+		Status:     "published",
+	})
+	require.NoError(t, err)
+
+	held, err := testStore.UpdateCommentModeration(context.Background(), UpdateCommentModerationParams{
+		ID:               comment.ID,
+		Status:           "held",
+		ModerationReason: "links: 3",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "held", held.Status)
+	require.Equal(t, "links: 3", held.ModerationReason)
+
+	comments, err := testStore.ListTopLevelComments(context.Background(), ListTopLevelCommentsParams{
+		ArticleID:  article.ID,
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, comments)
+
+	rows, err := testStore.ListHeldComments(context.Background(), ListHeldCommentsParams{Limit: 100})
+	require.NoError(t, err)
+	found := false
+	for _, row := range rows {
+		if row.ID == comment.ID {
+			found = true
+			require.Equal(t, article.Title, row.ArticleTitle.String)
+			require.Equal(t, sendCommentUser.Username, row.FromUserName.String)
+		}
+	}
+	require.True(t, found)
+
+	count, err := testStore.CountHeldComments(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(1))
+}
+
+func TestResolvePendingComment(t *testing.T) {
+	article := createRandomArticle(t, false, 1)
+	sendCommentUser := createRandomUser(t)
+
+	comment, err := testStore.CreateComment(context.Background(), CreateCommentParams{
+		Content:    util.RandomString(32),
+		ArticleID:  article.ID,
+		FromUserID: sendCommentUser.ID,
+		ToUserID:   article.Owner,
+		Status:     "pending",
+	})
+	require.NoError(t, err)
+
+	count, err := testStore.CountArticleComments(context.Background(), article.ID)
+	require.NoError(t, err)
+	require.Zero(t, count.Total)
+
+	published, err := testStore.ResolvePendingComment(context.Background(), ResolvePendingCommentParams{
+		ID:     comment.ID,
+		Status: "published",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "published", published.Status)
+
+	// 已处理的评论不会被再次覆盖
+	_, err = testStore.ResolvePendingComment(context.Background(), ResolvePendingCommentParams{
+		ID:               comment.ID,
+		Status:           "held",
+		ModerationReason: "ai: spam",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	count, err = testStore.CountArticleComments(context.Background(), article.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count.Total)
+}
+
+func TestListCommentThreads(t *testing.T) {
+	ctx := context.Background()
+	article := createRandomArticle(t, false, 1)
+	user := createRandomUser(t)
+
+	createComment := func(parentID int64, status string) Comment {
+		comment, err := testStore.CreateComment(ctx, CreateCommentParams{
+			Content:    util.RandomString(16),
+			ArticleID:  article.ID,
+			ParentID:   parentID,
+			FromUserID: user.ID,
+			ToUserID:   article.Owner,
+			Status:     status,
+		})
+		require.NoError(t, err)
+		return comment
+	}
 
+	first := createComment(0, "published")
+	second := createComment(0, "published")
+	createComment(0, "held")
+	replies := []Comment{
+		createComment(first.ID, "published"),
+		createComment(first.ID, "published"),
+		createComment(first.ID, "published"),
+	}
+	createComment(first.ID, "held")
+
+	roots, err := testStore.ListTopLevelComments(ctx, ListTopLevelCommentsParams{
+		ArticleID:  article.ID,
+		MaxResults: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	require.Equal(t, first.ID, roots[0].ID)
+	require.Equal(t, int64(3), roots[0].ReplyCount)
+	require.Equal(t, user.Username, roots[0].FromUserName.String)
+
+	roots, err = testStore.ListTopLevelComments(ctx, ListTopLevelCommentsParams{
+		ArticleID:  article.ID,
+		AfterID:    first.ID,
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	require.Equal(t, second.ID, roots[0].ID)
+	require.Zero(t, roots[0].ReplyCount)
+
+	previews, err := testStore.ListCommentReplyPreviews(ctx, ListCommentReplyPreviewsParams{
+		ParentIds:    []int64{first.ID, second.ID},
+		MaxPerParent: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, previews, 2)
+	require.Equal(t, replies[0].ID, previews[0].ID)
+	require.Equal(t, replies[1].ID, previews[1].ID)
+
+	rest, err := testStore.ListCommentReplies(ctx, ListCommentRepliesParams{
+		ArticleID:  article.ID,
+		ParentID:   first.ID,
+		AfterID:    replies[1].ID,
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.Equal(t, replies[2].ID, rest[0].ID)
+
+	count, err := testStore.CountArticleComments(ctx, article.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), count.Total)
+	require.Equal(t, int64(2), count.TopLevel)
 }
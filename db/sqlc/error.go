@@ -15,6 +15,8 @@ var ErrRecordNotFound = pgx.ErrNoRows
 
 var ErrUniqueViolation = &pgconn.PgError{Code: UniqueViolation}
 
+var ErrForeignKeyViolation = &pgconn.PgError{Code: ForeignKeyViolation}
+
 func ErrorCode(err error) string {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIndexNowSubmission(t *testing.T) {
+	arg := CreateIndexNowSubmissionParams{
+		Endpoint:   "http://localhost:8089/indexnow",
+		Urls:       []string{"https://blog.example.com/article/go"},
+		StatusCode: 429,
+		Error:      "indexnow responded with status 429",
+		Attempt:    2,
+	}
+
+	submission, err := testStore.CreateIndexNowSubmission(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, submission.ID)
+	require.Equal(t, arg.Endpoint, submission.Endpoint)
+	require.Equal(t, arg.Urls, submission.Urls)
+	require.Equal(t, arg.StatusCode, submission.StatusCode)
+	require.Equal(t, arg.Error, submission.Error)
+	require.Equal(t, arg.Attempt, submission.Attempt)
+	require.NotZero(t, submission.CreatedAt)
+}
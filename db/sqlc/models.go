@@ -11,6 +11,17 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AiPromptTemplateVersion struct {
+	ID              int64  `json:"id"`
+	Purpose         string `json:"purpose"`
+	Version         int32  `json:"version"`
+	PromptTemplates []byte `json:"prompt_templates"`
+	// 回滚来源版本
+	RollbackOf pgtype.Int4 `json:"rollback_of"`
+	CreatedBy  pgtype.UUID `json:"created_by"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
 type AiProviderConfig struct {
 	Purpose          string      `json:"purpose"`
 	Provider         string      `json:"provider"`
@@ -62,6 +73,36 @@ type Article struct {
 	CreatedByAutomation bool        `json:"created_by_automation"`
 	AutomationStatus    string      `json:"automation_status"`
 	AutomationRequestID pgtype.Int8 `json:"automation_request_id"`
+	// 标签名，由触发器维护
+	SearchTags string `json:"search_tags"`
+	// 分类名，由触发器维护
+	SearchCategory string `json:"search_category"`
+}
+
+// 已写回数据库的计数批次，重试同一批次时跳过
+type ArticleCounterFlush struct {
+	BatchID   string    `json:"batch_id"`
+	FlushedAt time.Time `json:"flushed_at"`
+}
+
+// 文章曾经使用过的短标识，用于旧链接跳转
+type ArticleSlugHistory struct {
+	Slug      string    `json:"slug"`
+	ArticleID uuid.UUID `json:"article_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ArticleTranslation struct {
+	ID int64 `json:"id"`
+	// 原文文章
+	SourceArticleID uuid.UUID `json:"source_article_id"`
+	// 译文草稿
+	TranslatedArticleID uuid.UUID `json:"translated_article_id"`
+	// 译文语言
+	Locale    string      `json:"locale"`
+	Model     string      `json:"model"`
+	CreatedBy pgtype.UUID `json:"created_by"`
+	CreatedAt time.Time   `json:"created_at"`
 }
 
 type AutomationArticleRequest struct {
@@ -108,6 +149,53 @@ type Comment struct {
 	ToUserID  uuid.UUID `json:"to_user_id"`
 	CreatedAt time.Time `json:"created_at"`
 	DeletedAt time.Time `json:"deleted_at"`
+	// 审核状态
+	Status string `json:"status"`
+	// 审核原因
+	ModerationReason string `json:"moderation_reason"`
+}
+
+type IndexnowSubmission struct {
+	ID       int64    `json:"id"`
+	Endpoint string   `json:"endpoint"`
+	Urls     []string `json:"urls"`
+	// 搜索引擎响应状态码，请求未发出时为 0
+	StatusCode int32 `json:"status_code"`
+	// 失败原因，成功时为空
+	Error string `json:"error"`
+	// 第几次尝试，从 1 开始
+	Attempt   int32     `json:"attempt"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Redirect struct {
+	ID int64 `json:"id"`
+	// 站内路径，以 / 开头，不含查询参数
+	SourcePath string `json:"source_path"`
+	// 站内路径或完整的 http(s) 地址
+	TargetPath string `json:"target_path"`
+	// 301、302、307 或 308
+	StatusCode int32     `json:"status_code"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type SearchClick struct {
+	ID            int64     `json:"id"`
+	SearchQueryID int64     `json:"search_query_id"`
+	ArticleID     uuid.UUID `json:"article_id"`
+	// 点击结果在列表中的位置，从 1 开始
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SearchQuery struct {
+	ID int64 `json:"id"`
+	// 规范化并脱敏后的查询词
+	Query string `json:"query"`
+	// 命中数量
+	ResultCount int64     `json:"result_count"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 type Session struct {
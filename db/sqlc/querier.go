@@ -6,6 +6,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -14,65 +15,129 @@ import (
 type Querier interface {
 	AddCommentLikes(ctx context.Context, id int64) (Comment, error)
 	BlockUserSessions(ctx context.Context, userID uuid.UUID) error
+	CountAIPromptTemplateVersions(ctx context.Context, purpose string) (int64, error)
 	CountAdminUsers(ctx context.Context) (int64, error)
 	CountAdminUsersByFilter(ctx context.Context, arg CountAdminUsersByFilterParams) (int64, error)
 	CountAllArticles(ctx context.Context, title pgtype.Text) (int64, error)
+	// 文章头部展示的评论总数，只统计已发布的评论
+	CountArticleComments(ctx context.Context, articleID uuid.UUID) (CountArticleCommentsRow, error)
 	CountArticles(ctx context.Context, arg CountArticlesParams) (int64, error)
 	CountArticlesByCategoryID(ctx context.Context, categoryID int64) (int64, error)
 	CountAutomationDraftsToday(ctx context.Context) (int64, error)
 	CountCategories(ctx context.Context) (int64, error)
+	CountHeldComments(ctx context.Context) (int64, error)
+	CountRedirects(ctx context.Context) (int64, error)
 	CountSearchArticles(ctx context.Context, arg CountSearchArticlesParams) (int64, error)
+	CreateAIPromptTemplateVersion(ctx context.Context, arg CreateAIPromptTemplateVersionParams) (AiPromptTemplateVersion, error)
 	CreateArticle(ctx context.Context, arg CreateArticleParams) (Article, error)
+	// 批次已经写回过时不插入，返回 0
+	CreateArticleCounterFlush(ctx context.Context, batchID string) (int64, error)
+	CreateArticleTranslation(ctx context.Context, arg CreateArticleTranslationParams) (ArticleTranslation, error)
 	CreateAutomationArticle(ctx context.Context, arg CreateAutomationArticleParams) (Article, error)
 	CreateAutomationArticleRequest(ctx context.Context, arg CreateAutomationArticleRequestParams) (AutomationArticleRequest, error)
 	CreateCategory(ctx context.Context, name string) (Category, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	CreateIndexNowSubmission(ctx context.Context, arg CreateIndexNowSubmissionParams) (IndexnowSubmission, error)
+	CreateRedirect(ctx context.Context, arg CreateRedirectParams) (Redirect, error)
+	CreateSearchClick(ctx context.Context, arg CreateSearchClickParams) (SearchClick, error)
+	CreateSearchQuery(ctx context.Context, arg CreateSearchQueryParams) (SearchQuery, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserWithRole(ctx context.Context, arg CreateUserWithRoleParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
 	DeleteArticle(ctx context.Context, id uuid.UUID) error
+	// 短标识重新被文章使用时移除对应的历史记录
+	DeleteArticleSlugHistory(ctx context.Context, slug string) error
 	DeleteArticlesByCategoryID(ctx context.Context, categoryID int64) error
 	DeleteCategory(ctx context.Context, id int64) error
 	DeleteChildComments(ctx context.Context, parentID int64) error
 	DeleteComment(ctx context.Context, id int64) error
 	DeleteCommentsByArticleID(ctx context.Context, articleID uuid.UUID) error
 	DeleteCommentsByCategoryID(ctx context.Context, categoryID int64) error
+	DeleteExpiredArticleCounterFlushes(ctx context.Context, expiredBefore time.Time) (int64, error)
+	DeleteExpiredSessions(ctx context.Context, expiredBefore time.Time) (int64, error)
+	DeleteExpiredVerifyEmails(ctx context.Context, expiredBefore time.Time) (int64, error)
+	DeleteRedirect(ctx context.Context, id int64) (Redirect, error)
 	DisableVisitorUser(ctx context.Context, arg DisableVisitorUserParams) (User, error)
 	EnableVisitorUser(ctx context.Context, id uuid.UUID) (User, error)
+	GetAIPromptTemplateVersion(ctx context.Context, arg GetAIPromptTemplateVersionParams) (AiPromptTemplateVersion, error)
 	GetAIProviderConfig(ctx context.Context, purpose string) (AiProviderConfig, error)
 	GetArticle(ctx context.Context, id uuid.UUID) (GetArticleRow, error)
 	GetArticleBySlug(ctx context.Context, slug pgtype.Text) (GetArticleBySlugRow, error)
 	GetArticleForUpdate(ctx context.Context, id uuid.UUID) (GetArticleForUpdateRow, error)
+	// 根据旧短标识查找仍公开的文章及其当前短标识
+	GetArticleSlugRedirect(ctx context.Context, slug string) (GetArticleSlugRedirectRow, error)
+	GetArticleTranslation(ctx context.Context, arg GetArticleTranslationParams) (ArticleTranslation, error)
 	GetAutomationArticleRequestByIdempotencyKey(ctx context.Context, idempotencyKey string) (AutomationArticleRequest, error)
 	GetCategory(ctx context.Context, id int64) (Category, error)
 	GetCategoryByName(ctx context.Context, name string) (Category, error)
 	GetComment(ctx context.Context, id int64) (Comment, error)
 	GetFirstAdminUser(ctx context.Context) (User, error)
+	GetLatestAIPromptTemplateVersion(ctx context.Context, purpose string) (AiPromptTemplateVersion, error)
+	GetRedirectBySourcePath(ctx context.Context, sourcePath string) (Redirect, error)
+	GetSearchAnalyticsSummary(ctx context.Context, arg GetSearchAnalyticsSummaryParams) (GetSearchAnalyticsSummaryRow, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetUser(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	// 批量写回缓冲的浏览量与点赞数，返回写回后的计数
+	IncrementArticleCounters(ctx context.Context, arg IncrementArticleCountersParams) ([]IncrementArticleCountersRow, error)
 	IncrementArticleLikes(ctx context.Context, id uuid.UUID) error
 	IncrementArticleViews(ctx context.Context, id uuid.UUID) error
+	// 清理临时上传文件前确认没有文章正文或封面仍在引用
+	IsResourceReferenced(ctx context.Context, path string) (bool, error)
+	ListAIPromptTemplateVersions(ctx context.Context, arg ListAIPromptTemplateVersionsParams) ([]ListAIPromptTemplateVersionsRow, error)
 	ListAdminUsers(ctx context.Context, arg ListAdminUsersParams) ([]ListAdminUsersRow, error)
 	ListAllArticles(ctx context.Context, arg ListAllArticlesParams) ([]ListAllArticlesRow, error)
 	ListAllCategories(ctx context.Context) ([]Category, error)
+	// 重试已写回的批次时读取当前计数
+	ListArticleCounters(ctx context.Context, ids []uuid.UUID) ([]ListArticleCountersRow, error)
 	ListArticleResourceRefsByCategoryID(ctx context.Context, categoryID int64) ([]ListArticleResourceRefsByCategoryIDRow, error)
 	ListArticles(ctx context.Context, arg ListArticlesParams) ([]ListArticlesRow, error)
 	ListArticlesByCategoryID(ctx context.Context, arg ListArticlesByCategoryIDParams) ([]ListArticlesByCategoryIDRow, error)
 	ListCategoriesCountArticles(ctx context.Context, arg ListCategoriesCountArticlesParams) ([]ListCategoriesCountArticlesRow, error)
-	ListCommentsByArticleID(ctx context.Context, articleID uuid.UUID) ([]ListCommentsByArticleIDRow, error)
-	ListPublishedArticleSitemapItems(ctx context.Context) ([]ListPublishedArticleSitemapItemsRow, error)
+	ListCommentReplies(ctx context.Context, arg ListCommentRepliesParams) ([]ListCommentRepliesRow, error)
+	// 每条顶层评论预取前几条回复，其余回复按需加载
+	ListCommentReplyPreviews(ctx context.Context, arg ListCommentReplyPreviewsParams) ([]ListCommentReplyPreviewsRow, error)
+	ListHeldComments(ctx context.Context, arg ListHeldCommentsParams) ([]ListHeldCommentsRow, error)
+	// 缓存预热使用，按浏览量取已发布文章
+	ListPopularArticleIDs(ctx context.Context, limit int32) ([]ListPopularArticleIDsRow, error)
+	// 按创建时间稳定排序，保证分页后的子站点地图内容不随文章更新而漂移
+	ListPublishedArticleSitemapItems(ctx context.Context, arg ListPublishedArticleSitemapItemsParams) ([]ListPublishedArticleSitemapItemsRow, error)
+	// 与 ListPublishedArticleSitemapItems 使用相同排序，返回每个分页的最后修改时间
+	ListPublishedArticleSitemapPages(ctx context.Context, pageSize int32) ([]ListPublishedArticleSitemapPagesRow, error)
 	ListPublishedCategorySitemapItems(ctx context.Context) ([]ListPublishedCategorySitemapItemsRow, error)
+	ListRedirects(ctx context.Context, arg ListRedirectsParams) ([]Redirect, error)
+	// 相关度：标题与摘要的相似度 + 共同标签数 * 3 + 同分类 2，权重为 0 的字段不参与匹配
+	ListRelatedArticles(ctx context.Context, arg ListRelatedArticlesParams) ([]ListRelatedArticlesRow, error)
+	// 分类分面忽略分类筛选，便于切换分类
+	ListSearchArticleCategoryFacets(ctx context.Context, arg ListSearchArticleCategoryFacetsParams) ([]ListSearchArticleCategoryFacetsRow, error)
+	// 年份分面忽略日期筛选，便于切换年份；年份按 time_zone 换算，需与日期筛选使用同一时区
+	ListSearchArticleYearFacets(ctx context.Context, arg ListSearchArticleYearFacetsParams) ([]ListSearchArticleYearFacetsRow, error)
+	ListSearchVocabulary(ctx context.Context) ([]string, error)
+	// 按 id 游标分页顶层评论，回复数用于提示按需加载
+	ListTopLevelComments(ctx context.Context, arg ListTopLevelCommentsParams) ([]ListTopLevelCommentsRow, error)
+	ListTopSearchQueries(ctx context.Context, arg ListTopSearchQueriesParams) ([]ListTopSearchQueriesRow, error)
+	ListZeroResultSearchQueries(ctx context.Context, arg ListZeroResultSearchQueriesParams) ([]ListZeroResultSearchQueriesRow, error)
+	// 事务级锁，同一用途的版本号按 MAX(version) + 1 串行分配
+	LockAIPromptTemplateVersions(ctx context.Context, purpose string) error
 	MarkAutomationArticleRequestCreated(ctx context.Context, arg MarkAutomationArticleRequestCreatedParams) (AutomationArticleRequest, error)
+	// 只更新仍在等待 AI 审核的评论，管理员已处理的评论不会被覆盖
+	ResolvePendingComment(ctx context.Context, arg ResolvePendingCommentParams) (Comment, error)
+	// 字段权重：标题 10、摘要 3、正文 1、标签 6、分类名 4
 	SearchArticles(ctx context.Context, arg SearchArticlesParams) ([]SearchArticlesRow, error)
 	SetArticleDefaultCategoryIdByCategoryId(ctx context.Context, categoryID int64) error
+	SuggestArticleTitles(ctx context.Context, arg SuggestArticleTitlesParams) ([]string, error)
+	SuggestTags(ctx context.Context, arg SuggestTagsParams) ([]SuggestTagsRow, error)
 	UpdateArticle(ctx context.Context, arg UpdateArticleParams) (Article, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error)
+	UpdateCommentModeration(ctx context.Context, arg UpdateCommentModerationParams) (Comment, error)
+	UpdateRedirect(ctx context.Context, arg UpdateRedirectParams) (Redirect, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
 	UpdateVisitorUser(ctx context.Context, arg UpdateVisitorUserParams) (User, error)
 	UpsertAIProviderConfig(ctx context.Context, arg UpsertAIProviderConfigParams) (AiProviderConfig, error)
+	// 同一个旧短标识只指向最近使用它的文章
+	UpsertArticleSlugHistory(ctx context.Context, arg UpsertArticleSlugHistoryParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomRedirect(t *testing.T) Redirect {
+	arg := CreateRedirectParams{
+		SourcePath: "/" + util.RandomString(10),
+		TargetPath: "/article/" + util.RandomString(8),
+		StatusCode: 301,
+	}
+
+	redirect, err := testStore.CreateRedirect(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, redirect.ID)
+	require.Equal(t, arg.SourcePath, redirect.SourcePath)
+	require.Equal(t, arg.TargetPath, redirect.TargetPath)
+	require.Equal(t, arg.StatusCode, redirect.StatusCode)
+	require.NotZero(t, redirect.CreatedAt)
+
+	return redirect
+}
+
+func TestGetRedirectBySourcePath(t *testing.T) {
+	redirect := createRandomRedirect(t)
+
+	got, err := testStore.GetRedirectBySourcePath(context.Background(), redirect.SourcePath)
+	require.NoError(t, err)
+	require.Equal(t, redirect.ID, got.ID)
+	require.Equal(t, redirect.TargetPath, got.TargetPath)
+}
+
+func TestUpdateRedirect(t *testing.T) {
+	redirect := createRandomRedirect(t)
+
+	updated, err := testStore.UpdateRedirect(context.Background(), UpdateRedirectParams{
+		ID:         redirect.ID,
+		StatusCode: pgtype.Int4{Int32: 302, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, redirect.TargetPath, updated.TargetPath)
+	require.Equal(t, int32(302), updated.StatusCode)
+	require.True(t, updated.UpdatedAt.After(redirect.UpdatedAt) || updated.UpdatedAt.Equal(redirect.UpdatedAt))
+}
+
+func TestListAndDeleteRedirects(t *testing.T) {
+	redirect := createRandomRedirect(t)
+
+	count, err := testStore.CountRedirects(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(1))
+
+	redirects, err := testStore.ListRedirects(context.Background(), ListRedirectsParams{
+		Limit:  int32(count),
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, redirects, int(count))
+
+	deleted, err := testStore.DeleteRedirect(context.Background(), redirect.ID)
+	require.NoError(t, err)
+	require.Equal(t, redirect.ID, deleted.ID)
+
+	_, err = testStore.GetRedirectBySourcePath(context.Background(), redirect.SourcePath)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
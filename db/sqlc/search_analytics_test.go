@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchAnalytics(t *testing.T) {
+	category := createRandomCategory(t)
+	article := createRandomArticle(t, true, category.ID)
+	query := "analytics " + util.RandomString(8)
+	startTime := time.Now().Add(-time.Minute)
+
+	hit, err := testStore.CreateSearchQuery(context.Background(), CreateSearchQueryParams{Query: query, ResultCount: 2})
+	require.NoError(t, err)
+	_, err = testStore.CreateSearchQuery(context.Background(), CreateSearchQueryParams{Query: query, ResultCount: 0})
+	require.NoError(t, err)
+
+	click, err := testStore.CreateSearchClick(context.Background(), CreateSearchClickParams{
+		SearchQueryID: hit.ID,
+		ArticleID:     article.ID,
+		Position:      2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), click.Position)
+
+	_, err = testStore.CreateSearchClick(context.Background(), CreateSearchClickParams{
+		SearchQueryID: hit.ID + 1_000_000,
+		ArticleID:     article.ID,
+		Position:      1,
+	})
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+
+	window := GetSearchAnalyticsSummaryParams{StartTime: startTime, EndTime: time.Now().Add(time.Minute)}
+	summary, err := testStore.GetSearchAnalyticsSummary(context.Background(), window)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, summary.Searches, int64(2))
+	require.GreaterOrEqual(t, summary.ClickedSearches, int64(1))
+
+	topQueries, err := testStore.ListTopSearchQueries(context.Background(), ListTopSearchQueriesParams{
+		StartTime:  window.StartTime,
+		EndTime:    window.EndTime,
+		MaxResults: 100,
+	})
+	require.NoError(t, err)
+	var found bool
+	for _, row := range topQueries {
+		if row.Query == query {
+			found = true
+			require.Equal(t, int64(2), row.Searches)
+			require.Equal(t, int64(1), row.ClickedSearches)
+			require.InDelta(t, 1.0, row.AvgResultCount, 1e-9)
+		}
+	}
+	require.True(t, found)
+
+	zeroResultQueries, err := testStore.ListZeroResultSearchQueries(context.Background(), ListZeroResultSearchQueriesParams{
+		StartTime:  window.StartTime,
+		EndTime:    window.EndTime,
+		MaxResults: 100,
+	})
+	require.NoError(t, err)
+	found = false
+	for _, row := range zeroResultQueries {
+		if row.Query == query {
+			found = true
+			require.Equal(t, int64(1), row.Searches)
+		}
+	}
+	require.True(t, found)
+}
@@ -12,6 +12,7 @@ type Store interface {
 	Ping(ctx context.Context) error
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	CreateAutomationArticleTx(ctx context.Context, arg CreateAutomationArticleTxParams) (CreateAutomationArticleTxResult, error)
+	CreateArticleTranslationTx(ctx context.Context, arg CreateArticleTranslationTxParams) (CreateArticleTranslationTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
 	UpdateArticleTx(ctx context.Context, arg UpdateArticleTxParams) (UpdateArticleTxResult, error)
 	DeleteArticleTx(ctx context.Context, arg DeleteArticleTxParams) error
@@ -19,6 +20,8 @@ type Store interface {
 	UpdateCategoryTx(ctx context.Context, arg UpdateCategoryTxParams) (UpdateCategoryTxResult, error)
 	CreateCategoryTx(ctx context.Context, arg CreateCategoryTxParams) (CreateCategoryTxResult, error)
 	DisableVisitorUserTx(ctx context.Context, arg DisableVisitorUserTxParams) (DisableVisitorUserTxResult, error)
+	SaveAIConfigTx(ctx context.Context, arg SaveAIConfigTxParams) (SaveAIConfigTxResult, error)
+	FlushArticleCountersTx(ctx context.Context, arg FlushArticleCountersTxParams) (FlushArticleCountersTxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateArticleTranslationTxParams struct {
+	SourceArticleID uuid.UUID
+	Article         CreateArticleParams
+	Locale          string
+	Model           string
+	CreatedBy       pgtype.UUID
+}
+
+type CreateArticleTranslationTxResult struct {
+	Article     Article
+	Translation ArticleTranslation
+}
+
+// CreateArticleTranslationTx 创建译文草稿并关联到原文
+func (store *SQLStore) CreateArticleTranslationTx(ctx context.Context, arg CreateArticleTranslationTxParams) (CreateArticleTranslationTxResult, error) {
+	var result CreateArticleTranslationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Article, err = q.CreateArticle(ctx, arg.Article)
+		if err != nil {
+			return err
+		}
+
+		result.Translation, err = q.CreateArticleTranslation(ctx, CreateArticleTranslationParams{
+			SourceArticleID:     arg.SourceArticleID,
+			TranslatedArticleID: result.Article.ID,
+			Locale:              arg.Locale,
+			Model:               arg.Model,
+			CreatedBy:           arg.CreatedBy,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// articleCounterFlushRetention 批次记录的保留时间，远大于缓存中批次的存活时间即可
+const articleCounterFlushRetention = 7 * 24 * time.Hour
+
+type FlushArticleCountersTxParams struct {
+	// BatchID 同一批增量重试时不变，用于判断是否已经写回
+	BatchID string
+	IncrementArticleCountersParams
+}
+
+type FlushArticleCountersTxResult struct {
+	// Applied 为 false 表示该批次此前已经写回，本次没有修改计数
+	Applied bool
+	// Articles 写回后各文章的计数
+	Articles []IncrementArticleCountersRow
+}
+
+// FlushArticleCountersTx 在同一事务内记录批次并写回计数，同一批次只会生效一次
+func (store *SQLStore) FlushArticleCountersTx(ctx context.Context, arg FlushArticleCountersTxParams) (FlushArticleCountersTxResult, error) {
+	var result FlushArticleCountersTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateArticleCounterFlush(ctx, arg.BatchID)
+		if err != nil {
+			return err
+		}
+		if inserted == 0 {
+			rows, err := q.ListArticleCounters(ctx, arg.Ids)
+			if err != nil {
+				return err
+			}
+			result.Articles = make([]IncrementArticleCountersRow, 0, len(rows))
+			for _, row := range rows {
+				result.Articles = append(result.Articles, IncrementArticleCountersRow(row))
+			}
+			return nil
+		}
+
+		result.Applied = true
+		result.Articles, err = q.IncrementArticleCounters(ctx, arg.IncrementArticleCountersParams)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteExpiredArticleCounterFlushes(ctx, time.Now().Add(-articleCounterFlushRetention))
+		return err
+	})
+	if err != nil {
+		return FlushArticleCountersTxResult{}, err
+	}
+
+	return result, nil
+}
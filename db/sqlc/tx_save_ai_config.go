@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+)
+
+type SaveAIConfigTxParams struct {
+	UpsertAIProviderConfigParams
+	// AfterSave 与配置写入处于同一事务，用于记录提示词模板版本
+	AfterSave func(q Querier, config AiProviderConfig) error
+}
+
+type SaveAIConfigTxResult struct {
+	Config AiProviderConfig
+}
+
+// SaveAIConfigTx 保存 AI 配置，并在同一事务内记录提示词模板版本
+func (store *SQLStore) SaveAIConfigTx(ctx context.Context, arg SaveAIConfigTxParams) (SaveAIConfigTxResult, error) {
+	var result SaveAIConfigTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockAIPromptTemplateVersions(ctx, arg.Purpose)
+		if err != nil {
+			return err
+		}
+
+		result.Config, err = q.UpsertAIProviderConfig(ctx, arg.UpsertAIProviderConfigParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.AfterSave == nil {
+			return nil
+		}
+		return arg.AfterSave(q, result.Config)
+	})
+
+	return result, err
+}
@@ -19,13 +19,32 @@ func (store *SQLStore) UpdateArticleTx(ctx context.Context, arg UpdateArticleTxP
 	var result UpdateArticleTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
+		previous, err := q.GetArticleForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
 
 		result.Article, err = q.UpdateArticle(ctx, arg.UpdateArticleParams)
 		if err != nil {
 			return err
 		}
 
+		// 记录旧短标识以便旧链接跳转；重新启用的短标识不再视为历史
+		if result.Article.Slug.Valid {
+			if err := q.DeleteArticleSlugHistory(ctx, result.Article.Slug.String); err != nil {
+				return err
+			}
+		}
+		if previous.Slug.Valid && previous.Slug.String != "" && previous.Slug != result.Article.Slug {
+			err = q.UpsertArticleSlugHistory(ctx, UpsertArticleSlugHistoryParams{
+				Slug:      previous.Slug.String,
+				ArticleID: result.Article.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		return arg.AfterUpdate(result.Article)
 	})
 
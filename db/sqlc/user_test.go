@@ -337,3 +337,50 @@ func TestDisableVisitorUserTxRejectsAdminUser(t *testing.T) {
 	require.False(t, latestAdmin.DisabledAt.Valid)
 	require.Empty(t, latestAdmin.DisabledReason)
 }
+
+func TestDeleteExpiredSessions(t *testing.T) {
+	user := createRandomUser(t)
+	active := createRandomSession(t, user.ID)
+
+	expired, err := testStore.CreateSession(context.Background(), CreateSessionParams{
+		ID:           uuid.New(),
+		UserID:       user.ID,
+		RefreshToken: uuid.NewString(),
+		UserAgent:    "db-test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	deleted, err := testStore.DeleteExpiredSessions(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = testStore.GetSession(context.Background(), expired.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	_, err = testStore.GetSession(context.Background(), active.ID)
+	require.NoError(t, err)
+}
+
+func TestDeleteExpiredVerifyEmails(t *testing.T) {
+	user := createRandomUser(t)
+	verifyEmail, err := testStore.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		UserID:     user.ID,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	// 刚创建的验证码在 15 分钟内有效，不应被删除
+	_, err = testStore.DeleteExpiredVerifyEmails(context.Background(), time.Now())
+	require.NoError(t, err)
+	_, err = testStore.UpdateVerifyEmail(context.Background(), UpdateVerifyEmailParams{
+		ID:         verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+
+	deleted, err := testStore.DeleteExpiredVerifyEmails(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+}
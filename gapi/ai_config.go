@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"maps"
 	"net/url"
 	"strings"
 	"time"
@@ -12,100 +13,31 @@ import (
 	"github.com/MonitorAllen/nostalgia/internal/ai"
 	"github.com/MonitorAllen/nostalgia/internal/secrets"
 	"github.com/MonitorAllen/nostalgia/pb"
-	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-const (
-	aiPolishConfigPurpose = "ai_polish"
-	aiPolishAPIKeyAAD     = "nostalgia:ai-polish-api-key"
-	aiConfigSourceEnv     = "runtime_env"
-	aiConfigSourceDB      = "database"
-)
-
-type resolvedAIConfig struct {
-	Provider         string
-	APIProtocol      string
-	BaseURL          string
-	Model            string
-	APIKey           string
-	Timeout          time.Duration
-	MaxInputChars    int
-	MaxContextChars  int
-	MaxSuggestions   int
-	PromptTemplates  map[string]string
-	EnabledRequested bool
-	Source           string
-}
+type resolvedAIConfig = ai.ResolvedConfig
 
-func (server *Server) resolveAIPolishConfig(ctx context.Context) (resolvedAIConfig, error) {
-	runtimeConfig := server.runtimeAIPolishConfig()
-	if server.store == nil {
-		return runtimeConfig, nil
-	}
-
-	row, err := server.store.GetAIProviderConfig(ctx, aiPolishConfigPurpose)
-	if errors.Is(err, pgx.ErrNoRows) {
-		return runtimeConfig, nil
-	}
+// normalizeAIConfigPurpose 解析用途名称，不支持的用途返回 InvalidArgument
+func normalizeAIConfigPurpose(value string) (string, error) {
+	purpose, err := ai.NormalizeConfigPurpose(value)
 	if err != nil {
-		return resolvedAIConfig{}, err
+		return "", status.Error(codes.InvalidArgument, "unsupported AI config purpose")
 	}
-
-	return server.aiConfigFromRow(row)
-}
-
-func (server *Server) runtimeAIPolishConfig() resolvedAIConfig {
-	cfg := resolvedAIConfig{
-		Provider:         strings.TrimSpace(server.config.AIPolishProvider),
-		APIProtocol:      normalizeAIPolishProtocol(server.config.AIPolishAPIProtocol),
-		BaseURL:          strings.TrimSpace(server.config.AIPolishBaseURL),
-		Model:            strings.TrimSpace(server.config.AIPolishModel),
-		APIKey:           strings.TrimSpace(server.config.AIPolishAPIKey),
-		Timeout:          normalizedDuration(server.config.AIPolishTimeout, 60*time.Second),
-		MaxInputChars:    normalizedPositive(server.config.AIPolishMaxInputChars, 6000),
-		MaxContextChars:  normalizedNonNegative(server.config.AIPolishMaxContextChars, 4000),
-		MaxSuggestions:   normalizedPositive(server.config.AIPolishMaxSuggestions, 3),
-		PromptTemplates:  ai.DefaultPromptTemplates(),
-		EnabledRequested: true,
-		Source:           aiConfigSourceEnv,
-	}
-	return cfg
+	return purpose, nil
 }
 
-func (server *Server) aiConfigFromRow(row db.AiProviderConfig) (resolvedAIConfig, error) {
-	apiKey, err := secrets.DecryptString(row.ApiKeyCiphertext, server.config.TokenSymmetricKey, aiPolishAPIKeyAAD)
-	if err != nil {
-		apiKey = ""
-	}
-
-	return resolvedAIConfig{
-		Provider:         strings.TrimSpace(row.Provider),
-		APIProtocol:      normalizeAIPolishProtocol(row.ApiProtocol),
-		BaseURL:          strings.TrimSpace(row.BaseUrl),
-		Model:            strings.TrimSpace(row.Model),
-		APIKey:           strings.TrimSpace(apiKey),
-		Timeout:          normalizedDuration(time.Duration(row.TimeoutMs)*time.Millisecond, 30*time.Second),
-		MaxInputChars:    normalizedPositive(int(row.MaxInputChars), 6000),
-		MaxContextChars:  normalizedNonNegative(int(row.MaxContextChars), 4000),
-		MaxSuggestions:   normalizedPositive(int(row.MaxSuggestions), 3),
-		PromptTemplates:  decodePromptTemplates(row.PromptTemplates),
-		EnabledRequested: row.Enabled,
-		Source:           aiConfigSourceDB,
-	}, nil
-}
-
-func (cfg resolvedAIConfig) toResponse() *pb.GetAIConfigResponse {
+func aiConfigResponse(cfg resolvedAIConfig) *pb.GetAIConfigResponse {
 	return &pb.GetAIConfigResponse{
 		Provider:               cfg.Provider,
 		ApiProtocol:            cfg.APIProtocol,
 		BaseUrl:                cfg.BaseURL,
 		Model:                  cfg.Model,
-		ApiKeyConfigured:       cfg.apiKeyConfigured(),
-		Enabled:                cfg.usable(),
+		ApiKeyConfigured:       cfg.APIKeyConfigured(),
+		Enabled:                cfg.Usable(),
 		Timeout:                cfg.Timeout.String(),
 		MaxInputChars:          int32(cfg.MaxInputChars),
 		MaxContextChars:        int32(cfg.MaxContextChars),
@@ -113,45 +45,26 @@ func (cfg resolvedAIConfig) toResponse() *pb.GetAIConfigResponse {
 		Source:                 cfg.Source,
 		PromptTemplates:        ai.NormalizePromptTemplates(cfg.PromptTemplates),
 		DefaultPromptTemplates: ai.DefaultPromptTemplates(),
+		Purpose:                cfg.Purpose,
+		Inherited:              cfg.Inherited,
 	}
 }
 
-func (cfg resolvedAIConfig) toRuntimeConfig(base util.Config) util.Config {
-	base.AIPolishProvider = cfg.Provider
-	base.AIPolishAPIProtocol = cfg.APIProtocol
-	base.AIPolishBaseURL = cfg.BaseURL
-	base.AIPolishAPIKey = cfg.APIKey
-	base.AIPolishModel = cfg.Model
-	base.AIPolishTimeout = cfg.Timeout
-	base.AIPolishMaxInputChars = cfg.MaxInputChars
-	base.AIPolishMaxContextChars = cfg.MaxContextChars
-	base.AIPolishMaxSuggestions = cfg.MaxSuggestions
-	return base
-}
-
-func (cfg resolvedAIConfig) apiKeyConfigured() bool {
-	return strings.TrimSpace(cfg.APIKey) != ""
-}
-
-func (cfg resolvedAIConfig) usable() bool {
-	return cfg.EnabledRequested &&
-		strings.TrimSpace(cfg.Provider) != "" &&
-		strings.TrimSpace(cfg.APIProtocol) != "" &&
-		strings.TrimSpace(cfg.BaseURL) != "" &&
-		strings.TrimSpace(cfg.Model) != "" &&
-		cfg.apiKeyConfigured()
-}
-
-func (server *Server) buildUpdatedAIConfig(ctx context.Context, req *pb.UpdateAIConfigRequest) (resolvedAIConfig, error) {
-	current, err := server.resolveAIPolishConfig(ctx)
+// buildUpdatedAIConfig 返回更新后的配置以及更新前的配置
+func (server *Server) buildUpdatedAIConfig(ctx context.Context, req *pb.UpdateAIConfigRequest) (resolvedAIConfig, resolvedAIConfig, error) {
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
 	if err != nil {
-		return resolvedAIConfig{}, err
+		return resolvedAIConfig{}, resolvedAIConfig{}, err
+	}
+	current, err := server.aiConfigs.Resolve(ctx, purpose)
+	if err != nil {
+		return resolvedAIConfig{}, resolvedAIConfig{}, err
 	}
 
 	provider := strings.TrimSpace(req.GetProvider())
 	apiProtocol := current.APIProtocol
 	if strings.TrimSpace(req.GetApiProtocol()) != "" {
-		apiProtocol = normalizeAIPolishProtocol(req.GetApiProtocol())
+		apiProtocol = ai.NormalizeAPIProtocol(req.GetApiProtocol())
 	}
 	baseURL := strings.TrimSpace(req.GetBaseUrl())
 	model := strings.TrimSpace(req.GetModel())
@@ -166,7 +79,7 @@ func (server *Server) buildUpdatedAIConfig(ctx context.Context, req *pb.UpdateAI
 	if strings.TrimSpace(req.GetTimeout()) != "" {
 		parsed, parseErr := time.ParseDuration(strings.TrimSpace(req.GetTimeout()))
 		if parseErr != nil {
-			return resolvedAIConfig{}, status.Error(codes.InvalidArgument, "invalid AI timeout")
+			return resolvedAIConfig{}, current, status.Error(codes.InvalidArgument, "invalid AI timeout")
 		}
 		timeout = parsed
 	}
@@ -183,20 +96,21 @@ func (server *Server) buildUpdatedAIConfig(ctx context.Context, req *pb.UpdateAI
 		MaxSuggestions:   choosePositive(req.GetMaxSuggestions(), current.MaxSuggestions, 3),
 		PromptTemplates:  choosePromptTemplates(req.GetPromptTemplates(), current.PromptTemplates),
 		EnabledRequested: req.GetEnabled(),
-		Source:           aiConfigSourceDB,
+		Source:           ai.ConfigSourceDB,
+		Purpose:          purpose,
 	}
 
 	if err := validateUpdatedAIConfig(cfg); err != nil {
-		return resolvedAIConfig{}, err
+		return resolvedAIConfig{}, current, err
 	}
-	return cfg, nil
+	return cfg, current, nil
 }
 
 func validateUpdatedAIConfig(cfg resolvedAIConfig) error {
 	if cfg.Provider == "" || len([]rune(cfg.Provider)) > 64 {
 		return status.Error(codes.InvalidArgument, "AI provider name is required")
 	}
-	if !isSupportedAIPolishProtocol(cfg.APIProtocol) {
+	if !ai.IsSupportedAPIProtocol(cfg.APIProtocol) {
 		return status.Error(codes.InvalidArgument, "unsupported AI API protocol")
 	}
 	parsedURL, err := url.Parse(cfg.BaseURL)
@@ -221,49 +135,82 @@ func validateUpdatedAIConfig(cfg resolvedAIConfig) error {
 	if cfg.MaxSuggestions < 1 || cfg.MaxSuggestions > 5 {
 		return status.Error(codes.InvalidArgument, "AI max suggestions must be between 1 and 5")
 	}
-	if cfg.EnabledRequested && !cfg.apiKeyConfigured() {
+	if cfg.EnabledRequested && !cfg.APIKeyConfigured() {
 		return status.Error(codes.InvalidArgument, "AI API key is required when enabled")
 	}
 	return nil
 }
 
-func (server *Server) saveAIConfig(ctx context.Context, cfg resolvedAIConfig, updatedBy pgtype.UUID) (resolvedAIConfig, error) {
-	ciphertext, err := secrets.EncryptString(cfg.APIKey, server.config.TokenSymmetricKey, aiPolishAPIKeyAAD)
+// saveAIConfig afterSave 与配置写入处于同一事务，返回错误时配置不会保存
+func (server *Server) saveAIConfig(ctx context.Context, cfg resolvedAIConfig, updatedBy pgtype.UUID, afterSave func(q db.Querier) error) (resolvedAIConfig, error) {
+	ciphertext, err := secrets.EncryptString(cfg.APIKey, server.config.TokenSymmetricKey, ai.ConfigAPIKeyAAD(cfg.Purpose))
 	if err != nil {
 		return resolvedAIConfig{}, err
 	}
 
-	row, err := server.store.UpsertAIProviderConfig(ctx, db.UpsertAIProviderConfigParams{
-		Purpose:          aiPolishConfigPurpose,
-		Provider:         cfg.Provider,
-		ApiProtocol:      cfg.APIProtocol,
-		BaseUrl:          cfg.BaseURL,
-		Model:            cfg.Model,
-		ApiKeyCiphertext: ciphertext,
-		TimeoutMs:        int32(cfg.Timeout / time.Millisecond),
-		MaxInputChars:    int32(cfg.MaxInputChars),
-		MaxContextChars:  int32(cfg.MaxContextChars),
-		MaxSuggestions:   int32(cfg.MaxSuggestions),
-		PromptTemplates:  encodePromptTemplates(cfg.PromptTemplates),
-		Enabled:          cfg.EnabledRequested,
-		UpdatedBy:        updatedBy,
+	result, err := server.store.SaveAIConfigTx(ctx, db.SaveAIConfigTxParams{
+		UpsertAIProviderConfigParams: db.UpsertAIProviderConfigParams{
+			Purpose:          ai.ConfigStorageKey(cfg.Purpose),
+			Provider:         cfg.Provider,
+			ApiProtocol:      cfg.APIProtocol,
+			BaseUrl:          cfg.BaseURL,
+			Model:            cfg.Model,
+			ApiKeyCiphertext: ciphertext,
+			TimeoutMs:        int32(cfg.Timeout / time.Millisecond),
+			MaxInputChars:    int32(cfg.MaxInputChars),
+			MaxContextChars:  int32(cfg.MaxContextChars),
+			MaxSuggestions:   int32(cfg.MaxSuggestions),
+			PromptTemplates:  encodePromptTemplates(cfg.PromptTemplates),
+			Enabled:          cfg.EnabledRequested,
+			UpdatedBy:        updatedBy,
+		},
+		AfterSave: func(q db.Querier, _ db.AiProviderConfig) error {
+			if afterSave == nil {
+				return nil
+			}
+			return afterSave(q)
+		},
 	})
 	if err != nil {
 		return resolvedAIConfig{}, err
 	}
 
-	return server.aiConfigFromRow(row)
+	return server.aiConfigs.FromRow(result.Config), nil
 }
 
-func decodePromptTemplates(raw []byte) map[string]string {
-	if len(raw) == 0 {
-		return ai.DefaultPromptTemplates()
+// recordPromptTemplateVersion 模板有变化时保存新版本；首次记录时先保存修改前的模板，保证可以回滚
+func recordPromptTemplateVersion(ctx context.Context, q db.Querier, purpose string, previous map[string]string, next map[string]string, author pgtype.UUID) error {
+	if promptTemplatesEqual(previous, next) {
+		return nil
 	}
-	var values map[string]string
-	if err := json.Unmarshal(raw, &values); err != nil {
-		return ai.DefaultPromptTemplates()
+
+	storageKey := ai.ConfigStorageKey(purpose)
+	latest, err := q.GetLatestAIPromptTemplateVersion(ctx, storageKey)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		_, err = q.CreateAIPromptTemplateVersion(ctx, db.CreateAIPromptTemplateVersionParams{
+			Purpose:         storageKey,
+			PromptTemplates: encodePromptTemplates(previous),
+		})
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case promptTemplatesEqual(ai.DecodePromptTemplates(latest.PromptTemplates), next):
+		return nil
 	}
-	return ai.NormalizePromptTemplates(values)
+
+	_, err = q.CreateAIPromptTemplateVersion(ctx, db.CreateAIPromptTemplateVersionParams{
+		Purpose:         storageKey,
+		PromptTemplates: encodePromptTemplates(next),
+		CreatedBy:       author,
+	})
+	return err
+}
+
+func promptTemplatesEqual(a map[string]string, b map[string]string) bool {
+	return maps.Equal(ai.NormalizePromptTemplates(a), ai.NormalizePromptTemplates(b))
 }
 
 func encodePromptTemplates(values map[string]string) []byte {
@@ -287,57 +234,16 @@ func choosePromptTemplates(next map[string]string, current map[string]string) ma
 	return current
 }
 
-func normalizedDuration(value time.Duration, fallback time.Duration) time.Duration {
-	if value <= 0 {
-		return fallback
-	}
-	return value
-}
-
-func normalizedPositive(value int, fallback int) int {
-	if value <= 0 {
-		return fallback
-	}
-	return value
-}
-
-func normalizedNonNegative(value int, fallback int) int {
-	if value < 0 {
-		return fallback
-	}
-	return value
-}
-
 func choosePositive(next int32, current int, fallback int) int {
 	if next > 0 {
 		return int(next)
 	}
-	return normalizedPositive(current, fallback)
+	return ai.NormalizedPositive(current, fallback)
 }
 
 func chooseNonNegative(next int32, current int, fallback int) int {
 	if next >= 0 {
 		return int(next)
 	}
-	return normalizedNonNegative(current, fallback)
-}
-
-func normalizeAIPolishProtocol(value string) string {
-	switch strings.Trim(strings.TrimSpace(value), "/") {
-	case ai.APIProtocolResponses:
-		return ai.APIProtocolResponses
-	case ai.APIProtocolMessages:
-		return ai.APIProtocolMessages
-	default:
-		return ai.APIProtocolChatCompletions
-	}
-}
-
-func isSupportedAIPolishProtocol(value string) bool {
-	switch value {
-	case ai.APIProtocolChatCompletions, ai.APIProtocolResponses, ai.APIProtocolMessages:
-		return true
-	default:
-		return false
-	}
+	return ai.NormalizedNonNegative(current, fallback)
 }
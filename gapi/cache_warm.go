@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MonitorAllen/nostalgia/worker"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// distributeWarmCache 公开文章变化后重新预热列表与热门文章，已有待执行的预热任务时直接复用
+func (server *Server) distributeWarmCache(ctx context.Context) {
+	if server.taskDistributor == nil || server.cache == nil {
+		return
+	}
+
+	err := server.taskDistributor.DistributeTaskWarmCacheDefault(ctx, worker.NewPayloadWarmCache(server.config))
+	if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+		log.Error().Err(err).Msg("failed to distribute cache warm task")
+	}
+}
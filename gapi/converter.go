@@ -1,8 +1,14 @@
 package gapi
 
 import (
+	"encoding/base64"
+	"unicode/utf8"
+
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
 	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -163,3 +169,135 @@ func convertAdminUserRow(user db.ListAdminUsersRow) *pb.User {
 		DisabledReason:  user.DisabledReason,
 	}
 }
+
+func convertPromptTemplateVersion(version db.AiPromptTemplateVersion, authorUsername pgtype.Text) *pb.PromptTemplateVersion {
+	pbVersion := &pb.PromptTemplateVersion{
+		Purpose:         version.Purpose,
+		Version:         version.Version,
+		PromptTemplates: ai.DecodePromptTemplates(version.PromptTemplates),
+		AuthorUsername:  authorUsername.String,
+		RollbackOf:      version.RollbackOf.Int32,
+		CreatedAt:       timestamppb.New(version.CreatedAt),
+	}
+	if version.CreatedBy.Valid {
+		pbVersion.AuthorId = uuid.UUID(version.CreatedBy.Bytes).String()
+	}
+	return pbVersion
+}
+
+func convertHeldComment(comment db.ListHeldCommentsRow) *pb.ModeratedComment {
+	return &pb.ModeratedComment{
+		Id:               comment.ID,
+		Content:          comment.Content,
+		ArticleId:        comment.ArticleID.String(),
+		ArticleTitle:     comment.ArticleTitle.String,
+		FromUserId:       comment.FromUserID.String(),
+		FromUserName:     comment.FromUserName.String,
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+		CreatedAt:        timestamppb.New(comment.CreatedAt),
+	}
+}
+
+func convertModeratedComment(comment db.Comment) *pb.ModeratedComment {
+	return &pb.ModeratedComment{
+		Id:               comment.ID,
+		Content:          comment.Content,
+		ArticleId:        comment.ArticleID.String(),
+		FromUserId:       comment.FromUserID.String(),
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+		CreatedAt:        timestamppb.New(comment.CreatedAt),
+	}
+}
+
+func convertRedirect(redirect db.Redirect) *pb.Redirect {
+	return &pb.Redirect{
+		Id:         redirect.ID,
+		SourcePath: redirect.SourcePath,
+		TargetPath: redirect.TargetPath,
+		StatusCode: redirect.StatusCode,
+		CreatedAt:  timestamppb.New(redirect.CreatedAt),
+		UpdatedAt:  timestamppb.New(redirect.UpdatedAt),
+	}
+}
+
+func convertSearchArticleHit(row db.SearchArticlesRow) *pb.SearchArticleHit {
+	return &pb.SearchArticleHit{
+		Article: &pb.Article{
+			Id:                  row.ID.String(),
+			Title:               row.Title,
+			Summary:             &row.Summary,
+			IsPublish:           &row.IsPublish,
+			Views:               &row.Views,
+			Likes:               &row.Likes,
+			Cover:               row.Cover,
+			Slug:                row.Slug.String,
+			CheckOutdated:       &row.CheckOutdated,
+			ReadTime:            row.ReadTime,
+			LastUpdated:         timestamppb.New(row.LastUpdated),
+			CreatedByAutomation: &row.CreatedByAutomation,
+			AutomationStatus:    row.AutomationStatus,
+			CreatedAt:           timestamppb.New(row.CreatedAt),
+			UpdatedAt:           timestamppb.New(row.UpdatedAt),
+			DeletedAt:           timestamppb.New(row.DeletedAt),
+			Owner:               row.Owner.String(),
+			CategoryId:          row.CategoryID,
+			CategoryName:        row.CategoryName.String,
+		},
+		HighlightedTitle: row.HighlightedTitle,
+		Snippets:         row.Snippets,
+	}
+}
+
+func convertTaskQueue(queue *asynq.QueueInfo) *pb.TaskQueue {
+	return &pb.TaskQueue{
+		Name:           queue.Queue,
+		Paused:         queue.Paused,
+		Size:           int32(queue.Size),
+		Pending:        int32(queue.Pending),
+		Active:         int32(queue.Active),
+		Scheduled:      int32(queue.Scheduled),
+		Retry:          int32(queue.Retry),
+		Archived:       int32(queue.Archived),
+		ProcessedToday: int32(queue.Processed),
+		FailedToday:    int32(queue.Failed),
+		LatencyMs:      queue.Latency.Milliseconds(),
+	}
+}
+
+// convertQueueTask 载荷过大时只返回开头部分
+func convertQueueTask(task *asynq.TaskInfo) *pb.QueueTask {
+	pbTask := &pb.QueueTask{
+		Id:        task.ID,
+		Queue:     task.Queue,
+		Type:      task.Type,
+		State:     task.State.String(),
+		MaxRetry:  int32(task.MaxRetry),
+		Retried:   int32(task.Retried),
+		LastError: task.LastErr,
+	}
+
+	if utf8.Valid(task.Payload) {
+		payload, truncated := truncateUTF8(task.Payload, maxInspectValueBytes)
+		pbTask.Payload = string(payload)
+		pbTask.PayloadTruncated = truncated
+	} else {
+		// 二进制载荷无法放进 string 字段，编码后长度不超过上限
+		payload := task.Payload
+		if limit := base64.StdEncoding.DecodedLen(maxInspectValueBytes); len(payload) > limit {
+			payload = payload[:limit]
+			pbTask.PayloadTruncated = true
+		}
+		pbTask.Payload = base64.StdEncoding.EncodeToString(payload)
+		pbTask.PayloadEncoding = queueTaskPayloadEncodingBase64
+	}
+
+	if !task.LastFailedAt.IsZero() {
+		pbTask.LastFailedAt = timestamppb.New(task.LastFailedAt)
+	}
+	if !task.NextProcessAt.IsZero() {
+		pbTask.NextProcessAt = timestamppb.New(task.NextProcessAt)
+	}
+	return pbTask
+}
@@ -1,10 +1,15 @@
 package gapi
 
 import (
+	"encoding/base64"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,3 +52,70 @@ func TestConvertArticleWithCategoryIncludesCover(t *testing.T) {
 
 	require.Equal(t, "/resources/articles/preview-cover.webp", article.GetCover())
 }
+
+func TestConvertQueueTaskPayload(t *testing.T) {
+	testCases := []struct {
+		name          string
+		payload       []byte
+		checkResponse func(t *testing.T, task *pb.QueueTask)
+	}{
+		{
+			name:    "Text",
+			payload: []byte(`{"username":"alice"}`),
+			checkResponse: func(t *testing.T, task *pb.QueueTask) {
+				require.Equal(t, `{"username":"alice"}`, task.GetPayload())
+				require.Empty(t, task.GetPayloadEncoding())
+				require.False(t, task.GetPayloadTruncated())
+			},
+		},
+		{
+			name: "TruncatedMultiByte",
+			// 截断点落在汉字中间
+			payload: []byte("ab" + strings.Repeat("中", maxInspectValueBytes/3)),
+			checkResponse: func(t *testing.T, task *pb.QueueTask) {
+				require.True(t, task.GetPayloadTruncated())
+				require.Empty(t, task.GetPayloadEncoding())
+				require.True(t, utf8.ValidString(task.GetPayload()))
+				require.Len(t, task.GetPayload(), maxInspectValueBytes-2)
+				require.True(t, strings.HasSuffix(task.GetPayload(), "中"))
+			},
+		},
+		{
+			name:    "Binary",
+			payload: []byte{0xff, 0xfe, 0x00, 0x01},
+			checkResponse: func(t *testing.T, task *pb.QueueTask) {
+				require.Equal(t, queueTaskPayloadEncodingBase64, task.GetPayloadEncoding())
+				require.Equal(t, base64.StdEncoding.EncodeToString([]byte{0xff, 0xfe, 0x00, 0x01}), task.GetPayload())
+				require.False(t, task.GetPayloadTruncated())
+			},
+		},
+		{
+			name:    "TruncatedBinary",
+			payload: append([]byte{0xff}, make([]byte, maxInspectValueBytes)...),
+			checkResponse: func(t *testing.T, task *pb.QueueTask) {
+				require.Equal(t, queueTaskPayloadEncodingBase64, task.GetPayloadEncoding())
+				require.True(t, task.GetPayloadTruncated())
+				require.LessOrEqual(t, len(task.GetPayload()), maxInspectValueBytes)
+
+				payload, err := base64.StdEncoding.DecodeString(task.GetPayload())
+				require.NoError(t, err)
+				require.Equal(t, byte(0xff), payload[0])
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			task := convertQueueTask(&asynq.TaskInfo{
+				ID:      "task-1",
+				Queue:   "default",
+				Type:    "task:test",
+				State:   asynq.TaskStateArchived,
+				Payload: tc.payload,
+			})
+			tc.checkResponse(t, task)
+		})
+	}
+}
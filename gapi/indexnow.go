@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// distributeIndexNow 文章公开地址发生变化后通知搜索引擎，未配置 IndexNow 时跳过，推送失败不影响文章操作
+func (server *Server) distributeIndexNow(ctx context.Context, paths ...string) {
+	if server.taskDistributor == nil || !indexnow.ValidKey(server.config.IndexNowKey) {
+		return
+	}
+	origin := util.NormalizeOrigin(server.config.Domain)
+	if origin == "" {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(paths))
+	urls := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, ok := seen[path]; ok || path == "" {
+			continue
+		}
+		seen[path] = struct{}{}
+		urls = append(urls, origin+path)
+	}
+	if len(urls) == 0 {
+		return
+	}
+
+	// 短时间内重复保存同一篇文章时，队列中已有的任务会负责推送
+	err := server.taskDistributor.DistributeTaskSubmitIndexNowDefault(ctx, urls...)
+	if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+		log.Error().Err(err).Strs("urls", urls).Msg("failed to distribute indexnow task")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
 	"github.com/MonitorAllen/nostalgia/internal/cache"
 	"github.com/MonitorAllen/nostalgia/token"
 	"github.com/MonitorAllen/nostalgia/util"
@@ -29,6 +30,24 @@ func (store *testStore) CreateUserWithRole(ctx context.Context, arg db.CreateUse
 	return db.User{}, nil
 }
 
+// SaveAIConfigTx 在 mock 上依次执行事务内的查询，测试可以分别断言配置与版本的写入
+func (store *testStore) SaveAIConfigTx(ctx context.Context, arg db.SaveAIConfigTxParams) (db.SaveAIConfigTxResult, error) {
+	var result db.SaveAIConfigTxResult
+
+	config, err := store.UpsertAIProviderConfig(ctx, arg.UpsertAIProviderConfigParams)
+	if err != nil {
+		return result, err
+	}
+	if arg.AfterSave != nil {
+		if err := arg.AfterSave(store, config); err != nil {
+			return result, err
+		}
+	}
+
+	result.Config = config
+	return result, nil
+}
+
 func newGAPITestStore(store *mockdb.MockStore) db.Store {
 	return &testStore{MockStore: store}
 }
@@ -39,7 +58,7 @@ func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDist
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store, taskDistributor, cache)
+	server, err := NewServer(config, store, taskDistributor, nil, cache, ai.NewConfigResolver(config, store))
 	require.NoError(t, err)
 
 	return server
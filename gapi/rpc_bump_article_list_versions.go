@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBumpCategoryIDs = 100
+
+func (server *Server) BumpArticleListVersions(ctx context.Context, req *pb.BumpArticleListVersionsRequest) (*pb.BumpArticleListVersionsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateBumpArticleListVersionsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if server.cache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "cache is not configured")
+	}
+
+	err = cachepkg.NewArticleCache(server.cache).BumpListVersion(ctx, req.GetCategoryIds()...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to bump article list versions: %v", err)
+	}
+
+	return &pb.BumpArticleListVersionsResponse{}, nil
+}
+
+func validateBumpArticleListVersionsRequest(req *pb.BumpArticleListVersionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if len(req.GetCategoryIds()) > maxBumpCategoryIDs {
+		violations = append(violations, fieldViolation("category_ids", errors.New("at most 100 category ids are allowed")))
+	}
+	for i, categoryID := range req.GetCategoryIds() {
+		if categoryID <= 0 {
+			violations = append(violations, fieldViolation(fmt.Sprintf("category_ids[%d]", i), errors.New("category id must be positive")))
+		}
+	}
+	return violations
+}
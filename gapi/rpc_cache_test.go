@@ -0,0 +1,268 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInspectCacheKey(t *testing.T) {
+	cacheKey := key.CategoryAllKey
+
+	testCases := []struct {
+		name          string
+		key           string
+		buildStubs    func(redisCache *mockcache.MockCache)
+		checkResponse func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error)
+	}{
+		{
+			name: "OK",
+			key:  cacheKey,
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().TTL(gomock.Any(), gomock.Eq(cacheKey)).Times(1).Return(90*time.Second, true, nil)
+				redisCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest any) (bool, error) {
+						return true, json.Unmarshal([]byte(`[{"id":1}]`), dest)
+					})
+			},
+			checkResponse: func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, resp.GetExists())
+				require.Equal(t, int64(90), resp.GetTtlSeconds())
+				require.Equal(t, `[{"id":1}]`, resp.GetValue())
+				require.False(t, resp.GetValueTruncated())
+			},
+		},
+		{
+			name: "Truncated",
+			key:  cacheKey,
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().TTL(gomock.Any(), gomock.Eq(cacheKey)).Times(1).Return(time.Duration(-1), true, nil)
+				redisCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest any) (bool, error) {
+						value, err := json.Marshal(strings.Repeat("a", maxInspectValueBytes))
+						require.NoError(t, err)
+						return true, json.Unmarshal(value, dest)
+					})
+			},
+			checkResponse: func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), resp.GetTtlSeconds())
+				require.Len(t, resp.GetValue(), maxInspectValueBytes)
+				require.True(t, resp.GetValueTruncated())
+			},
+		},
+		{
+			name: "TruncatedMultiByte",
+			key:  cacheKey,
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().TTL(gomock.Any(), gomock.Eq(cacheKey)).Times(1).Return(time.Duration(-1), true, nil)
+				redisCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest any) (bool, error) {
+						// `"a` 之后每个汉字 3 字节，截断点落在汉字中间
+						value, err := json.Marshal("a" + strings.Repeat("中", maxInspectValueBytes/3))
+						require.NoError(t, err)
+						require.NotZero(t, (maxInspectValueBytes-2)%3)
+						return true, json.Unmarshal(value, dest)
+					})
+			},
+			checkResponse: func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, resp.GetValueTruncated())
+				require.True(t, utf8.ValidString(resp.GetValue()))
+				require.Less(t, len(resp.GetValue()), maxInspectValueBytes)
+				require.Greater(t, len(resp.GetValue()), maxInspectValueBytes-utf8.UTFMax)
+				require.True(t, strings.HasSuffix(resp.GetValue(), "中"))
+			},
+		},
+		{
+			name: "NotFound",
+			key:  cacheKey,
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().TTL(gomock.Any(), gomock.Eq(cacheKey)).Times(1).Return(time.Duration(0), false, nil)
+				redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, resp.GetExists())
+				require.Empty(t, resp.GetValue())
+			},
+		},
+		{
+			name: "EmptyKey",
+			key:  "",
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().TTL(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InspectCacheKeyResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			redisCache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(redisCache)
+
+			server := newTestServer(t, newGAPITestStore(mockdb.NewMockStore(ctrl)), nil, redisCache)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.InspectCacheKey(ctx, &pb.InspectCacheKeyRequest{Key: tc.key})
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestPurgeCache(t *testing.T) {
+	articleID := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	categoryID := int64(7)
+	article := db.GetArticleRow{
+		ID:         articleID,
+		CategoryID: categoryID,
+		Slug:       pgtype.Text{String: "purged-slug", Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.PurgeCacheRequest
+		buildStubs    func(store *mockdb.MockStore, redisCache *mockcache.MockCache)
+		checkResponse func(t *testing.T, resp *pb.PurgeCacheResponse, err error)
+	}{
+		{
+			name: "Article",
+			req:  &pb.PurgeCacheRequest{Target: &pb.PurgeCacheRequest_ArticleId{ArticleId: articleID.String()}},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(article, nil)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq(key.GetArticleIDKey(articleID)), gomock.Eq(key.VersionKeyPatterns)).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq(key.GetArticleCommentKey(articleID)+"*"), gomock.Eq(key.VersionKeyPatterns)).Times(1).Return(int64(2), nil)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq(key.GetArticleRelatedPattern(articleID)), gomock.Eq(key.VersionKeyPatterns)).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq(key.GetArticleSlugKey("purged-slug")), gomock.Eq(key.VersionKeyPatterns)).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(0))).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(categoryID))).Times(1).Return(int64(1), nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(5), resp.GetDeletedKeys())
+			},
+		},
+		{
+			name: "Category",
+			req:  &pb.PurgeCacheRequest{Target: &pb.PurgeCacheRequest_CategoryId{CategoryId: categoryID}},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(0))).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(categoryID))).Times(1).Return(int64(1), nil)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq(key.CategoryAllKey)).Times(1).Return(int64(1), nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), resp.GetDeletedKeys())
+			},
+		},
+		{
+			name: "Prefix",
+			req:  &pb.PurgeCacheRequest{Target: &pb.PurgeCacheRequest_Prefix{Prefix: "cache:search:"}},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Eq("cache:search:*"), gomock.Eq(key.VersionKeyPatterns)).Times(1).Return(int64(12), nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(12), resp.GetDeletedKeys())
+			},
+		},
+		{
+			name: "ProtectedPrefix",
+			req:  &pb.PurgeCacheRequest{Target: &pb.PurgeCacheRequest_Prefix{Prefix: "counter:"}},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "MissingTarget",
+			req:  &pb.PurgeCacheRequest{},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "ArticleNotFound",
+			req:  &pb.PurgeCacheRequest{Target: &pb.PurgeCacheRequest_ArticleId{ArticleId: articleID.String()}},
+			buildStubs: func(store *mockdb.MockStore, redisCache *mockcache.MockCache) {
+				store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(db.GetArticleRow{}, db.ErrRecordNotFound)
+				redisCache.EXPECT().DelByPattern(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PurgeCacheResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			redisCache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, redisCache)
+
+			server := newTestServer(t, newGAPITestStore(store), nil, redisCache)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.PurgeCache(ctx, tc.req)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestBumpArticleListVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	redisCache := mockcache.NewMockCache(ctrl)
+	redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(0))).Times(1).Return(int64(3), nil)
+	redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(4))).Times(1).Return(int64(2), nil)
+
+	server := newTestServer(t, newGAPITestStore(mockdb.NewMockStore(ctrl)), nil, redisCache)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.BumpArticleListVersions(ctx, &pb.BumpArticleListVersionsRequest{CategoryIds: []int64{4}})
+	require.NoError(t, err)
+
+	_, err = server.BumpArticleListVersions(ctx, &pb.BumpArticleListVersionsRequest{CategoryIds: []int64{0}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
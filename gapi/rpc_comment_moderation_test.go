@@ -0,0 +1,109 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListHeldComments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().
+		ListHeldComments(gomock.Any(), db.ListHeldCommentsParams{Limit: 10, Offset: 10}).
+		Return([]db.ListHeldCommentsRow{{
+			ID:               3,
+			Content:          "加我 https://spam.example.com",
+			ArticleID:        uuid.New(),
+			Status:           moderation.StatusHeld,
+			ModerationReason: "heuristic: 1 links exceeds limit 0",
+			ArticleTitle:     pgtype.Text{String: "Redis 缓存", Valid: true},
+			FromUserName:     pgtype.Text{String: "visitor", Valid: true},
+		}}, nil)
+	store.EXPECT().CountHeldComments(gomock.Any()).Return(int64(11), nil)
+
+	resp, err := server.ListHeldComments(ctx, &pb.ListHeldCommentsRequest{Page: 2, Limit: 10})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(11), resp.GetCount())
+	require.Len(t, resp.GetComments(), 1)
+	require.Equal(t, "Redis 缓存", resp.GetComments()[0].GetArticleTitle())
+	require.Equal(t, "visitor", resp.GetComments()[0].GetFromUserName())
+	require.Equal(t, moderation.StatusHeld, resp.GetComments()[0].GetStatus())
+}
+
+func TestReviewCommentApproves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	held := db.Comment{ID: 5, Status: moderation.StatusHeld, ModerationReason: "ai: spam: 广告"}
+	store.EXPECT().GetComment(gomock.Any(), int64(5)).Return(held, nil)
+	store.EXPECT().
+		UpdateCommentModeration(gomock.Any(), db.UpdateCommentModerationParams{
+			ID:               5,
+			Status:           moderation.StatusPublished,
+			ModerationReason: held.ModerationReason,
+		}).
+		Return(db.Comment{ID: 5, Status: moderation.StatusPublished, ModerationReason: held.ModerationReason}, nil)
+
+	resp, err := server.ReviewComment(ctx, &pb.ReviewCommentRequest{Id: 5, Action: " Approve "})
+
+	require.NoError(t, err)
+	require.Equal(t, moderation.StatusPublished, resp.GetComment().GetStatus())
+	require.Equal(t, held.ModerationReason, resp.GetComment().GetModerationReason())
+}
+
+func TestReviewCommentRejectsInvalidAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().GetComment(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := server.ReviewComment(ctx, &pb.ReviewCommentRequest{Id: 5, Action: "delete"})
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestReviewCommentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().GetComment(gomock.Any(), int64(6)).Return(db.Comment{}, db.ErrRecordNotFound)
+	store.EXPECT().UpdateCommentModeration(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := server.ReviewComment(ctx, &pb.ReviewCommentRequest{Id: 6, Action: "reject"})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
 	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -49,6 +52,15 @@ func (server *Server) CreateArticle(ctx context.Context, req *pb.CreateArticleRe
 		return nil, status.Errorf(codes.Internal, "failed to create article: %v", err)
 	}
 
+	// 直接发布的文章需要让列表、相关文章与站点地图缓存失效，并通知搜索引擎
+	if article.IsPublish {
+		if err := cachepkg.NewArticleCache(server.cache).BumpListVersion(ctx, article.CategoryID); err != nil {
+			log.Error().Err(err).Str("article_id", article.ID.String()).Msg("failed to bump article list version")
+		}
+		server.distributeIndexNow(ctx, util.ArticlePath(article.ID, article.Slug))
+		server.distributeWarmCache(ctx)
+	}
+
 	resp := &pb.CreateArticleResponse{
 		Article: convertOnlyArticle(article, false),
 	}
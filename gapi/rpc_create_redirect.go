@@ -0,0 +1,96 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRedirectSourceLength = 255
+	maxRedirectTargetLength = 2048
+)
+
+func (server *Server) CreateRedirect(ctx context.Context, req *pb.CreateRedirectRequest) (*pb.CreateRedirectResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateRedirectRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	statusCode := req.GetStatusCode()
+	if statusCode == 0 {
+		statusCode = http.StatusMovedPermanently
+	}
+
+	redirect, err := server.store.CreateRedirect(ctx, db.CreateRedirectParams{
+		SourcePath: util.NormalizeSitePath(req.GetSourcePath()),
+		TargetPath: strings.TrimSpace(req.GetTargetPath()),
+		StatusCode: statusCode,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, "redirect for this path already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create redirect: %v", err)
+	}
+
+	return &pb.CreateRedirectResponse{Redirect: convertRedirect(redirect)}, nil
+}
+
+func validateCreateRedirectRequest(req *pb.CreateRedirectRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	source := util.NormalizeSitePath(req.GetSourcePath())
+	if source == "" || len(source) > maxRedirectSourceLength {
+		violations = append(violations, fieldViolation("source_path", errors.New("must be a site path starting with / and at most 255 characters")))
+	}
+	if err := validateRedirectTarget(req.GetTargetPath()); err != nil {
+		violations = append(violations, fieldViolation("target_path", err))
+	} else if source != "" && util.NormalizeSitePath(req.GetTargetPath()) == source {
+		violations = append(violations, fieldViolation("target_path", errors.New("must differ from source_path")))
+	}
+	if req.GetStatusCode() != 0 {
+		if err := validateRedirectStatusCode(req.GetStatusCode()); err != nil {
+			violations = append(violations, fieldViolation("status_code", err))
+		}
+	}
+	return violations
+}
+
+// validateRedirectTarget 目标可以是站内路径，也可以是完整的 http(s) 地址
+func validateRedirectTarget(value string) error {
+	target := strings.TrimSpace(value)
+	if target == "" || len(target) > maxRedirectTargetLength {
+		return errors.New("must be non-empty and at most 2048 characters")
+	}
+	if util.NormalizeSitePath(target) != "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("must be a site path or an absolute http(s) URL")
+	}
+	return nil
+}
+
+func validateRedirectStatusCode(code int32) error {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return nil
+	default:
+		return errors.New("must be one of 301, 302, 307, 308")
+	}
+}
@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteArchivedTask(ctx context.Context, req *pb.DeleteArchivedTaskRequest) (*pb.DeleteArchivedTaskResponse, error) {
+	payload, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateQueueTaskTarget(req.GetQueue(), req.GetTaskId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if err := server.checkTaskInspector(); err != nil {
+		return nil, err
+	}
+
+	task, err := server.taskInspector.GetTask(req.GetQueue(), req.GetTaskId())
+	if err != nil {
+		return nil, taskInspectorError("get task", err)
+	}
+	if task.State != asynq.TaskStateArchived {
+		return nil, status.Errorf(codes.FailedPrecondition, "task is %s, only archived tasks can be deleted", task.State)
+	}
+
+	if err := server.taskInspector.DeleteTask(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, taskInspectorError("delete task", err)
+	}
+
+	log.Info().Str("queue", task.Queue).Str("task_id", task.ID).Str("type", task.Type).
+		Str("operator", payload.Username).Msg("deleted archived task")
+
+	return &pb.DeleteArchivedTaskResponse{}, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -67,5 +68,10 @@ func (server *Server) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRe
 		return nil, status.Errorf(codes.Internal, "cannot delete article: %v", err)
 	}
 
+	if article.IsPublish {
+		server.distributeIndexNow(ctx, util.ArticlePath(article.ID, article.Slug))
+		server.distributeWarmCache(ctx)
+	}
+
 	return &pb.DeleteArticleResponse{}, nil
 }
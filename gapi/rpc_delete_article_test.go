@@ -61,3 +61,33 @@ func TestDeleteArticleInvalidatesDetailAndListCaches(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 }
+
+func TestDeleteArticleSubmitsIndexNowForPublishedArticle(t *testing.T) {
+	articleID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	article := db.GetArticleRow{
+		ID:         articleID,
+		IsPublish:  true,
+		CategoryID: 7,
+		Slug:       pgtype.Text{String: "published-slug", Valid: true},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+
+	store.EXPECT().GetArticle(gomock.Any(), gomock.Eq(articleID)).Times(1).Return(article, nil)
+	store.EXPECT().DeleteArticleTx(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	taskDistributor.EXPECT().
+		DistributeTaskSubmitIndexNowDefault(gomock.Any(), gomock.Eq("https://blog.example.com/article/published-slug")).
+		Times(1).
+		Return(nil)
+
+	server := newTestServer(t, newGAPITestStore(store), taskDistributor, nil)
+	server.config.Domain = "https://blog.example.com"
+	server.config.IndexNowKey = "a1b2c3d4e5f6"
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+	_, err := server.DeleteArticle(ctx, &pb.DeleteArticleRequest{Id: articleID.String()})
+	require.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteRedirect(ctx context.Context, req *pb.DeleteRedirectRequest) (*pb.DeleteRedirectResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	_, err = server.store.DeleteRedirect(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "redirect not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete redirect: %v", err)
+	}
+
+	return &pb.DeleteRedirectResponse{}, nil
+}
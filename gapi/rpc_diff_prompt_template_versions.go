@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DiffPromptTemplateVersions(ctx context.Context, req *pb.DiffPromptTemplateVersionsRequest) (*pb.DiffPromptTemplateVersionsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetFromVersion() < 1 || req.GetToVersion() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid prompt template version")
+	}
+
+	from, err := server.promptTemplatesAtVersion(ctx, purpose, req.GetFromVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	var to map[string]string
+	if req.GetToVersion() == 0 {
+		current, err := server.aiConfigs.Resolve(ctx, purpose)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to load AI config")
+		}
+		to = ai.NormalizePromptTemplates(current.PromptTemplates)
+	} else {
+		to, err = server.promptTemplatesAtVersion(ctx, purpose, req.GetToVersion())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pb.DiffPromptTemplateVersionsResponse{Diffs: []*pb.PromptTemplateDiff{}}
+	for _, key := range ai.PromptTemplateKeys() {
+		if from[key] == to[key] {
+			continue
+		}
+		diff := &pb.PromptTemplateDiff{Key: key}
+		for _, line := range util.DiffLines(from[key], to[key]) {
+			diff.Lines = append(diff.Lines, &pb.PromptTemplateDiffLine{Op: line.Op, Text: line.Text})
+		}
+		resp.Diffs = append(resp.Diffs, diff)
+	}
+	return resp, nil
+}
+
+func (server *Server) promptTemplatesAtVersion(ctx context.Context, purpose string, version int32) (map[string]string, error) {
+	row, err := server.store.GetAIPromptTemplateVersion(ctx, db.GetAIPromptTemplateVersionParams{
+		Purpose: ai.ConfigStorageKey(purpose),
+		Version: version,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "prompt template version not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load prompt template version")
+	}
+	return ai.DecodePromptTemplates(row.PromptTemplates), nil
+}
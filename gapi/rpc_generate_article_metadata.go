@@ -0,0 +1,229 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxSEODescriptionRunes = 160
+	maxTagRunes            = 32
+	maxCoverAltRunes       = 125
+)
+
+// articleMetadataFields 可生成的元数据字段，顺序即生成顺序
+var articleMetadataFields = []string{
+	ai.TargetSlug,
+	ai.TargetSEODescription,
+	ai.TargetTags,
+	ai.TargetCoverAlt,
+	ai.TargetCategory,
+}
+
+var articleMetadataModes = map[string]string{
+	ai.TargetSlug:           ai.ModeSlugCandidates,
+	ai.TargetSEODescription: ai.ModeSEODescriptionCandidates,
+	ai.TargetTags:           ai.ModeTagCandidates,
+	ai.TargetCoverAlt:       ai.ModeCoverAltCandidates,
+	ai.TargetCategory:       ai.ModeCategoryCandidates,
+}
+
+func (server *Server) GenerateArticleMetadata(ctx context.Context, req *pb.GenerateArticleMetadataRequest) (*pb.GenerateArticleMetadataResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	fields, violations := validateGenerateArticleMetadataRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	articleID, err := uuid.Parse(req.GetArticleId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	article, err := server.store.GetArticle(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "article not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to fetch article")
+	}
+
+	polisher, _, err := server.aiTextPolisher(ctx, ai.PurposeMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []db.Category
+	if slices.Contains(fields, ai.TargetCategory) {
+		categories, err = server.store.ListAllCategories(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to list categories")
+		}
+	}
+	categoryNames := make([]string, 0, len(categories))
+	for _, category := range categories {
+		categoryNames = append(categoryNames, category.Name)
+	}
+
+	rsp := &pb.GenerateArticleMetadataResponse{}
+	for _, field := range fields {
+		if field == ai.TargetCategory && len(categoryNames) == 0 {
+			continue
+		}
+
+		result, err := polisher.Polish(ctx, ai.PolishRequest{
+			Mode:           articleMetadataModes[field],
+			Target:         field,
+			ArticleID:      article.ID.String(),
+			ArticleTitle:   article.Title,
+			ArticleSummary: article.Summary,
+			ArticleExcerpt: util.PlainText(article.Content),
+			Locale:         req.GetLocale(),
+			Categories:     categoryNames,
+		})
+		if err != nil {
+			return nil, mapAIPolishError(err)
+		}
+		if result.Model != "" {
+			rsp.Model = result.Model
+		}
+
+		switch field {
+		case ai.TargetSlug:
+			rsp.Slugs, err = server.validSlugCandidates(ctx, article.ID, result.Suggestions)
+			if err != nil {
+				return nil, err
+			}
+		case ai.TargetSEODescription:
+			rsp.SeoDescriptions = plainTextCandidates(result.Suggestions, maxSEODescriptionRunes)
+		case ai.TargetTags:
+			rsp.Tags = tagCandidates(result.Suggestions)
+		case ai.TargetCoverAlt:
+			rsp.CoverAlts = plainTextCandidates(result.Suggestions, maxCoverAltRunes)
+		case ai.TargetCategory:
+			rsp.Categories = categoryCandidates(result.Suggestions, categories)
+		}
+	}
+
+	return rsp, nil
+}
+
+func validateGenerateArticleMetadataRequest(req *pb.GenerateArticleMetadataRequest) (fields []string, violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetArticleId() == "" {
+		violations = append(violations, fieldViolation("article_id", fmt.Errorf("article_id is required")))
+	}
+
+	if len(req.GetFields()) == 0 {
+		return articleMetadataFields, violations
+	}
+	for _, field := range req.GetFields() {
+		field = strings.TrimSpace(field)
+		if _, ok := articleMetadataModes[field]; !ok {
+			violations = append(violations, fieldViolation("fields", fmt.Errorf("unsupported field: %s", field)))
+			continue
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, violations
+}
+
+// validSlugCandidates 仅保留格式合法且未被其他文章占用的短标识
+func (server *Server) validSlugCandidates(ctx context.Context, articleID uuid.UUID, suggestions []ai.Suggestion) ([]*pb.PolishSuggestion, error) {
+	candidates := make([]*pb.PolishSuggestion, 0, len(suggestions))
+	seen := make(map[string]bool, len(suggestions))
+	for _, suggestion := range suggestions {
+		slug := normalizeSlugCandidate(suggestion.Content)
+		if seen[slug] || !util.IsValidSlug(slug) {
+			continue
+		}
+		seen[slug] = true
+
+		existing, err := server.store.GetArticleBySlug(ctx, pgtype.Text{String: slug, Valid: true})
+		if err == nil && existing.ID != articleID {
+			continue
+		}
+		if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to check slug")
+		}
+
+		candidates = append(candidates, &pb.PolishSuggestion{Content: slug, Reason: suggestion.Reason})
+	}
+	return candidates, nil
+}
+
+func normalizeSlugCandidate(value string) string {
+	value = strings.ToLower(util.PlainText(value))
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), "-")
+}
+
+func plainTextCandidates(suggestions []ai.Suggestion, maxRunes int) []*pb.PolishSuggestion {
+	candidates := make([]*pb.PolishSuggestion, 0, len(suggestions))
+	seen := make(map[string]bool, len(suggestions))
+	for _, suggestion := range suggestions {
+		content := util.TruncateRunes(util.PlainText(suggestion.Content), maxRunes)
+		if content == "" || seen[content] {
+			continue
+		}
+		seen[content] = true
+		candidates = append(candidates, &pb.PolishSuggestion{Content: content, Reason: suggestion.Reason})
+	}
+	return candidates
+}
+
+func tagCandidates(suggestions []ai.Suggestion) []*pb.PolishSuggestion {
+	candidates := make([]*pb.PolishSuggestion, 0, len(suggestions))
+	seen := make(map[string]bool, len(suggestions))
+	for _, suggestion := range suggestions {
+		tag := strings.TrimSpace(strings.TrimLeft(util.PlainText(suggestion.Content), "#"))
+		key := strings.ToLower(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagRunes || seen[key] {
+			continue
+		}
+		seen[key] = true
+		candidates = append(candidates, &pb.PolishSuggestion{Content: tag, Reason: suggestion.Reason})
+	}
+	return candidates
+}
+
+// categoryCandidates 只接受与已有分类名称一致的候选
+func categoryCandidates(suggestions []ai.Suggestion, categories []db.Category) []*pb.ArticleCategoryCandidate {
+	candidates := make([]*pb.ArticleCategoryCandidate, 0, len(suggestions))
+	seen := make(map[int64]bool, len(suggestions))
+	for _, suggestion := range suggestions {
+		name := util.PlainText(suggestion.Content)
+		for _, category := range categories {
+			if !strings.EqualFold(category.Name, name) || seen[category.ID] {
+				continue
+			}
+			seen[category.ID] = true
+			candidates = append(candidates, &pb.ArticleCategoryCandidate{
+				CategoryId: category.ID,
+				Name:       category.Name,
+				Reason:     suggestion.Reason,
+			})
+		}
+	}
+	return candidates
+}
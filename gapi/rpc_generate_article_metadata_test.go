@@ -0,0 +1,151 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeModePolisher struct {
+	responses map[string]ai.PolishResponse
+	requests  []ai.PolishRequest
+}
+
+func (polisher *fakeModePolisher) Polish(ctx context.Context, req ai.PolishRequest) (ai.PolishResponse, error) {
+	polisher.requests = append(polisher.requests, req)
+	return polisher.responses[req.Mode], nil
+}
+
+func polishResponseWith(contents ...string) ai.PolishResponse {
+	result := ai.PolishResponse{Model: "writer-model"}
+	for _, content := range contents {
+		result.Suggestions = append(result.Suggestions, ai.Suggestion{Content: content, Reason: "理由"})
+	}
+	return result
+}
+
+func TestGenerateArticleMetadataValidatesCandidates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	articleID := util.RandUserID()
+	article := db.GetArticleRow{
+		ID:      articleID,
+		Title:   "Redis 缓存一致性",
+		Summary: "摘要",
+		Content: "<h2>背景</h2><p>先删缓存再更新数据库</p>",
+	}
+	categories := []db.Category{{ID: 1, Name: "后端"}, {ID: 2, Name: "数据库"}}
+
+	store.EXPECT().GetArticle(gomock.Any(), articleID).Times(1).Return(article, nil)
+	store.EXPECT().ListAllCategories(gomock.Any()).Times(1).Return(categories, nil)
+	store.EXPECT().GetArticleBySlug(gomock.Any(), pgtype.Text{String: "redis-cache-consistency", Valid: true}).
+		Times(1).Return(db.GetArticleBySlugRow{}, db.ErrRecordNotFound)
+	store.EXPECT().GetArticleBySlug(gomock.Any(), pgtype.Text{String: "redis-cache", Valid: true}).
+		Times(1).Return(db.GetArticleBySlugRow{ID: uuid.New()}, nil)
+
+	polisher := &fakeModePolisher{responses: map[string]ai.PolishResponse{
+		ai.ModeSlugCandidates:           polishResponseWith("Redis Cache Consistency", "redis-cache", "ab", "坏的slug"),
+		ai.ModeSEODescriptionCandidates: polishResponseWith("<p>介绍 Redis 缓存一致性方案</p>"),
+		ai.ModeTagCandidates:            polishResponseWith("#Redis", "redis", "缓存"),
+		ai.ModeCoverAltCandidates:       polishResponseWith("Redis 与数据库同步示意图"),
+		ai.ModeCategoryCandidates:       polishResponseWith("数据库", "不存在的分类"),
+	}}
+
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = polisher
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.GenerateArticleMetadata(ctx, &pb.GenerateArticleMetadataRequest{ArticleId: articleID.String()})
+
+	require.NoError(t, err)
+	require.Len(t, polisher.requests, 5)
+	require.Equal(t, "背景 先删缓存再更新数据库", polisher.requests[0].ArticleExcerpt)
+	require.Equal(t, []string{"后端", "数据库"}, polisher.requests[4].Categories)
+
+	require.Len(t, resp.GetSlugs(), 1)
+	require.Equal(t, "redis-cache-consistency", resp.GetSlugs()[0].GetContent())
+	require.Len(t, resp.GetSeoDescriptions(), 1)
+	require.Equal(t, "介绍 Redis 缓存一致性方案", resp.GetSeoDescriptions()[0].GetContent())
+	require.Len(t, resp.GetTags(), 2)
+	require.Equal(t, "Redis", resp.GetTags()[0].GetContent())
+	require.Equal(t, "缓存", resp.GetTags()[1].GetContent())
+	require.Len(t, resp.GetCoverAlts(), 1)
+	require.Len(t, resp.GetCategories(), 1)
+	require.Equal(t, int64(2), resp.GetCategories()[0].GetCategoryId())
+	require.Equal(t, "writer-model", resp.GetModel())
+}
+
+func TestGenerateArticleMetadataTruncatesSEODescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	articleID := util.RandUserID()
+	store.EXPECT().GetArticle(gomock.Any(), articleID).Times(1).Return(db.GetArticleRow{ID: articleID, Title: "标题"}, nil)
+	store.EXPECT().ListAllCategories(gomock.Any()).Times(0)
+
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = &fakeModePolisher{responses: map[string]ai.PolishResponse{
+		ai.ModeSEODescriptionCandidates: polishResponseWith(strings.Repeat("长", 200)),
+	}}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.GenerateArticleMetadata(ctx, &pb.GenerateArticleMetadataRequest{
+		ArticleId: articleID.String(),
+		Fields:    []string{ai.TargetSEODescription},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetSeoDescriptions(), 1)
+	require.Len(t, []rune(resp.GetSeoDescriptions()[0].GetContent()), maxSEODescriptionRunes)
+	require.Empty(t, resp.GetSlugs())
+}
+
+func TestGenerateArticleMetadataRejectsUnknownField(t *testing.T) {
+	server := newTestServer(t, nil, nil, nil)
+	server.textPolisher = &fakeModePolisher{}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.GenerateArticleMetadata(ctx, &pb.GenerateArticleMetadataRequest{
+		ArticleId: util.RandUserID().String(),
+		Fields:    []string{"title"},
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGenerateArticleMetadataArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	articleID := util.RandUserID()
+	store.EXPECT().GetArticle(gomock.Any(), articleID).Times(1).Return(db.GetArticleRow{}, db.ErrRecordNotFound)
+
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = &fakeModePolisher{}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.GenerateArticleMetadata(ctx, &pb.GenerateArticleMetadataRequest{ArticleId: articleID.String()})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
@@ -8,16 +8,21 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (server *Server) GetAIConfig(ctx context.Context, _ *pb.GetAIConfigRequest) (*pb.GetAIConfigResponse, error) {
+func (server *Server) GetAIConfig(ctx context.Context, req *pb.GetAIConfigRequest) (*pb.GetAIConfigResponse, error) {
 	_, _, err := server.authorizeAdmin(ctx)
 	if err != nil {
 		return nil, unauthenticatedError(err)
 	}
 
-	cfg, err := server.resolveAIPolishConfig(ctx)
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := server.aiConfigs.Resolve(ctx, purpose)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load AI config")
 	}
 
-	return cfg.toResponse(), nil
+	return aiConfigResponse(cfg), nil
 }
@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/pb"
+)
+
+func (server *Server) GetCacheStats(ctx context.Context, req *pb.GetCacheStatsRequest) (*pb.GetCacheStatsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	snapshot := cachepkg.StatsSnapshot()
+	resp := &pb.GetCacheStatsResponse{
+		Stats: make([]*pb.CacheStats, 0, len(snapshot)),
+	}
+	for _, stats := range snapshot {
+		resp.Stats = append(resp.Stats, &pb.CacheStats{
+			Namespace: stats.Namespace,
+			Hits:      stats.Hits,
+			Misses:    stats.Misses,
+			Errors:    stats.Errors,
+			HitRate:   stats.HitRate(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/search"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSearchAnalyticsLimit int32 = 20
+	maxSearchAnalyticsLimit     int32 = 100
+	maxSearchAnalyticsWindow          = 366 * 24 * time.Hour
+)
+
+func (server *Server) GetSearchAnalytics(ctx context.Context, req *pb.GetSearchAnalyticsRequest) (*pb.GetSearchAnalyticsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetSearchAnalyticsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	endTime := time.Now()
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	startTime := endTime.Add(-search.DefaultAnalyticsWindow)
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	if !startTime.Before(endTime) || endTime.Sub(startTime) > maxSearchAnalyticsWindow {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("start_time", errors.New("time window must be positive and at most 366 days")),
+		})
+	}
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultSearchAnalyticsLimit
+	}
+
+	summary, err := server.store.GetSearchAnalyticsSummary(ctx, db.GetSearchAnalyticsSummaryParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get search analytics summary: %v", err)
+	}
+
+	topQueries, err := server.store.ListTopSearchQueries(ctx, db.ListTopSearchQueriesParams{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list top search queries: %v", err)
+	}
+
+	zeroResultQueries, err := server.store.ListZeroResultSearchQueries(ctx, db.ListZeroResultSearchQueriesParams{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list zero-result search queries: %v", err)
+	}
+
+	resp := &pb.GetSearchAnalyticsResponse{
+		StartTime:          timestamppb.New(startTime),
+		EndTime:            timestamppb.New(endTime),
+		Searches:           summary.Searches,
+		ZeroResultSearches: summary.ZeroResultSearches,
+		ClickedSearches:    summary.ClickedSearches,
+		ClickThroughRate:   clickThroughRate(summary.ClickedSearches, summary.Searches),
+		AvgClickPosition:   summary.AvgClickPosition,
+		TopQueries:         make([]*pb.SearchQueryStat, 0, len(topQueries)),
+		ZeroResultQueries:  make([]*pb.ZeroResultQueryStat, 0, len(zeroResultQueries)),
+	}
+	for _, query := range topQueries {
+		resp.TopQueries = append(resp.TopQueries, &pb.SearchQueryStat{
+			Query:            query.Query,
+			Searches:         query.Searches,
+			ClickedSearches:  query.ClickedSearches,
+			ClickThroughRate: clickThroughRate(query.ClickedSearches, query.Searches),
+			AvgResultCount:   query.AvgResultCount,
+		})
+	}
+	for _, query := range zeroResultQueries {
+		resp.ZeroResultQueries = append(resp.ZeroResultQueries, &pb.ZeroResultQueryStat{
+			Query:          query.Query,
+			Searches:       query.Searches,
+			LastSearchedAt: timestamppb.New(query.LastSearchedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func validateGetSearchAnalyticsRequest(req *pb.GetSearchAnalyticsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetLimit() < 0 || req.GetLimit() > maxSearchAnalyticsLimit {
+		violations = append(violations, fieldViolation("limit", errors.New("limit must be between 1 and 100")))
+	}
+	if req.StartTime != nil && !req.GetStartTime().IsValid() {
+		violations = append(violations, fieldViolation("start_time", errors.New("invalid timestamp")))
+	}
+	if req.EndTime != nil && !req.GetEndTime().IsValid() {
+		violations = append(violations, fieldViolation("end_time", errors.New("invalid timestamp")))
+	}
+	return violations
+}
+
+// clickThroughRate 有点击的搜索次数占总搜索次数的比例
+func clickThroughRate(clicked, searches int64) float64 {
+	if searches == 0 {
+		return 0
+	}
+	return float64(clicked) / float64(searches)
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetSearchAnalytics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	startTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC)
+
+	store.EXPECT().
+		GetSearchAnalyticsSummary(gomock.Any(), db.GetSearchAnalyticsSummaryParams{StartTime: startTime, EndTime: endTime}).
+		Return(db.GetSearchAnalyticsSummaryRow{Searches: 40, ZeroResultSearches: 4, ClickedSearches: 10, AvgClickPosition: 2.5}, nil)
+	store.EXPECT().
+		ListTopSearchQueries(gomock.Any(), db.ListTopSearchQueriesParams{StartTime: startTime, EndTime: endTime, MaxResults: 20}).
+		Return([]db.ListTopSearchQueriesRow{{Query: "redis", Searches: 8, ClickedSearches: 2, AvgResultCount: 5}}, nil)
+	store.EXPECT().
+		ListZeroResultSearchQueries(gomock.Any(), db.ListZeroResultSearchQueriesParams{StartTime: startTime, EndTime: endTime, MaxResults: 20}).
+		Return([]db.ListZeroResultSearchQueriesRow{{Query: "kubernetes", Searches: 3, LastSearchedAt: endTime}}, nil)
+
+	resp, err := server.GetSearchAnalytics(ctx, &pb.GetSearchAnalyticsRequest{
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(endTime),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(40), resp.GetSearches())
+	require.InDelta(t, 0.25, resp.GetClickThroughRate(), 1e-9)
+	require.InDelta(t, 2.5, resp.GetAvgClickPosition(), 1e-9)
+	require.Equal(t, "redis", resp.GetTopQueries()[0].GetQuery())
+	require.InDelta(t, 0.25, resp.GetTopQueries()[0].GetClickThroughRate(), 1e-9)
+	require.Equal(t, "kubernetes", resp.GetZeroResultQueries()[0].GetQuery())
+}
+
+func TestGetSearchAnalyticsRejectsInvalidWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().GetSearchAnalyticsSummary(gomock.Any(), gomock.Any()).Times(0)
+
+	now := time.Now()
+	for _, req := range []*pb.GetSearchAnalyticsRequest{
+		{StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(-time.Hour))},
+		{StartTime: timestamppb.New(now.AddDate(-2, 0, 0)), EndTime: timestamppb.New(now)},
+		{Limit: 500},
+	} {
+		_, err := server.GetSearchAnalytics(ctx, req)
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	}
+}
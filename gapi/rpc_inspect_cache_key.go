@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxCacheKeyLength = 512
+	// 大列表的原始值可能有数百 KB，只返回开头部分
+	maxInspectValueBytes = 64 * 1024
+)
+
+func (server *Server) InspectCacheKey(ctx context.Context, req *pb.InspectCacheKeyRequest) (*pb.InspectCacheKeyResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateInspectCacheKeyRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if server.cache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "cache is not configured")
+	}
+
+	inspection, err := cachepkg.Inspect(ctx, server.cache, req.GetKey())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to inspect cache key: %v", err)
+	}
+
+	resp := &pb.InspectCacheKeyResponse{
+		Key:    req.GetKey(),
+		Exists: inspection.Exists,
+	}
+	if !inspection.Exists {
+		return resp, nil
+	}
+
+	resp.TtlSeconds = -1
+	if inspection.TTL >= 0 {
+		resp.TtlSeconds = int64(inspection.TTL.Seconds())
+	}
+	value, truncated := truncateUTF8(inspection.Value, maxInspectValueBytes)
+	resp.Value = string(value)
+	resp.ValueTruncated = truncated
+
+	return resp, nil
+}
+
+// truncateUTF8 截取前 limit 字节，截断点落在多字节字符中间时回退到该字符开头，避免返回非法的 UTF-8
+func truncateUTF8(value []byte, limit int) ([]byte, bool) {
+	if len(value) <= limit {
+		return value, false
+	}
+
+	cut := limit
+	for i := 0; i < utf8.UTFMax-1 && cut > 0 && !utf8.RuneStart(value[cut]); i++ {
+		cut--
+	}
+	return value[:cut], true
+}
+
+func validateInspectCacheKeyRequest(req *pb.InspectCacheKeyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetKey() == "" || len(req.GetKey()) > maxCacheKeyLength {
+		violations = append(violations, fieldViolation("key", errors.New("key must be between 1 and 512 characters")))
+	}
+	return violations
+}
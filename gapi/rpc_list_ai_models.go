@@ -17,7 +17,12 @@ func (server *Server) ListAIModels(ctx context.Context, req *pb.ListAIModelsRequ
 		return nil, unauthenticatedError(err)
 	}
 
-	cfg, err := server.resolveAIPolishConfig(ctx)
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := server.aiConfigs.Resolve(ctx, purpose)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load AI config")
 	}
@@ -26,7 +31,7 @@ func (server *Server) ListAIModels(ctx context.Context, req *pb.ListAIModelsRequ
 		cfg.Provider = strings.TrimSpace(req.GetProvider())
 	}
 	if strings.TrimSpace(req.GetApiProtocol()) != "" {
-		cfg.APIProtocol = normalizeAIPolishProtocol(req.GetApiProtocol())
+		cfg.APIProtocol = ai.NormalizeAPIProtocol(req.GetApiProtocol())
 	}
 	if strings.TrimSpace(req.GetBaseUrl()) != "" {
 		cfg.BaseURL = strings.TrimSpace(req.GetBaseUrl())
@@ -38,7 +43,7 @@ func (server *Server) ListAIModels(ctx context.Context, req *pb.ListAIModelsRequ
 	if cfg.BaseURL == "" || cfg.APIKey == "" {
 		return nil, status.Error(codes.FailedPrecondition, "AI provider models require base URL and API key")
 	}
-	if !isSupportedAIPolishProtocol(cfg.APIProtocol) {
+	if !ai.IsSupportedAPIProtocol(cfg.APIProtocol) {
 		return nil, status.Error(codes.InvalidArgument, "unsupported AI API protocol")
 	}
 
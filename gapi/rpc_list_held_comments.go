@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListHeldComments(ctx context.Context, req *pb.ListHeldCommentsRequest) (*pb.ListHeldCommentsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	page := normalizeUserListPage(req.GetPage())
+	limit := normalizeUserListLimit(req.GetLimit())
+
+	comments, err := server.store.ListHeldComments(ctx, db.ListHeldCommentsParams{
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list held comments: %v", err)
+	}
+
+	count, err := server.store.CountHeldComments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count held comments: %v", err)
+	}
+
+	resp := &pb.ListHeldCommentsResponse{
+		Comments: make([]*pb.ModeratedComment, 0, len(comments)),
+		Count:    count,
+	}
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, convertHeldComment(comment))
+	}
+
+	return resp, nil
+}
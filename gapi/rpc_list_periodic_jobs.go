@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListPeriodicJobs 执行计划来自当前实例的配置，执行结果由处理任务的实例写入缓存
+func (server *Server) ListPeriodicJobs(ctx context.Context, req *pb.ListPeriodicJobsRequest) (*pb.ListPeriodicJobsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	jobs, err := worker.PeriodicJobs(server.config)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid periodic jobs config: %v", err)
+	}
+
+	schedulerCache := cachepkg.NewSchedulerCache(server.cache)
+	now := time.Now()
+	resp := &pb.ListPeriodicJobsResponse{
+		SchedulerEnabled: server.config.SchedulerEnabled,
+		Jobs:             make([]*pb.PeriodicJob, 0, len(jobs)),
+	}
+	for _, job := range jobs {
+		item := &pb.PeriodicJob{
+			Name:     job.Name,
+			TaskType: job.TaskType,
+			Spec:     job.Spec,
+			Enabled:  job.Enabled(),
+		}
+		if next := job.NextRun(now); !next.IsZero() {
+			item.NextRunAt = timestamppb.New(next)
+		}
+
+		run, ok, err := schedulerCache.GetLastRun(ctx, job.TaskType)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get last run of %s: %v", job.Name, err)
+		}
+		if ok {
+			item.LastRun = &pb.PeriodicJobRun{
+				StartedAt:  timestamppb.New(run.StartedAt),
+				FinishedAt: timestamppb.New(run.FinishedAt),
+				Succeeded:  run.Succeeded,
+				Error:      run.Error,
+			}
+		}
+
+		resp.Jobs = append(resp.Jobs, item)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/worker"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListPeriodicJobs(t *testing.T) {
+	startedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	lastRun := cachepkg.PeriodicJobRun{
+		StartedAt:  startedAt,
+		FinishedAt: startedAt.Add(2 * time.Second),
+		Error:      "failed to delete expired sessions: boom",
+	}
+
+	testCases := []struct {
+		name          string
+		periodicJobs  []string
+		buildStubs    func(redisCache *mockcache.MockCache)
+		checkResponse func(t *testing.T, resp *pb.ListPeriodicJobsResponse, err error)
+	}{
+		{
+			name:         "OK",
+			periodicJobs: []string{"cleanup_temp_uploads=off"},
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key.GetSchedulerLastRunKey(worker.TaskCleanupSessions)), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, dest any) (bool, error) {
+						value, err := json.Marshal(lastRun)
+						require.NoError(t, err)
+						return true, json.Unmarshal(value, dest)
+					})
+				redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListPeriodicJobsResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, resp.GetSchedulerEnabled())
+
+				jobs := make(map[string]*pb.PeriodicJob, len(resp.GetJobs()))
+				for _, job := range resp.GetJobs() {
+					jobs[job.GetName()] = job
+				}
+				require.Len(t, jobs, 5)
+
+				sessions := jobs[worker.JobCleanupSessions]
+				require.True(t, sessions.GetEnabled())
+				require.Equal(t, "@daily", sessions.GetSpec())
+				require.Equal(t, worker.TaskCleanupSessions, sessions.GetTaskType())
+				require.True(t, sessions.GetNextRunAt().AsTime().After(time.Now()))
+				require.Equal(t, startedAt, sessions.GetLastRun().GetStartedAt().AsTime())
+				require.False(t, sessions.GetLastRun().GetSucceeded())
+				require.Equal(t, lastRun.Error, sessions.GetLastRun().GetError())
+
+				uploads := jobs[worker.JobCleanupTempUploads]
+				require.False(t, uploads.GetEnabled())
+				require.Empty(t, uploads.GetSpec())
+				require.Nil(t, uploads.GetNextRunAt())
+				require.Nil(t, uploads.GetLastRun())
+			},
+		},
+		{
+			name:         "InvalidConfig",
+			periodicJobs: []string{"unknown_job=@daily"},
+			buildStubs: func(redisCache *mockcache.MockCache) {
+				redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListPeriodicJobsResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			redisCache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(redisCache)
+
+			server := newTestServer(t, newGAPITestStore(mockdb.NewMockStore(ctrl)), nil, redisCache)
+			server.config.SchedulerEnabled = true
+			server.config.PeriodicJobs = tc.periodicJobs
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.ListPeriodicJobs(ctx, &pb.ListPeriodicJobsRequest{})
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestListPeriodicJobsUnauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, newGAPITestStore(mockdb.NewMockStore(ctrl)), nil, nil)
+
+	_, err := server.ListPeriodicJobs(context.Background(), &pb.ListPeriodicJobsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPromptTemplateVersions(ctx context.Context, req *pb.ListPromptTemplateVersionsRequest) (*pb.ListPromptTemplateVersionsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	storageKey := ai.ConfigStorageKey(purpose)
+
+	page := normalizeUserListPage(req.GetPage())
+	limit := normalizeUserListLimit(req.GetLimit())
+
+	versions, err := server.store.ListAIPromptTemplateVersions(ctx, db.ListAIPromptTemplateVersionsParams{
+		Purpose: storageKey,
+		Limit:   limit,
+		Offset:  (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list prompt template versions")
+	}
+
+	count, err := server.store.CountAIPromptTemplateVersions(ctx, storageKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count prompt template versions")
+	}
+
+	resp := &pb.ListPromptTemplateVersionsResponse{
+		Versions: make([]*pb.PromptTemplateVersion, 0, len(versions)),
+		Count:    count,
+	}
+	for _, version := range versions {
+		resp.Versions = append(resp.Versions, convertPromptTemplateVersion(db.AiPromptTemplateVersion{
+			ID:              version.ID,
+			Purpose:         version.Purpose,
+			Version:         version.Version,
+			PromptTemplates: version.PromptTemplates,
+			RollbackOf:      version.RollbackOf,
+			CreatedBy:       version.CreatedBy,
+			CreatedAt:       version.CreatedAt,
+		}, version.AuthorUsername))
+	}
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListQueueTasks(ctx context.Context, req *pb.ListQueueTasksRequest) (*pb.ListQueueTasksResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListQueueTasksRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if err := server.checkTaskInspector(); err != nil {
+		return nil, err
+	}
+
+	state := queueTaskStates[normalizeQueueTaskState(req.GetState())]
+	page := normalizeUserListPage(req.GetPage())
+	limit := normalizeUserListLimit(req.GetLimit())
+
+	tasks, err := server.taskInspector.ListTasks(req.GetQueue(), state, int(page), int(limit))
+	if err != nil {
+		return nil, taskInspectorError("list queue tasks", err)
+	}
+
+	queue, err := server.taskInspector.GetQueue(req.GetQueue())
+	if err != nil {
+		return nil, taskInspectorError("get task queue", err)
+	}
+
+	resp := &pb.ListQueueTasksResponse{
+		Tasks: make([]*pb.QueueTask, 0, len(tasks)),
+		Count: int64(countQueueTasks(queue, state)),
+	}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, convertQueueTask(task))
+	}
+
+	return resp, nil
+}
+
+func countQueueTasks(queue *asynq.QueueInfo, state asynq.TaskState) int {
+	switch state {
+	case asynq.TaskStatePending:
+		return queue.Pending
+	case asynq.TaskStateRetry:
+		return queue.Retry
+	case asynq.TaskStateArchived:
+		return queue.Archived
+	default:
+		return 0
+	}
+}
+
+func validateListQueueTasksRequest(req *pb.ListQueueTasksRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := validateQueueName(req.GetQueue()); violation != nil {
+		violations = append(violations, violation)
+	}
+	if _, ok := queueTaskStates[normalizeQueueTaskState(req.GetState())]; !ok {
+		violations = append(violations, fieldViolation("state", fmt.Errorf("state must be pending, retry or archived")))
+	}
+	return violations
+}
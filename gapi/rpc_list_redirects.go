@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListRedirects(ctx context.Context, req *pb.ListRedirectsRequest) (*pb.ListRedirectsResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	page := normalizeUserListPage(req.GetPage())
+	limit := normalizeUserListLimit(req.GetLimit())
+
+	redirects, err := server.store.ListRedirects(ctx, db.ListRedirectsParams{
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list redirects: %v", err)
+	}
+
+	count, err := server.store.CountRedirects(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count redirects: %v", err)
+	}
+
+	resp := &pb.ListRedirectsResponse{
+		Redirects: make([]*pb.Redirect, 0, len(redirects)),
+		Count:     count,
+	}
+	for _, redirect := range redirects {
+		resp.Redirects = append(resp.Redirects, convertRedirect(redirect))
+	}
+
+	return resp, nil
+}
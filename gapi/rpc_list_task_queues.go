@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/MonitorAllen/nostalgia/pb"
+)
+
+func (server *Server) ListTaskQueues(ctx context.Context, req *pb.ListTaskQueuesRequest) (*pb.ListTaskQueuesResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	if err := server.checkTaskInspector(); err != nil {
+		return nil, err
+	}
+
+	queues, err := server.taskInspector.ListQueues()
+	if err != nil {
+		return nil, taskInspectorError("list task queues", err)
+	}
+
+	resp := &pb.ListTaskQueuesResponse{
+		Queues: make([]*pb.TaskQueue, 0, len(queues)),
+	}
+	for _, queue := range queues {
+		resp.Queues = append(resp.Queues, convertTaskQueue(queue))
+	}
+
+	return resp, nil
+}
@@ -3,11 +3,16 @@ package gapi
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/MonitorAllen/nostalgia/internal/ai"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
 	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (server *Server) PolishText(ctx context.Context, req *pb.PolishTextRequest) (*pb.PolishTextResponse, error) {
@@ -29,11 +34,11 @@ func (server *Server) PolishText(ctx context.Context, req *pb.PolishTextRequest)
 		Locale:         req.GetLocale(),
 	}
 
-	cfg := server.runtimeAIPolishConfig()
+	cfg := server.aiConfigs.Runtime()
 	polisher := server.textPolisher
 	if polisher == nil {
 		var resolveErr error
-		cfg, resolveErr = server.resolveAIPolishConfig(ctx)
+		cfg, resolveErr = server.aiConfigs.Resolve(ctx, ai.PurposePolish)
 		if resolveErr != nil {
 			return nil, status.Error(codes.Internal, "failed to load AI config")
 		}
@@ -44,25 +49,28 @@ func (server *Server) PolishText(ctx context.Context, req *pb.PolishTextRequest)
 	}
 
 	if polisher == nil {
-		if !cfg.usable() {
+		if !cfg.Usable() {
 			return nil, status.Error(codes.FailedPrecondition, "AI 润色尚未配置")
 		}
-		polisher = ai.NewPolishService(ai.ServiceConfig{
-			Provider:         cfg.Provider,
-			APIProtocol:      cfg.APIProtocol,
-			BaseURL:          cfg.BaseURL,
-			APIKey:           cfg.APIKey,
-			Model:            cfg.Model,
-			Timeout:          cfg.Timeout,
-			MaxInputChars:    cfg.MaxInputChars,
-			MaxContextChars:  cfg.MaxContextChars,
-			MaxSuggestions:   cfg.MaxSuggestions,
-			PromptTemplates:  cfg.PromptTemplates,
-			HTTPProxyAddress: server.config.HTTPProxyAddr,
-		}, nil)
+		polisher = server.newTextPolisher(cfg)
 	}
-	if polisher == nil {
-		return nil, status.Error(codes.FailedPrecondition, "AI 润色尚未配置")
+
+	// 相同提示词、模型与协议的请求直接复用上次结果，避免重复计费
+	polishCache := cachepkg.NewAIPolishCache(server.cache, server.config.AIPolishCacheTTL)
+	cacheKey := ai.PolishCacheKey(server.aiConfigs.ServiceConfig(cfg), polishReq)
+	if !req.GetForceRefresh() {
+		entry, ok, err := polishCache.Get(ctx, cacheKey)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("key", key.GetAIPolishResponseKey(cacheKey)).
+				Str("module", "ai").
+				Str("action", "cache_get").
+				Msg("获取 AI 润色缓存失败，降级为直接调用模型")
+		}
+		if ok {
+			return convertPolishTextResponse(entry.Response, true, entry.CachedAt), nil
+		}
 	}
 
 	result, err := polisher.Polish(ctx, polishReq)
@@ -70,6 +78,20 @@ func (server *Server) PolishText(ctx context.Context, req *pb.PolishTextRequest)
 		return nil, mapAIPolishError(err)
 	}
 
+	entry := cachepkg.AIPolishEntry{Response: result, CachedAt: time.Now()}
+	if err := polishCache.Set(ctx, cacheKey, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.GetAIPolishResponseKey(cacheKey)).
+			Str("module", "ai").
+			Str("action", "cache_set").
+			Msg("写入 AI 润色缓存失败")
+	}
+
+	return convertPolishTextResponse(result, false, time.Time{}), nil
+}
+
+func convertPolishTextResponse(result ai.PolishResponse, cacheHit bool, cachedAt time.Time) *pb.PolishTextResponse {
 	suggestions := make([]*pb.PolishSuggestion, 0, len(result.Suggestions))
 	for _, suggestion := range result.Suggestions {
 		suggestions = append(suggestions, &pb.PolishSuggestion{
@@ -78,12 +100,37 @@ func (server *Server) PolishText(ctx context.Context, req *pb.PolishTextRequest)
 		})
 	}
 
-	return &pb.PolishTextResponse{
+	resp := &pb.PolishTextResponse{
 		Suggestions: suggestions,
 		Mode:        result.Mode,
 		Target:      result.Target,
 		Model:       result.Model,
-	}, nil
+		CacheHit:    cacheHit,
+	}
+	if cacheHit {
+		resp.CachedAt = timestamppb.New(cachedAt)
+	}
+	return resp
+}
+
+func (server *Server) newTextPolisher(cfg resolvedAIConfig) ai.TextPolisher {
+	return ai.NewPolishService(server.aiConfigs.ServiceConfig(cfg), nil)
+}
+
+// aiTextPolisher 返回注入的润色器，未注入时按指定用途的配置构建
+func (server *Server) aiTextPolisher(ctx context.Context, purpose string) (ai.TextPolisher, resolvedAIConfig, error) {
+	if server.textPolisher != nil {
+		return server.textPolisher, server.aiConfigs.Runtime(), nil
+	}
+
+	cfg, err := server.aiConfigs.Resolve(ctx, purpose)
+	if err != nil {
+		return nil, cfg, status.Error(codes.Internal, "failed to load AI config")
+	}
+	if !cfg.Usable() {
+		return nil, cfg, status.Error(codes.FailedPrecondition, "AI 润色尚未配置")
+	}
+	return server.newTextPolisher(cfg), cfg, nil
 }
 
 func mapAIPolishError(err error) error {
@@ -12,6 +12,9 @@ import (
 	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	"github.com/MonitorAllen/nostalgia/internal/ai"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
 	"github.com/MonitorAllen/nostalgia/internal/secrets"
 	"github.com/MonitorAllen/nostalgia/pb"
 	"github.com/MonitorAllen/nostalgia/util"
@@ -150,6 +153,143 @@ func TestPolishTextSuccess(t *testing.T) {
 	require.Equal(t, "writer-model", resp.GetModel())
 }
 
+func TestPolishTextOutlineMode(t *testing.T) {
+	polisher := &fakeTextPolisher{response: ai.PolishResponse{
+		Suggestions: []ai.Suggestion{{Content: "<h2>背景</h2><ul><li>问题</li></ul>"}},
+		Mode:        ai.ModeOutline,
+		Target:      ai.TargetContentSelection,
+	}}
+	server := newPolishTextTestServer(t, polisher)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.PolishText(ctx, &pb.PolishTextRequest{
+		Mode:         ai.ModeOutline,
+		Target:       ai.TargetContentSelection,
+		Text:         "缓存穿透与击穿",
+		ArticleTitle: "Redis 缓存实践",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "Redis 缓存实践", polisher.request.ArticleTitle)
+	require.Equal(t, ai.ModeOutline, resp.GetMode())
+
+	_, err = server.PolishText(ctx, &pb.PolishTextRequest{Mode: ai.ModeContinue, Target: ai.TargetContentSelection})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestPolishTextServesCachedResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	redisCache := mockcache.NewMockCache(ctrl)
+	polisher := &fakeTextPolisher{}
+	server := newTestServer(t, nil, nil, redisCache)
+	server.textPolisher = polisher
+	server.config.AIPolishCacheTTL = time.Hour
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	cachedAt := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	req := &pb.PolishTextRequest{Mode: ai.ModeImprove, Target: ai.TargetContentSelection, Text: "原始表达"}
+	cacheKey := key.GetAIPolishResponseKey(ai.PolishCacheKey(server.aiConfigs.ServiceConfig(server.aiConfigs.Runtime()), ai.PolishRequest{
+		Mode:   req.GetMode(),
+		Target: req.GetTarget(),
+		Text:   req.GetText(),
+	}))
+	redisCache.EXPECT().
+		Get(gomock.Any(), cacheKey, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cacheKey string, dest any) (bool, error) {
+			*dest.(*cachepkg.AIPolishEntry) = cachepkg.AIPolishEntry{
+				Response: ai.PolishResponse{
+					Suggestions: []ai.Suggestion{{Content: "缓存结果"}},
+					Mode:        ai.ModeImprove,
+					Target:      ai.TargetContentSelection,
+					Model:       "writer-model",
+				},
+				CachedAt: cachedAt,
+			}
+			return true, nil
+		})
+	redisCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	resp, err := server.PolishText(ctx, req)
+
+	require.NoError(t, err)
+	require.Empty(t, polisher.request.Mode)
+	require.True(t, resp.GetCacheHit())
+	require.Equal(t, cachedAt, resp.GetCachedAt().AsTime())
+	require.Equal(t, "缓存结果", resp.GetSuggestions()[0].GetContent())
+}
+
+func TestPolishTextForceRefreshBypassesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	redisCache := mockcache.NewMockCache(ctrl)
+	polisher := &fakeTextPolisher{response: ai.PolishResponse{
+		Suggestions: []ai.Suggestion{{Content: "新结果"}},
+		Mode:        ai.ModeImprove,
+		Target:      ai.TargetContentSelection,
+		Model:       "writer-model",
+	}}
+	server := newTestServer(t, nil, nil, redisCache)
+	server.textPolisher = polisher
+	server.config.AIPolishCacheTTL = time.Hour
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	redisCache.EXPECT().
+		Set(gomock.Any(), gomock.Any(), gomock.Any(), time.Hour).
+		DoAndReturn(func(ctx context.Context, cacheKey string, value any, ttl time.Duration) error {
+			entry := value.(cachepkg.AIPolishEntry)
+			require.Equal(t, "新结果", entry.Response.Suggestions[0].Content)
+			require.False(t, entry.CachedAt.IsZero())
+			return nil
+		})
+
+	resp, err := server.PolishText(ctx, &pb.PolishTextRequest{
+		Mode:         ai.ModeImprove,
+		Target:       ai.TargetContentSelection,
+		Text:         "原始表达",
+		ForceRefresh: true,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "原始表达", polisher.request.Text)
+	require.False(t, resp.GetCacheHit())
+	require.Nil(t, resp.GetCachedAt())
+	require.Equal(t, "新结果", resp.GetSuggestions()[0].GetContent())
+}
+
+func TestPolishTextSkipsCacheWhenTTLDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	redisCache := mockcache.NewMockCache(ctrl)
+	polisher := &fakeTextPolisher{response: ai.PolishResponse{
+		Suggestions: []ai.Suggestion{{Content: "新结果"}},
+		Mode:        ai.ModeImprove,
+		Target:      ai.TargetContentSelection,
+	}}
+	server := newTestServer(t, nil, nil, redisCache)
+	server.textPolisher = polisher
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	redisCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	redisCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	resp, err := server.PolishText(ctx, &pb.PolishTextRequest{
+		Mode:   ai.ModeImprove,
+		Target: ai.TargetContentSelection,
+		Text:   "原始表达",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "原始表达", polisher.request.Text)
+	require.False(t, resp.GetCacheHit())
+}
+
 func TestPolishTextMapsProviderFailureWithoutLeakingSecret(t *testing.T) {
 	server := newPolishTextTestServer(t, &fakeTextPolisher{
 		err: errors.Join(ai.ErrProviderFailure, errors.New("secret-key")),
@@ -178,6 +318,7 @@ func TestGetAIConfigMasksProviderSecret(t *testing.T) {
 	server.config.AIPolishMaxInputChars = 7000
 	server.config.AIPolishMaxContextChars = 5000
 	server.config.AIPolishMaxSuggestions = 2
+	server.aiConfigs = ai.NewConfigResolver(server.config, server.store)
 	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
 
 	resp, err := server.GetAIConfig(ctx, &pb.GetAIConfigRequest{})
@@ -344,6 +485,26 @@ func TestUpdateAIConfigStoresPromptTemplates(t *testing.T) {
 				UpdatedBy:        arg.UpdatedBy,
 			}, nil
 		})
+	store.EXPECT().
+		GetLatestAIPromptTemplateVersion(gomock.Any(), "ai_polish").
+		Return(db.AiPromptTemplateVersion{}, pgx.ErrNoRows)
+	gomock.InOrder(
+		store.EXPECT().
+			CreateAIPromptTemplateVersion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg db.CreateAIPromptTemplateVersionParams) (db.AiPromptTemplateVersion, error) {
+				require.Equal(t, "ai_polish", arg.Purpose)
+				require.False(t, arg.CreatedBy.Valid)
+				require.Contains(t, string(arg.PromptTemplates), "你是专业中文内容编辑")
+				return db.AiPromptTemplateVersion{Purpose: arg.Purpose, Version: 1}, nil
+			}),
+		store.EXPECT().
+			CreateAIPromptTemplateVersion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg db.CreateAIPromptTemplateVersionParams) (db.AiPromptTemplateVersion, error) {
+				require.True(t, arg.CreatedBy.Valid)
+				require.JSONEq(t, `{"improve":"custom {{text}}"}`, string(arg.PromptTemplates))
+				return db.AiPromptTemplateVersion{Purpose: arg.Purpose, Version: 2}, nil
+			}),
+	)
 
 	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
 	resp, err := server.UpdateAIConfig(ctx, &pb.UpdateAIConfigRequest{
@@ -499,3 +660,92 @@ func TestGetAIConfigRequiresAdmin(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, codes.PermissionDenied, st.Code())
 }
+
+func TestGetAIConfigFallsBackToPolishForUnconfiguredPurpose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	row := testAIConfigRow(t, server, "https://db-ai.example.com/v1", "database-secret")
+	gomock.InOrder(
+		store.EXPECT().
+			GetAIProviderConfig(gomock.Any(), "ai_metadata").
+			Return(db.AiProviderConfig{}, pgx.ErrNoRows),
+		store.EXPECT().
+			GetAIProviderConfig(gomock.Any(), "ai_polish").
+			Return(row, nil),
+	)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.GetAIConfig(ctx, &pb.GetAIConfigRequest{Purpose: "metadata"})
+
+	require.NoError(t, err)
+	require.Equal(t, "metadata", resp.GetPurpose())
+	require.True(t, resp.GetInherited())
+	require.Equal(t, "https://db-ai.example.com/v1", resp.GetBaseUrl())
+	require.True(t, resp.GetEnabled())
+}
+
+func TestUpdateAIConfigStoresPurposeSpecificConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	store.EXPECT().
+		GetAIProviderConfig(gomock.Any(), "ai_automation_review").
+		Return(db.AiProviderConfig{}, pgx.ErrNoRows)
+	store.EXPECT().
+		GetAIProviderConfig(gomock.Any(), "ai_polish").
+		Return(db.AiProviderConfig{}, pgx.ErrNoRows)
+	store.EXPECT().
+		UpsertAIProviderConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.UpsertAIProviderConfigParams) (db.AiProviderConfig, error) {
+			require.Equal(t, "ai_automation_review", arg.Purpose)
+
+			plaintext, err := secrets.DecryptString(arg.ApiKeyCiphertext, server.config.TokenSymmetricKey, "nostalgia:ai-automation-review-api-key")
+			require.NoError(t, err)
+			require.Equal(t, "review-secret", plaintext)
+
+			return db.AiProviderConfig{
+				Purpose:          arg.Purpose,
+				Provider:         arg.Provider,
+				ApiProtocol:      arg.ApiProtocol,
+				BaseUrl:          arg.BaseUrl,
+				Model:            arg.Model,
+				ApiKeyCiphertext: arg.ApiKeyCiphertext,
+				TimeoutMs:        arg.TimeoutMs,
+				MaxInputChars:    arg.MaxInputChars,
+				MaxContextChars:  arg.MaxContextChars,
+				MaxSuggestions:   arg.MaxSuggestions,
+				Enabled:          arg.Enabled,
+			}, nil
+		})
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.UpdateAIConfig(ctx, &pb.UpdateAIConfigRequest{
+		Purpose:  "automation-review",
+		Provider: "openai_compatible",
+		BaseUrl:  "https://review.example.com/v1",
+		Model:    "review-model",
+		ApiKey:   "review-secret",
+		Timeout:  "30s",
+		Enabled:  true,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "automation_review", resp.GetPurpose())
+	require.False(t, resp.GetInherited())
+	require.True(t, resp.GetApiKeyConfigured())
+	require.Equal(t, "review-model", resp.GetModel())
+}
+
+func TestGetAIConfigRejectsUnknownPurpose(t *testing.T) {
+	server := newPolishTextTestServer(t, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.GetAIConfig(ctx, &pb.GetAIConfigRequest{Purpose: "unknown"})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
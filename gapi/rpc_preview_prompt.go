@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PreviewPrompt(ctx context.Context, req *pb.PreviewPromptRequest) (*pb.PreviewPromptResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetTemplate()) == "" && !slices.Contains(ai.PromptTemplateKeys(), strings.TrimSpace(req.GetMode())) {
+		return nil, status.Error(codes.InvalidArgument, "mode or template is required")
+	}
+
+	cfg, err := server.aiConfigs.Resolve(ctx, purpose)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load AI config")
+	}
+
+	preview := ai.PreviewPrompt(ai.ServiceConfig{
+		MaxInputChars:   cfg.MaxInputChars,
+		MaxContextChars: cfg.MaxContextChars,
+		MaxSuggestions:  cfg.MaxSuggestions,
+		PromptTemplates: cfg.PromptTemplates,
+	}, ai.PolishRequest{
+		Mode:           req.GetMode(),
+		Target:         req.GetTarget(),
+		Text:           req.GetText(),
+		RichText:       req.GetRichText(),
+		InputFormat:    req.GetInputFormat(),
+		ArticleTitle:   req.GetArticleTitle(),
+		ArticleSummary: req.GetArticleSummary(),
+		ArticleExcerpt: req.GetArticleExcerpt(),
+		Locale:         req.GetLocale(),
+		Categories:     req.GetCategories(),
+	}, req.GetTemplate())
+
+	return &pb.PreviewPromptResponse{
+		Prompt:                preview.Prompt,
+		UnknownPlaceholders:   preview.UnknownPlaceholders,
+		SupportedPlaceholders: ai.PromptPlaceholders(),
+	}, nil
+}
@@ -0,0 +1,146 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreviewPromptReportsUnknownPlaceholders(t *testing.T) {
+	server := newPolishTextTestServer(t, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.PreviewPrompt(ctx, &pb.PreviewPromptRequest{
+		Mode:         ai.ModeTitleCandidates,
+		Template:     "标题：{{article_title}} 作者：{{author}}",
+		ArticleTitle: "缓存一致性",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "标题：缓存一致性 作者：{{author}}", resp.GetPrompt())
+	require.Equal(t, []string{"{{author}}"}, resp.GetUnknownPlaceholders())
+	require.Contains(t, resp.GetSupportedPlaceholders(), "{{article_title}}")
+}
+
+func TestPreviewPromptUsesConfiguredTemplate(t *testing.T) {
+	server := newPolishTextTestServer(t, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.PreviewPrompt(ctx, &pb.PreviewPromptRequest{
+		Mode:         ai.ModeSummaryCandidates,
+		ArticleTitle: "缓存一致性",
+	})
+
+	require.NoError(t, err)
+	require.Contains(t, resp.GetPrompt(), "article_title=缓存一致性")
+	require.Empty(t, resp.GetUnknownPlaceholders())
+
+	_, err = server.PreviewPrompt(ctx, &pb.PreviewPromptRequest{Mode: "unknown"})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestDiffPromptTemplateVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetAIPromptTemplateVersion(gomock.Any(), db.GetAIPromptTemplateVersionParams{Purpose: "ai_polish", Version: 1}).
+		Return(db.AiPromptTemplateVersion{PromptTemplates: []byte(`{"improve":"第一行\n第二行"}`)}, nil)
+	store.EXPECT().
+		GetAIPromptTemplateVersion(gomock.Any(), db.GetAIPromptTemplateVersionParams{Purpose: "ai_polish", Version: 2}).
+		Return(db.AiPromptTemplateVersion{PromptTemplates: []byte(`{"improve":"第一行\n新的第二行"}`)}, nil)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.DiffPromptTemplateVersions(ctx, &pb.DiffPromptTemplateVersionsRequest{
+		FromVersion: 1,
+		ToVersion:   2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetDiffs(), 1)
+	require.Equal(t, ai.ModeImprove, resp.GetDiffs()[0].GetKey())
+	lines := resp.GetDiffs()[0].GetLines()
+	require.Len(t, lines, 3)
+	require.Equal(t, util.DiffEqual, lines[0].GetOp())
+	require.Equal(t, util.DiffDelete, lines[1].GetOp())
+	require.Equal(t, "第二行", lines[1].GetText())
+	require.Equal(t, util.DiffInsert, lines[2].GetOp())
+	require.Equal(t, "新的第二行", lines[2].GetText())
+}
+
+func TestRollbackPromptTemplatesRestoresVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	row := testAIConfigRow(t, server, "https://db-ai.example.com/v1", "database-secret")
+	row.PromptTemplates = []byte(`{"improve":"broken {{txt}}"}`)
+
+	store.EXPECT().
+		GetAIPromptTemplateVersion(gomock.Any(), db.GetAIPromptTemplateVersionParams{Purpose: "ai_polish", Version: 3}).
+		Return(db.AiPromptTemplateVersion{PromptTemplates: []byte(`{"improve":"custom {{text}}"}`)}, nil)
+	store.EXPECT().GetAIProviderConfig(gomock.Any(), "ai_polish").Return(row, nil)
+	store.EXPECT().
+		UpsertAIProviderConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.UpsertAIProviderConfigParams) (db.AiProviderConfig, error) {
+			require.Equal(t, "ai_polish", arg.Purpose)
+			require.Contains(t, string(arg.PromptTemplates), "custom {{text}}")
+			require.Equal(t, row.BaseUrl, arg.BaseUrl)
+			return row, nil
+		})
+	store.EXPECT().
+		CreateAIPromptTemplateVersion(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.CreateAIPromptTemplateVersionParams) (db.AiPromptTemplateVersion, error) {
+			require.Equal(t, pgtype.Int4{Int32: 3, Valid: true}, arg.RollbackOf)
+			require.True(t, arg.CreatedBy.Valid)
+			return db.AiPromptTemplateVersion{
+				Purpose:         arg.Purpose,
+				Version:         4,
+				PromptTemplates: arg.PromptTemplates,
+				RollbackOf:      arg.RollbackOf,
+				CreatedBy:       arg.CreatedBy,
+			}, nil
+		})
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.RollbackPromptTemplates(ctx, &pb.RollbackPromptTemplatesRequest{Version: 3})
+
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.GetVersion().GetVersion())
+	require.Equal(t, int32(3), resp.GetVersion().GetRollbackOf())
+	require.Equal(t, "custom {{text}}", resp.GetVersion().GetPromptTemplates()[ai.ModeImprove])
+}
+
+func TestRollbackPromptTemplatesRequiresSavedConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetAIPromptTemplateVersion(gomock.Any(), gomock.Any()).
+		Return(db.AiPromptTemplateVersion{PromptTemplates: []byte(`{}`)}, nil)
+	store.EXPECT().GetAIProviderConfig(gomock.Any(), "ai_polish").Return(db.AiProviderConfig{}, pgx.ErrNoRows)
+	store.EXPECT().UpsertAIProviderConfig(gomock.Any(), gomock.Any()).Times(0)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.RollbackPromptTemplates(ctx, &pb.RollbackPromptTemplatesRequest{Version: 1})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+}
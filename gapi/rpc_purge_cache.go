@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purgeCachePrefix 计数缓冲、锁和幂等键不以 cache: 开头，按前缀清除时不会被误删
+const purgeCachePrefix = "cache:"
+
+func (server *Server) PurgeCache(ctx context.Context, req *pb.PurgeCacheRequest) (*pb.PurgeCacheResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validatePurgeCacheRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if server.cache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "cache is not configured")
+	}
+
+	var deleted int64
+	switch target := req.GetTarget().(type) {
+	case *pb.PurgeCacheRequest_ArticleId:
+		articleID, _ := uuid.Parse(target.ArticleId)
+		article, err := server.store.GetArticle(ctx, articleID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "article not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get article: %v", err)
+		}
+
+		articleCache := cachepkg.NewArticleCache(server.cache)
+		deleted, err = articleCache.Purge(ctx, article.ID, article.Slug.String)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to purge article cache: %v", err)
+		}
+		if err := articleCache.BumpListVersion(ctx, article.CategoryID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to bump article list version: %v", err)
+		}
+	case *pb.PurgeCacheRequest_CategoryId:
+		deleted, err = cachepkg.NewCategoryCache(server.cache).Purge(ctx, target.CategoryId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to purge category cache: %v", err)
+		}
+	case *pb.PurgeCacheRequest_Prefix:
+		// 保留版本号键，删除后版本号归零会让旧版本的列表与评论缓存重新生效
+		deleted, err = server.cache.DelByPattern(ctx, cachepkg.PrefixPattern(target.Prefix), key.VersionKeyPatterns...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to purge cache prefix: %v", err)
+		}
+	}
+
+	return &pb.PurgeCacheResponse{DeletedKeys: deleted}, nil
+}
+
+func validatePurgeCacheRequest(req *pb.PurgeCacheRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	switch target := req.GetTarget().(type) {
+	case *pb.PurgeCacheRequest_ArticleId:
+		if _, err := uuid.Parse(target.ArticleId); err != nil {
+			violations = append(violations, fieldViolation("article_id", errors.New("invalid article id")))
+		}
+	case *pb.PurgeCacheRequest_CategoryId:
+		if target.CategoryId <= 0 {
+			violations = append(violations, fieldViolation("category_id", errors.New("category id must be positive")))
+		}
+	case *pb.PurgeCacheRequest_Prefix:
+		// 只给出 cache: 时会清空全部缓存，要求至少指定一级命名空间
+		if !strings.HasPrefix(target.Prefix, purgeCachePrefix) || len(target.Prefix) <= len(purgeCachePrefix) {
+			violations = append(violations, fieldViolation("prefix", errors.New("prefix must start with cache: and name a namespace")))
+		}
+		if len(target.Prefix) > maxCacheKeyLength {
+			violations = append(violations, fieldViolation("prefix", errors.New("prefix must be at most 512 characters")))
+		}
+	default:
+		violations = append(violations, fieldViolation("target", errors.New("one of article_id, category_id or prefix is required")))
+	}
+	return violations
+}
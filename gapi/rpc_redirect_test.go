@@ -0,0 +1,204 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateRedirect(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.CreateRedirectRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, resp *pb.CreateRedirectResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateRedirectRequest{
+				SourcePath: " /about-me/?ref=nav ",
+				TargetPath: "/about",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRedirect(gomock.Any(), gomock.Eq(db.CreateRedirectParams{
+						SourcePath: "/about-me",
+						TargetPath: "/about",
+						StatusCode: 301,
+					})).
+					Times(1).
+					Return(db.Redirect{ID: 1, SourcePath: "/about-me", TargetPath: "/about", StatusCode: 301}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateRedirectResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "/about-me", resp.GetRedirect().GetSourcePath())
+				require.Equal(t, int32(301), resp.GetRedirect().GetStatusCode())
+			},
+		},
+		{
+			name: "ExternalTarget",
+			req: &pb.CreateRedirectRequest{
+				SourcePath: "/go",
+				TargetPath: "https://go.dev/blog",
+				StatusCode: 302,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRedirect(gomock.Any(), gomock.Eq(db.CreateRedirectParams{
+						SourcePath: "/go",
+						TargetPath: "https://go.dev/blog",
+						StatusCode: 302,
+					})).
+					Times(1).
+					Return(db.Redirect{ID: 2}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateRedirectResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidArguments",
+			req: &pb.CreateRedirectRequest{
+				SourcePath: "https://blog.example.com/about-me",
+				TargetPath: "javascript:alert(1)",
+				StatusCode: 303,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRedirect(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateRedirectResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Len(t, st.Details(), 1)
+				badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+				require.True(t, ok)
+				require.Len(t, badRequest.GetFieldViolations(), 3)
+			},
+		},
+		{
+			name: "SelfRedirect",
+			req: &pb.CreateRedirectRequest{
+				SourcePath: "/about",
+				TargetPath: "/about/",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRedirect(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateRedirectResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "AlreadyExists",
+			req: &pb.CreateRedirectRequest{
+				SourcePath: "/about-me",
+				TargetPath: "/about",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRedirect(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Redirect{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateRedirectResponse, err error) {
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, newGAPITestStore(store), nil, nil)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.CreateRedirect(ctx, tc.req)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestListRedirects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().
+		ListRedirects(gomock.Any(), db.ListRedirectsParams{Limit: 10, Offset: 10}).
+		Return([]db.Redirect{{ID: 3, SourcePath: "/old", TargetPath: "/new", StatusCode: 301}}, nil)
+	store.EXPECT().CountRedirects(gomock.Any()).Return(int64(11), nil)
+
+	resp, err := server.ListRedirects(ctx, &pb.ListRedirectsRequest{Page: 2, Limit: 10})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(11), resp.GetCount())
+	require.Len(t, resp.GetRedirects(), 1)
+	require.Equal(t, "/new", resp.GetRedirects()[0].GetTargetPath())
+}
+
+func TestUpdateRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().
+		UpdateRedirect(gomock.Any(), db.UpdateRedirectParams{
+			ID:         3,
+			StatusCode: pgtype.Int4{Int32: 308, Valid: true},
+		}).
+		Return(db.Redirect{ID: 3, SourcePath: "/old", TargetPath: "/new", StatusCode: 308}, nil)
+
+	resp, err := server.UpdateRedirect(ctx, &pb.UpdateRedirectRequest{Id: 3, StatusCode: proto.Int32(308)})
+	require.NoError(t, err)
+	require.Equal(t, int32(308), resp.GetRedirect().GetStatusCode())
+
+	_, err = server.UpdateRedirect(ctx, &pb.UpdateRedirectRequest{Id: 3, TargetPath: proto.String("ftp://example.com")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeleteRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().DeleteRedirect(gomock.Any(), int64(3)).Return(db.Redirect{ID: 3}, nil)
+	store.EXPECT().DeleteRedirect(gomock.Any(), int64(4)).Return(db.Redirect{}, db.ErrRecordNotFound)
+
+	_, err := server.DeleteRedirect(ctx, &pb.DeleteRedirectRequest{Id: 3})
+	require.NoError(t, err)
+
+	_, err = server.DeleteRedirect(ctx, &pb.DeleteRedirectRequest{Id: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.DeleteRedirect(context.Background(), &pb.DeleteRedirectRequest{Id: 3})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryArchivedTask 只重新执行已归档的任务，重试中的任务由 asynq 按退避时间自动执行
+func (server *Server) RetryArchivedTask(ctx context.Context, req *pb.RetryArchivedTaskRequest) (*pb.RetryArchivedTaskResponse, error) {
+	payload, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateQueueTaskTarget(req.GetQueue(), req.GetTaskId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	if err := server.checkTaskInspector(); err != nil {
+		return nil, err
+	}
+
+	task, err := server.taskInspector.GetTask(req.GetQueue(), req.GetTaskId())
+	if err != nil {
+		return nil, taskInspectorError("get task", err)
+	}
+	if task.State != asynq.TaskStateArchived {
+		return nil, status.Errorf(codes.FailedPrecondition, "task is %s, only archived tasks can be retried", task.State)
+	}
+
+	if err := server.taskInspector.RunTask(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, taskInspectorError("retry task", err)
+	}
+
+	log.Info().Str("queue", task.Queue).Str("task_id", task.ID).Str("type", task.Type).
+		Str("operator", payload.Username).Msg("retried archived task")
+
+	// 任务可能已被立即执行并删除，直接返回移回队列后的状态
+	task.State = asynq.TaskStatePending
+	return &pb.RetryArchivedTaskResponse{Task: convertQueueTask(task)}, nil
+}
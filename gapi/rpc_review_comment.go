@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// commentReviewStatuses 审核动作与评论状态的对应关系
+var commentReviewStatuses = map[string]string{
+	"approve": moderation.StatusPublished,
+	"reject":  moderation.StatusRejected,
+}
+
+func (server *Server) ReviewComment(ctx context.Context, req *pb.ReviewCommentRequest) (*pb.ReviewCommentResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewCommentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	comment, err := server.store.GetComment(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get comment: %v", err)
+	}
+
+	// 保留原审核原因，便于回溯误判
+	updated, err := server.store.UpdateCommentModeration(ctx, db.UpdateCommentModerationParams{
+		ID:               comment.ID,
+		Status:           commentReviewStatuses[normalizeCommentReviewAction(req.GetAction())],
+		ModerationReason: comment.ModerationReason,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to review comment: %v", err)
+	}
+
+	// 审核结果决定评论是否公开，失效后由下一次请求重建评论分页
+	if err := cachepkg.NewCommentCache(server.cache).Invalidate(ctx, updated.ArticleID); err != nil {
+		log.Error().Err(err).Int64("comment_id", updated.ID).Msg("failed to invalidate comment cache")
+	}
+
+	return &pb.ReviewCommentResponse{Comment: convertModeratedComment(updated)}, nil
+}
+
+func normalizeCommentReviewAction(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func validateReviewCommentRequest(req *pb.ReviewCommentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetId() <= 0 {
+		violations = append(violations, fieldViolation("id", fmt.Errorf("id must be a positive integer")))
+	}
+	if _, ok := commentReviewStatuses[normalizeCommentReviewAction(req.GetAction())]; !ok {
+		violations = append(violations, fieldViolation("action", fmt.Errorf("action must be approve or reject")))
+	}
+	return
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RollbackPromptTemplates(ctx context.Context, req *pb.RollbackPromptTemplatesRequest) (*pb.RollbackPromptTemplatesResponse, error) {
+	payload, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	if server.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "AI config storage is unavailable")
+	}
+
+	purpose, err := normalizeAIConfigPurpose(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetVersion() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid prompt template version")
+	}
+
+	templates, err := server.promptTemplatesAtVersion(ctx, purpose, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := server.aiConfigs.Resolve(ctx, purpose)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load AI config")
+	}
+	if current.Source != ai.ConfigSourceDB || current.Inherited {
+		return nil, status.Error(codes.FailedPrecondition, "AI config has not been saved for this purpose")
+	}
+
+	author := pgtype.UUID{Bytes: payload.UserID, Valid: true}
+	current.PromptTemplates = templates
+	var version db.AiPromptTemplateVersion
+	_, err = server.saveAIConfig(ctx, current, author, func(q db.Querier) error {
+		var err error
+		version, err = q.CreateAIPromptTemplateVersion(ctx, db.CreateAIPromptTemplateVersionParams{
+			Purpose:         ai.ConfigStorageKey(purpose),
+			PromptTemplates: encodePromptTemplates(templates),
+			RollbackOf:      pgtype.Int4{Int32: req.GetVersion(), Valid: true},
+			CreatedBy:       author,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save AI config")
+	}
+
+	return &pb.RollbackPromptTemplatesResponse{
+		Version: convertPromptTemplateVersion(version, pgtype.Text{String: payload.Username, Valid: true}),
+	}, nil
+}
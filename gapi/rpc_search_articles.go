@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/MonitorAllen/nostalgia/internal/search"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateSearchArticlesRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	filter := search.Filter{
+		Keyword:    strings.TrimSpace(req.GetKeyword()),
+		CategoryID: req.GetCategoryId(),
+		Tag:        req.GetTag(),
+		StartDate:  req.GetStartDate(),
+		EndDate:    req.GetEndDate(),
+		Sort:       req.GetSort(),
+		Location:   search.Location(server.config),
+	}
+	if req.IsPublish != nil {
+		filter.IsPublish = pgtype.Bool{Bool: req.GetIsPublish(), Valid: true}
+	}
+
+	query, err := search.ParseFilter(filter)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{searchFilterViolation(err)})
+	}
+
+	page := normalizeUserListPage(req.GetPage())
+	limit := normalizeUserListLimit(req.GetLimit())
+
+	result, err := search.Search(ctx, server.store, query, limit, (page-1)*limit, search.NewHighlighter(server.config))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var didYouMean []string
+	if result.Count == 0 {
+		didYouMean, err = search.NewSuggester(server.store, server.cache).DidYouMean(ctx, req.GetKeyword())
+		if err != nil {
+			log.Error().Err(err).Str("module", "search").Str("keyword", req.GetKeyword()).Msg("生成搜索纠正建议失败")
+		}
+	}
+
+	resp := &pb.SearchArticlesResponse{
+		DidYouMean:     didYouMean,
+		Hits:           make([]*pb.SearchArticleHit, 0, len(result.Articles)),
+		Count:          result.Count,
+		CategoryFacets: make([]*pb.SearchCategoryFacet, 0, len(result.Categories)),
+		YearFacets:     make([]*pb.SearchYearFacet, 0, len(result.Years)),
+	}
+	for _, article := range result.Articles {
+		resp.Hits = append(resp.Hits, convertSearchArticleHit(article))
+	}
+	for _, facet := range result.Categories {
+		resp.CategoryFacets = append(resp.CategoryFacets, &pb.SearchCategoryFacet{
+			CategoryId:   facet.CategoryID,
+			CategoryName: facet.CategoryName,
+			Count:        facet.Count,
+		})
+	}
+	for _, facet := range result.Years {
+		resp.YearFacets = append(resp.YearFacets, &pb.SearchYearFacet{
+			Year:  facet.Year,
+			Count: facet.Count,
+		})
+	}
+
+	return resp, nil
+}
+
+func validateSearchArticlesRequest(req *pb.SearchArticlesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if strings.TrimSpace(req.GetKeyword()) == "" {
+		violations = append(violations, fieldViolation("keyword", errors.New("keyword is required")))
+	}
+	if req.GetCategoryId() < 0 {
+		violations = append(violations, fieldViolation("category_id", errors.New("category_id must be positive")))
+	}
+	return violations
+}
+
+func searchFilterViolation(err error) *errdetails.BadRequest_FieldViolation {
+	switch {
+	case errors.Is(err, search.ErrInvalidSort):
+		return fieldViolation("sort", err)
+	case errors.Is(err, search.ErrInvalidDateRange):
+		return fieldViolation("end_date", err)
+	default:
+		return fieldViolation("date", err)
+	}
+}
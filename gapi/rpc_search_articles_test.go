@@ -0,0 +1,126 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/search"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchArticlesIncludesDrafts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	draft := db.SearchArticlesRow{
+		ID:                  uuid.New(),
+		Title:               "Redis 缓存",
+		CategoryID:          2,
+		CreatedByAutomation: true,
+		AutomationStatus:    "pending_review",
+		HighlightedTitle:    `<span class="keyword">Redis</span> 缓存`,
+		Snippets:            []string{`使用 <span class="keyword">Redis</span>`},
+	}
+	store.EXPECT().
+		SearchArticles(gomock.Any(), db.SearchArticlesParams{
+			Limit:        20,
+			Offset:       0,
+			Keyword:      `"redis"`,
+			SnippetWidth: util.DefaultSearchSnippetWidth,
+			SortBy:       search.SortNewest,
+		}).
+		Return([]db.SearchArticlesRow{draft}, nil)
+	store.EXPECT().
+		CountSearchArticles(gomock.Any(), db.CountSearchArticlesParams{Keyword: `"redis"`}).
+		Return(int64(1), nil)
+	store.EXPECT().
+		ListSearchArticleCategoryFacets(gomock.Any(), db.ListSearchArticleCategoryFacetsParams{Keyword: `"redis"`}).
+		Return([]db.ListSearchArticleCategoryFacetsRow{{CategoryID: 2, CategoryName: "后端", Count: 1}}, nil)
+	store.EXPECT().
+		ListSearchArticleYearFacets(gomock.Any(), db.ListSearchArticleYearFacetsParams{TimeZone: "UTC", Keyword: `"redis"`}).
+		Return([]db.ListSearchArticleYearFacetsRow{{Year: 2026, Count: 1}}, nil)
+
+	resp, err := server.SearchArticles(ctx, &pb.SearchArticlesRequest{Keyword: " Redis ", Sort: search.SortNewest})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.GetCount())
+	require.Len(t, resp.GetHits(), 1)
+	hit := resp.GetHits()[0]
+	require.Equal(t, draft.ID.String(), hit.GetArticle().GetId())
+	require.True(t, hit.GetArticle().GetCreatedByAutomation())
+	require.Equal(t, "<mark>Redis</mark> 缓存", hit.GetHighlightedTitle())
+	require.Equal(t, []string{"使用 <mark>Redis</mark>"}, hit.GetSnippets())
+	require.Equal(t, "后端", resp.GetCategoryFacets()[0].GetCategoryName())
+	require.Equal(t, int32(2026), resp.GetYearFacets()[0].GetYear())
+}
+
+func TestSearchArticlesFiltersPublishState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	isPublish := false
+	store.EXPECT().
+		SearchArticles(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, arg db.SearchArticlesParams) ([]db.SearchArticlesRow, error) {
+			require.Equal(t, pgtype.Bool{Bool: false, Valid: true}, arg.IsPublish)
+			require.Equal(t, pgtype.Int8{Int64: 3, Valid: true}, arg.CategoryID)
+			require.Equal(t, int32(10), arg.Offset)
+			return []db.SearchArticlesRow{}, nil
+		})
+	store.EXPECT().CountSearchArticles(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	store.EXPECT().ListSearchArticleCategoryFacets(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().ListSearchVocabulary(gomock.Any()).Return([]string{"golang"}, nil)
+
+	resp, err := server.SearchArticles(ctx, &pb.SearchArticlesRequest{
+		Keyword:    "golnag",
+		Page:       2,
+		Limit:      10,
+		CategoryId: 3,
+		IsPublish:  &isPublish,
+	})
+
+	require.NoError(t, err)
+	require.Empty(t, resp.GetHits())
+	require.Empty(t, resp.GetCategoryFacets())
+	require.Equal(t, []string{"golang"}, resp.GetDidYouMean())
+}
+
+func TestSearchArticlesRejectsInvalidFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	store.EXPECT().SearchArticles(gomock.Any(), gomock.Any()).Times(0)
+
+	for _, req := range []*pb.SearchArticlesRequest{
+		{Keyword: " "},
+		{Keyword: "go", Sort: "random"},
+		{Keyword: "go", StartDate: "2026-02-01", EndDate: "2026-01-01"},
+	} {
+		_, err := server.SearchArticles(ctx, req)
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	}
+}
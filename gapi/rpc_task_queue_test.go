@@ -0,0 +1,307 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/worker"
+	mockwk "github.com/MonitorAllen/nostalgia/worker/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTaskQueueTestServer(t *testing.T, ctrl *gomock.Controller, inspector worker.TaskInspector) *Server {
+	server := newTestServer(t, newGAPITestStore(mockdb.NewMockStore(ctrl)), nil, nil)
+	server.taskInspector = inspector
+	return server
+}
+
+func newArchivedTask(taskType string) *asynq.TaskInfo {
+	return &asynq.TaskInfo{
+		ID:           "task-1",
+		Queue:        worker.QueueCritical,
+		Type:         taskType,
+		Payload:      []byte(`{"username":"alice"}`),
+		State:        asynq.TaskStateArchived,
+		MaxRetry:     10,
+		Retried:      10,
+		LastErr:      "failed to send verify email: dial tcp: i/o timeout",
+		LastFailedAt: time.Now().Add(-time.Hour),
+	}
+}
+
+func TestListTaskQueues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inspector := mockwk.NewMockTaskInspector(ctrl)
+	inspector.EXPECT().ListQueues().Times(1).Return([]*asynq.QueueInfo{{
+		Queue:     worker.QueueCritical,
+		Size:      3,
+		Pending:   1,
+		Archived:  2,
+		Processed: 40,
+		Failed:    2,
+		Latency:   1500 * time.Millisecond,
+	}}, nil)
+
+	server := newTaskQueueTestServer(t, ctrl, inspector)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.ListTaskQueues(ctx, &pb.ListTaskQueuesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetQueues(), 1)
+	queue := resp.GetQueues()[0]
+	require.Equal(t, worker.QueueCritical, queue.GetName())
+	require.Equal(t, int32(2), queue.GetArchived())
+	require.Equal(t, int32(2), queue.GetFailedToday())
+	require.Equal(t, int64(1500), queue.GetLatencyMs())
+}
+
+func TestListTaskQueuesWithoutInspector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTaskQueueTestServer(t, ctrl, nil)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.ListTaskQueues(ctx, &pb.ListTaskQueuesRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.ListTaskQueues(context.Background(), &pb.ListTaskQueuesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestListQueueTasks(t *testing.T) {
+	archived := newArchivedTask(worker.TaskSendVerifyEmail)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListQueueTasksRequest
+		buildStubs    func(inspector *mockwk.MockTaskInspector)
+		checkResponse func(t *testing.T, resp *pb.ListQueueTasksResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListQueueTasksRequest{Queue: worker.QueueCritical, State: "Archived", Page: 2, Limit: 10},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().
+					ListTasks(worker.QueueCritical, asynq.TaskStateArchived, 2, 10).
+					Times(1).
+					Return([]*asynq.TaskInfo{archived}, nil)
+				inspector.EXPECT().GetQueue(worker.QueueCritical).Times(1).Return(&asynq.QueueInfo{Archived: 11}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListQueueTasksResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(11), resp.GetCount())
+				require.Len(t, resp.GetTasks(), 1)
+
+				task := resp.GetTasks()[0]
+				require.Equal(t, archived.ID, task.GetId())
+				require.Equal(t, "archived", task.GetState())
+				require.Equal(t, `{"username":"alice"}`, task.GetPayload())
+				require.False(t, task.GetPayloadTruncated())
+				require.Equal(t, archived.LastErr, task.GetLastError())
+				require.Equal(t, archived.LastFailedAt.Unix(), task.GetLastFailedAt().AsTime().Unix())
+				require.Nil(t, task.GetNextProcessAt())
+			},
+		},
+		{
+			name: "QueueNotFound",
+			req:  &pb.ListQueueTasksRequest{Queue: "missing", State: "retry"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().
+					ListTasks("missing", asynq.TaskStateRetry, 1, 20).
+					Times(1).
+					Return(nil, fmt.Errorf("asynq: %w", asynq.ErrQueueNotFound))
+				inspector.EXPECT().GetQueue(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListQueueTasksResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidState",
+			req:  &pb.ListQueueTasksRequest{Queue: worker.QueueCritical, State: "active"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListQueueTasksResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListQueueTasksRequest{Queue: worker.QueueCritical, State: "pending"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().
+					ListTasks(worker.QueueCritical, asynq.TaskStatePending, 1, 20).
+					Times(1).
+					Return(nil, errors.New("redis is down"))
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListQueueTasksResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTaskQueueTestServer(t, ctrl, inspector)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.ListQueueTasks(ctx, tc.req)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestRetryArchivedTask(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.RetryArchivedTaskRequest
+		buildStubs    func(inspector *mockwk.MockTaskInspector)
+		checkResponse func(t *testing.T, resp *pb.RetryArchivedTaskResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.RetryArchivedTaskRequest{Queue: worker.QueueCritical, TaskId: "task-1"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(worker.QueueCritical, "task-1").Times(1).Return(newArchivedTask(worker.TaskNotifyAutomationDraft), nil)
+				inspector.EXPECT().RunTask(worker.QueueCritical, "task-1").Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RetryArchivedTaskResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "pending", resp.GetTask().GetState())
+				require.Equal(t, worker.TaskNotifyAutomationDraft, resp.GetTask().GetType())
+			},
+		},
+		{
+			name: "NotArchived",
+			req:  &pb.RetryArchivedTaskRequest{Queue: worker.QueueCritical, TaskId: "task-1"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				task := newArchivedTask(worker.TaskSendVerifyEmail)
+				task.State = asynq.TaskStateRetry
+				inspector.EXPECT().GetTask(worker.QueueCritical, "task-1").Times(1).Return(task, nil)
+				inspector.EXPECT().RunTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RetryArchivedTaskResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "TaskNotFound",
+			req:  &pb.RetryArchivedTaskRequest{Queue: worker.QueueCritical, TaskId: "missing"},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(worker.QueueCritical, "missing").Times(1).Return(nil, fmt.Errorf("asynq: %w", asynq.ErrTaskNotFound))
+				inspector.EXPECT().RunTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RetryArchivedTaskResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "MissingTaskID",
+			req:  &pb.RetryArchivedTaskRequest{Queue: worker.QueueCritical},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RetryArchivedTaskResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTaskQueueTestServer(t, ctrl, inspector)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			resp, err := server.RetryArchivedTask(ctx, tc.req)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestDeleteArchivedTask(t *testing.T) {
+	testCases := []struct {
+		name       string
+		buildStubs func(inspector *mockwk.MockTaskInspector)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(worker.QueueCritical, "task-1").Times(1).Return(newArchivedTask(worker.TaskSendVerifyEmail), nil)
+				inspector.EXPECT().DeleteTask(worker.QueueCritical, "task-1").Times(1).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NotArchived",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				task := newArchivedTask(worker.TaskSendVerifyEmail)
+				task.State = asynq.TaskStatePending
+				inspector.EXPECT().GetTask(worker.QueueCritical, "task-1").Times(1).Return(task, nil)
+				inspector.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "DeleteFailed",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(worker.QueueCritical, "task-1").Times(1).Return(newArchivedTask(worker.TaskSendVerifyEmail), nil)
+				inspector.EXPECT().DeleteTask(worker.QueueCritical, "task-1").Times(1).Return(errors.New("redis is down"))
+			},
+			checkError: func(t *testing.T, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTaskQueueTestServer(t, ctrl, inspector)
+			ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+			_, err := server.DeleteArchivedTask(ctx, &pb.DeleteArchivedTaskRequest{Queue: worker.QueueCritical, TaskId: "task-1"})
+			tc.checkError(t, err)
+		})
+	}
+}
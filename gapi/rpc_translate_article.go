@@ -0,0 +1,204 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var translationLocalePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// maxTranslationChunkAttempts 正文分段返回的 HTML 未通过校验时重新翻译的总次数
+const maxTranslationChunkAttempts = 2
+
+// maxTranslationChunks 每段都是一次同步的模型调用，超过上限时拒绝翻译，避免单个请求耗时和费用失控
+const maxTranslationChunks = 40
+
+// normalizeTranslationLocale 语言标签不区分大小写，统一小写后查重与保存
+func normalizeTranslationLocale(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func (server *Server) TranslateArticle(ctx context.Context, req *pb.TranslateArticleRequest) (*pb.TranslateArticleResponse, error) {
+	payload, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateTranslateArticleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	locale := normalizeTranslationLocale(req.GetLocale())
+
+	articleID, err := uuid.Parse(req.GetArticleId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	source, err := server.store.GetArticle(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "article not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to fetch article")
+	}
+
+	_, err = server.store.GetArticleTranslation(ctx, db.GetArticleTranslationParams{
+		SourceArticleID: source.ID,
+		Locale:          locale,
+	})
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "translation already exists")
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to fetch translation")
+	}
+
+	polisher, cfg, err := server.aiTextPolisher(ctx, ai.PurposeTranslation)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks, err := ai.ChunkHTML(source.Content, cfg.MaxInputChars)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "failed to parse article content")
+	}
+	if len(chunks) > maxTranslationChunks {
+		return nil, status.Errorf(codes.InvalidArgument, "article is too long to translate: %d chunks exceeds the limit of %d", len(chunks), maxTranslationChunks)
+	}
+
+	translator := articleTranslator{polisher: polisher, locale: locale, articleTitle: source.Title}
+	title, err := translator.translate(ctx, ai.TargetTitle, source.Title)
+	if err != nil {
+		return nil, mapAIPolishError(err)
+	}
+	summary, err := translator.translate(ctx, ai.TargetSummary, source.Summary)
+	if err != nil {
+		return nil, mapAIPolishError(err)
+	}
+
+	var content strings.Builder
+	for _, chunk := range chunks {
+		translated, err := translator.translateHTML(ctx, chunk)
+		if err != nil {
+			return nil, mapAIPolishError(err)
+		}
+		content.WriteString(translated)
+	}
+
+	translatedID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成文章ID失败: %v", err)
+	}
+
+	result, err := server.store.CreateArticleTranslationTx(ctx, db.CreateArticleTranslationTxParams{
+		SourceArticleID: source.ID,
+		Article: db.CreateArticleParams{
+			ID:         translatedID,
+			Title:      title,
+			Summary:    summary,
+			Content:    content.String(),
+			IsPublish:  false,
+			Owner:      source.Owner,
+			CategoryID: source.CategoryID,
+			Cover:      source.Cover,
+		},
+		Locale:    locale,
+		Model:     translator.model,
+		CreatedBy: pgtype.UUID{Bytes: payload.UserID, Valid: true},
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, "translation already exists")
+		}
+		return nil, status.Error(codes.Internal, "failed to create translation")
+	}
+
+	return &pb.TranslateArticleResponse{
+		Article:         convertOnlyArticle(result.Article, true),
+		SourceArticleId: source.ID.String(),
+		Locale:          result.Translation.Locale,
+		Model:           result.Translation.Model,
+		Chunks:          int32(len(chunks)),
+	}, nil
+}
+
+func validateTranslateArticleRequest(req *pb.TranslateArticleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetArticleId() == "" {
+		violations = append(violations, fieldViolation("article_id", fmt.Errorf("article_id is required")))
+	}
+	if !translationLocalePattern.MatchString(strings.TrimSpace(req.GetLocale())) {
+		violations = append(violations, fieldViolation("locale", fmt.Errorf("locale must be a language tag such as en or en-US")))
+	}
+	return violations
+}
+
+// articleTranslator 逐段调用翻译模式，记录最后使用的模型
+type articleTranslator struct {
+	polisher     ai.TextPolisher
+	locale       string
+	articleTitle string
+	model        string
+}
+
+func (translator *articleTranslator) translate(ctx context.Context, target string, value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+
+	req := ai.PolishRequest{
+		Mode:         ai.ModeTranslate,
+		Target:       target,
+		Text:         value,
+		ArticleTitle: translator.articleTitle,
+		Locale:       translator.locale,
+	}
+	if target == ai.TargetContentSelection {
+		req.Text = util.PlainText(value)
+		req.RichText = value
+		req.InputFormat = "html"
+	}
+
+	result, err := translator.polisher.Polish(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Suggestions) == 0 {
+		return "", ai.ErrMalformedResponse
+	}
+	if result.Model != "" {
+		translator.model = result.Model
+	}
+
+	if target != ai.TargetContentSelection {
+		return util.PlainText(result.Suggestions[0].Content), nil
+	}
+	return result.Suggestions[0].Content, nil
+}
+
+// translateHTML 译文会直接写入草稿正文，只接受通过 HTML 片段校验的结果，失败时重新翻译
+func (translator *articleTranslator) translateHTML(ctx context.Context, chunk string) (string, error) {
+	var lastErr error
+	for range maxTranslationChunkAttempts {
+		translated, err := translator.translate(ctx, ai.TargetContentSelection, chunk)
+		if err != nil {
+			return "", err
+		}
+		if lastErr = ai.ValidateHTMLFragment(translated); lastErr == nil {
+			return translated, nil
+		}
+	}
+	return "", lastErr
+}
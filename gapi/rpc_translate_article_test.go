@@ -0,0 +1,237 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeTranslator struct {
+	requests []ai.PolishRequest
+}
+
+func (translator *fakeTranslator) Polish(ctx context.Context, req ai.PolishRequest) (ai.PolishResponse, error) {
+	translator.requests = append(translator.requests, req)
+	content := "EN:" + req.Text
+	if req.Target == ai.TargetContentSelection {
+		content = strings.ReplaceAll(req.RichText, "段落", "paragraph")
+	}
+	return ai.PolishResponse{
+		Suggestions: []ai.Suggestion{{Content: content}},
+		Model:       "writer-model",
+	}, nil
+}
+
+func TestTranslateArticleCreatesLinkedDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	source := db.GetArticleRow{
+		ID:         util.RandUserID(),
+		Title:      "缓存",
+		Summary:    "摘要",
+		Content:    "<h2>段落一</h2><p>段落二</p><ul><li>段落三</li></ul>",
+		Owner:      util.RandUserID(),
+		CategoryID: 3,
+		Cover:      "/images/go.png",
+	}
+
+	store.EXPECT().GetArticle(gomock.Any(), source.ID).Times(1).Return(source, nil)
+	store.EXPECT().GetArticleTranslation(gomock.Any(), db.GetArticleTranslationParams{
+		SourceArticleID: source.ID,
+		Locale:          "en",
+	}).Times(1).Return(db.ArticleTranslation{}, db.ErrRecordNotFound)
+	store.EXPECT().CreateArticleTranslationTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.CreateArticleTranslationTxParams) (db.CreateArticleTranslationTxResult, error) {
+			require.Equal(t, source.ID, arg.SourceArticleID)
+			require.Equal(t, "EN:缓存", arg.Article.Title)
+			require.Equal(t, "EN:摘要", arg.Article.Summary)
+			require.Equal(t, "<h2>paragraph一</h2><p>paragraph二</p><ul><li>paragraph三</li></ul>", arg.Article.Content)
+			require.False(t, arg.Article.IsPublish)
+			require.Equal(t, source.Owner, arg.Article.Owner)
+			require.Equal(t, source.CategoryID, arg.Article.CategoryID)
+			require.Equal(t, "en", arg.Locale)
+			require.Equal(t, "writer-model", arg.Model)
+			require.True(t, arg.CreatedBy.Valid)
+
+			return db.CreateArticleTranslationTxResult{
+				Article: db.Article{ID: arg.Article.ID, Title: arg.Article.Title, Content: arg.Article.Content},
+				Translation: db.ArticleTranslation{
+					SourceArticleID:     arg.SourceArticleID,
+					TranslatedArticleID: arg.Article.ID,
+					Locale:              arg.Locale,
+					Model:               arg.Model,
+				},
+			}, nil
+		})
+
+	translator := &fakeTranslator{}
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = translator
+	server.config.AIPolishMaxInputChars = 21
+	server.aiConfigs = ai.NewConfigResolver(server.config, server.store)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	resp, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: source.ID.String(),
+		Locale:    "en",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, source.ID.String(), resp.GetSourceArticleId())
+	require.Equal(t, "en", resp.GetLocale())
+	require.Equal(t, int32(3), resp.GetChunks())
+	require.Equal(t, "EN:缓存", resp.GetArticle().GetTitle())
+	require.Len(t, translator.requests, 5)
+	for _, req := range translator.requests {
+		require.Equal(t, ai.ModeTranslate, req.Mode)
+		require.Equal(t, "en", req.Locale)
+		require.LessOrEqual(t, len([]rune(req.RichText)), 21)
+	}
+}
+
+func TestTranslateArticleRejectsExistingTranslation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	source := db.GetArticleRow{ID: util.RandUserID(), Title: "缓存"}
+	store.EXPECT().GetArticle(gomock.Any(), source.ID).Times(1).Return(source, nil)
+	store.EXPECT().GetArticleTranslation(gomock.Any(), gomock.Any()).Times(1).Return(db.ArticleTranslation{Locale: "en"}, nil)
+	store.EXPECT().CreateArticleTranslationTx(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = &fakeTranslator{}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: source.ID.String(),
+		Locale:    "en",
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.AlreadyExists, st.Code())
+}
+
+func TestTranslateArticleRejectsTooManyChunks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	source := db.GetArticleRow{
+		ID:      util.RandUserID(),
+		Title:   "缓存",
+		Content: strings.Repeat("<p>段落段落段落段落</p>", maxTranslationChunks+1),
+	}
+	store.EXPECT().GetArticle(gomock.Any(), source.ID).Times(1).Return(source, nil)
+	store.EXPECT().GetArticleTranslation(gomock.Any(), gomock.Any()).Times(1).Return(db.ArticleTranslation{}, db.ErrRecordNotFound)
+	store.EXPECT().CreateArticleTranslationTx(gomock.Any(), gomock.Any()).Times(0)
+
+	translator := &fakeTranslator{}
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = translator
+	server.config.AIPolishMaxInputChars = 21
+	server.aiConfigs = ai.NewConfigResolver(server.config, server.store)
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: source.ID.String(),
+		Locale:    "en",
+	})
+
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	// 超过上限时不调用模型
+	require.Empty(t, translator.requests)
+}
+
+func TestTranslateArticleRejectsInvalidLocale(t *testing.T) {
+	server := newTestServer(t, nil, nil, nil)
+	server.textPolisher = &fakeTranslator{}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: util.RandUserID().String(),
+		Locale:    "<script>",
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// unsafeTranslator 正文分段返回带脚本的 HTML，模拟模型输出被注入的情况
+type unsafeTranslator struct {
+	contentRequests int
+}
+
+func (translator *unsafeTranslator) Polish(ctx context.Context, req ai.PolishRequest) (ai.PolishResponse, error) {
+	content := "EN:" + req.Text
+	if req.Target == ai.TargetContentSelection {
+		translator.contentRequests++
+		content = `<p>paragraph</p><img src="x" onerror="alert(1)">`
+	}
+	return ai.PolishResponse{Suggestions: []ai.Suggestion{{Content: content}}}, nil
+}
+
+func TestTranslateArticleRejectsUnsafeHTMLChunk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	source := db.GetArticleRow{ID: util.RandUserID(), Title: "缓存", Content: "<p>段落</p>"}
+	store.EXPECT().GetArticle(gomock.Any(), source.ID).Times(1).Return(source, nil)
+	store.EXPECT().GetArticleTranslation(gomock.Any(), gomock.Any()).Times(1).Return(db.ArticleTranslation{}, db.ErrRecordNotFound)
+	store.EXPECT().CreateArticleTranslationTx(gomock.Any(), gomock.Any()).Times(0)
+
+	translator := &unsafeTranslator{}
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = translator
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: source.ID.String(),
+		Locale:    "en",
+	})
+
+	require.Error(t, err)
+	require.NotEqual(t, codes.OK, status.Code(err))
+	require.Equal(t, maxTranslationChunkAttempts, translator.contentRequests)
+}
+
+func TestTranslateArticleNormalizesLocale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store := mockdb.NewMockStore(ctrl)
+
+	source := db.GetArticleRow{ID: util.RandUserID(), Title: "缓存"}
+	store.EXPECT().GetArticle(gomock.Any(), source.ID).Times(1).Return(source, nil)
+	store.EXPECT().GetArticleTranslation(gomock.Any(), db.GetArticleTranslationParams{
+		SourceArticleID: source.ID,
+		Locale:          "en-us",
+	}).Times(1).Return(db.ArticleTranslation{Locale: "en-us"}, nil)
+	store.EXPECT().CreateArticleTranslationTx(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, newGAPITestStore(store), nil, nil)
+	server.textPolisher = &fakeTranslator{}
+	ctx := newContextWithAdminBearerToken(t, server.tokenMaker, time.Minute)
+
+	_, err := server.TranslateArticle(ctx, &pb.TranslateArticleRequest{
+		ArticleId: source.ID.String(),
+		Locale:    " EN-US ",
+	})
+
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
@@ -3,6 +3,7 @@ package gapi
 import (
 	"context"
 
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
 	"github.com/MonitorAllen/nostalgia/pb"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
@@ -18,15 +19,18 @@ func (server *Server) UpdateAIConfig(ctx context.Context, req *pb.UpdateAIConfig
 		return nil, status.Error(codes.FailedPrecondition, "AI config storage is unavailable")
 	}
 
-	cfg, err := server.buildUpdatedAIConfig(ctx, req)
+	cfg, current, err := server.buildUpdatedAIConfig(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	saved, err := server.saveAIConfig(ctx, cfg, pgtype.UUID{Bytes: payload.UserID, Valid: true})
+	author := pgtype.UUID{Bytes: payload.UserID, Valid: true}
+	saved, err := server.saveAIConfig(ctx, cfg, author, func(q db.Querier) error {
+		return recordPromptTemplateVersion(ctx, q, cfg.Purpose, current.PromptTemplates, cfg.PromptTemplates, author)
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to save AI config")
 	}
 
-	return saved.toResponse(), nil
+	return aiConfigResponse(saved), nil
 }
@@ -146,6 +146,15 @@ func (server *Server) UpdateArticle(ctx context.Context, req *pb.UpdateArticleRe
 		return nil, status.Errorf(codes.Internal, "failed to update article: %v", err)
 	}
 
+	// 发布、更新或下线都会改变公开页面；slug 变化时旧地址也一并提交
+	if previousArticle.IsPublish || result.Article.IsPublish {
+		server.distributeIndexNow(ctx,
+			util.ArticlePath(result.Article.ID, result.Article.Slug),
+			util.ArticlePath(previousArticle.ID, previousArticle.Slug),
+		)
+		server.distributeWarmCache(ctx)
+	}
+
 	resp := &pb.UpdateArticleResponse{
 		Article: convertOnlyArticle(result.Article, false),
 	}
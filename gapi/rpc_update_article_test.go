@@ -61,6 +61,8 @@ func TestUpdateArticleInvalidatesDetailAndListCaches(t *testing.T) {
 	redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(int64(0)))).Times(1).Return(int64(1), nil)
 	redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(int64(1)))).Times(1).Return(int64(1), nil)
 	redisCache.EXPECT().Incr(gomock.Any(), gomock.Eq(key.GetArticleListVersionKey(newCategoryID))).Times(1).Return(int64(1), nil)
+	// 公开文章变化后重新预热列表与热门文章
+	taskDistributor.EXPECT().DistributeTaskWarmCacheDefault(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 	store.EXPECT().
 		UpdateArticleTx(gomock.Any(), gomock.Any()).
 		Times(1).
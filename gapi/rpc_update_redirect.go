@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/pb"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateRedirect(ctx context.Context, req *pb.UpdateRedirectRequest) (*pb.UpdateRedirectResponse, error) {
+	_, _, err := server.authorizeAdmin(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateRedirectRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	redirect, err := server.store.UpdateRedirect(ctx, db.UpdateRedirectParams{
+		ID: req.GetId(),
+		TargetPath: pgtype.Text{
+			String: strings.TrimSpace(req.GetTargetPath()),
+			Valid:  req.TargetPath != nil,
+		},
+		StatusCode: pgtype.Int4{
+			Int32: req.GetStatusCode(),
+			Valid: req.StatusCode != nil,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "redirect not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update redirect: %v", err)
+	}
+
+	return &pb.UpdateRedirectResponse{Redirect: convertRedirect(redirect)}, nil
+}
+
+func validateUpdateRedirectRequest(req *pb.UpdateRedirectRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetId() <= 0 {
+		violations = append(violations, fieldViolation("id", errors.New("id must be positive")))
+	}
+	if req.TargetPath != nil {
+		if err := validateRedirectTarget(req.GetTargetPath()); err != nil {
+			violations = append(violations, fieldViolation("target_path", err))
+		}
+	}
+	if req.StatusCode != nil {
+		if err := validateRedirectStatusCode(req.GetStatusCode()); err != nil {
+			violations = append(violations, fieldViolation("status_code", err))
+		}
+	}
+	return violations
+}
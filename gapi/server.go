@@ -19,13 +19,15 @@ type Server struct {
 	store           db.Store
 	tokenMaker      token.Maker
 	taskDistributor worker.TaskDistributor
+	taskInspector   worker.TaskInspector
 	cache           cache.Cache
 	cacheLoadGroup  singleflight.Group
+	aiConfigs       *ai.ConfigResolver
 	textPolisher    ai.TextPolisher
 }
 
 // NewServer creates a new gRPC server
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, cache cache.Cache) (*Server, error) {
+func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector, cache cache.Cache, aiConfigs *ai.ConfigResolver) (*Server, error) {
 	tokenMaker, err := token.NewJWTMaker(config.TokenSymmetricKey)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
@@ -35,7 +37,9 @@ func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDi
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributor: taskDistributor,
+		taskInspector:   taskInspector,
 		cache:           cache,
+		aiConfigs:       aiConfigs,
 	}
 
 	return server, nil
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queueTaskPayloadEncodingBase64 任务载荷不是合法的 UTF-8 时使用的编码
+const queueTaskPayloadEncodingBase64 = "base64"
+
+// queueTaskStates 管理后台可以查看的任务状态，执行中与已完成的任务不在此列
+var queueTaskStates = map[string]asynq.TaskState{
+	asynq.TaskStatePending.String():  asynq.TaskStatePending,
+	asynq.TaskStateRetry.String():    asynq.TaskStateRetry,
+	asynq.TaskStateArchived.String(): asynq.TaskStateArchived,
+}
+
+func (server *Server) checkTaskInspector() error {
+	if server.taskInspector == nil {
+		return status.Error(codes.FailedPrecondition, "task inspector is not configured")
+	}
+	return nil
+}
+
+// taskInspectorError 队列只有在投递过任务后才会存在，不存在时按未找到处理
+func taskInspectorError(action string, err error) error {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound):
+		return status.Error(codes.NotFound, "queue not found")
+	case errors.Is(err, asynq.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func normalizeQueueTaskState(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func validateQueueName(queue string) *errdetails.BadRequest_FieldViolation {
+	if strings.TrimSpace(queue) == "" {
+		return fieldViolation("queue", fmt.Errorf("queue must not be empty"))
+	}
+	return nil
+}
+
+func validateQueueTaskTarget(queue string, taskID string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := validateQueueName(queue); violation != nil {
+		violations = append(violations, violation)
+	}
+	if strings.TrimSpace(taskID) == "" {
+		violations = append(violations, fieldViolation("task_id", fmt.Errorf("task_id must not be empty")))
+	}
+	return violations
+}
@@ -0,0 +1,148 @@
+package ai
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ChunkHTML 按顶层块拆分 HTML，使每段不超过 maxRunes 个字符；
+// 超长块会按子节点拆分并重复外层标签，保证每段仍是结构完整的 HTML fragment
+func ChunkHTML(content string, maxRunes int) ([]string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, nil
+	}
+	if maxRunes <= 0 || utf8.RuneCountInString(content) <= maxRunes {
+		return []string{content}, nil
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pieces []string
+	for _, node := range nodes {
+		nodePieces, err := splitHTMLNode(node, maxRunes)
+		if err != nil {
+			return nil, err
+		}
+		pieces = append(pieces, nodePieces...)
+	}
+	return groupHTMLPieces(pieces, maxRunes), nil
+}
+
+func splitHTMLNode(node *html.Node, maxRunes int) ([]string, error) {
+	rendered, err := renderHTMLNode(node)
+	if err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(rendered) <= maxRunes {
+		return []string{rendered}, nil
+	}
+
+	switch node.Type {
+	case html.TextNode:
+		return splitHTMLText(node.Data, maxRunes), nil
+	case html.ElementNode:
+	default:
+		return []string{rendered}, nil
+	}
+
+	open, closing, err := renderHTMLTags(node)
+	if err != nil {
+		return nil, err
+	}
+	budget := maxRunes - utf8.RuneCountInString(open) - utf8.RuneCountInString(closing)
+	if budget <= 0 || node.FirstChild == nil {
+		return []string{rendered}, nil
+	}
+
+	var children []string
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		childPieces, err := splitHTMLNode(child, budget)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, childPieces...)
+	}
+
+	groups := groupHTMLPieces(children, budget)
+	pieces := make([]string, 0, len(groups))
+	for _, group := range groups {
+		pieces = append(pieces, open+group+closing)
+	}
+	return pieces, nil
+}
+
+func renderHTMLNode(node *html.Node) (string, error) {
+	var builder strings.Builder
+	if err := html.Render(&builder, node); err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+// renderHTMLTags 渲染元素的开始与结束标签（不含子节点）
+func renderHTMLTags(node *html.Node) (string, string, error) {
+	shell := &html.Node{
+		Type:     node.Type,
+		Data:     node.Data,
+		DataAtom: node.DataAtom,
+		Attr:     node.Attr,
+	}
+	rendered, err := renderHTMLNode(shell)
+	if err != nil {
+		return "", "", err
+	}
+	closing := "</" + node.Data + ">"
+	return strings.TrimSuffix(rendered, closing), closing, nil
+}
+
+func splitHTMLText(text string, maxRunes int) []string {
+	var pieces []string
+	var builder strings.Builder
+	size := 0
+	for _, r := range text {
+		escaped := html.EscapeString(string(r))
+		width := utf8.RuneCountInString(escaped)
+		if size > 0 && size+width > maxRunes {
+			pieces = append(pieces, builder.String())
+			builder.Reset()
+			size = 0
+		}
+		builder.WriteString(escaped)
+		size += width
+	}
+	if builder.Len() > 0 {
+		pieces = append(pieces, builder.String())
+	}
+	return pieces
+}
+
+func groupHTMLPieces(pieces []string, maxRunes int) []string {
+	var groups []string
+	var builder strings.Builder
+	size := 0
+	for _, piece := range pieces {
+		width := utf8.RuneCountInString(piece)
+		if size > 0 && size+width > maxRunes {
+			groups = append(groups, builder.String())
+			builder.Reset()
+			size = 0
+		}
+		builder.WriteString(piece)
+		size += width
+	}
+	if strings.TrimSpace(builder.String()) != "" {
+		groups = append(groups, builder.String())
+	}
+	return groups
+}
@@ -0,0 +1,54 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkHTMLKeepsShortContent(t *testing.T) {
+	chunks, err := ChunkHTML("<p>短文</p>", 100)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"<p>短文</p>"}, chunks)
+}
+
+func TestChunkHTMLSplitsAtTopLevelBlocks(t *testing.T) {
+	content := "<h2>标题</h2><p>第一段内容</p><p>第二段内容</p><pre><code>go test ./...</code></pre>"
+
+	chunks, err := ChunkHTML(content, 40)
+
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+	require.Equal(t, content, strings.Join(chunks, ""))
+	for _, chunk := range chunks {
+		require.LessOrEqual(t, utf8.RuneCountInString(chunk), 40)
+	}
+}
+
+func TestChunkHTMLSplitsOversizedList(t *testing.T) {
+	content := `<ul class="steps"><li>第一项说明</li><li>第二项说明</li><li>第三项说明</li></ul>`
+
+	chunks, err := ChunkHTML(content, 40)
+
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+	for _, chunk := range chunks {
+		require.True(t, strings.HasPrefix(chunk, `<ul class="steps">`), chunk)
+		require.True(t, strings.HasSuffix(chunk, "</ul>"), chunk)
+		require.LessOrEqual(t, utf8.RuneCountInString(chunk), 40)
+	}
+}
+
+func TestChunkHTMLSplitsOversizedText(t *testing.T) {
+	chunks, err := ChunkHTML("<p>"+strings.Repeat("长", 50)+"</p>", 20)
+
+	require.NoError(t, err)
+	require.Len(t, chunks, 4)
+	for _, chunk := range chunks {
+		require.True(t, strings.HasPrefix(chunk, "<p>"), chunk)
+		require.LessOrEqual(t, utf8.RuneCountInString(chunk), 20)
+	}
+}
@@ -0,0 +1,294 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/secrets"
+	"github.com/MonitorAllen/nostalgia/util"
+)
+
+const (
+	PurposePolish           = "polish"
+	PurposeMetadata         = "metadata"
+	PurposeModeration       = "moderation"
+	PurposeTranslation      = "translation"
+	PurposeAutomationReview = "automation_review"
+
+	ConfigSourceEnv = "runtime_env"
+	ConfigSourceDB  = "database"
+
+	polishAPIKeyAAD = "nostalgia:ai-polish-api-key"
+)
+
+// configStorageKeys 各用途在 ai_provider_configs.purpose 中的存储键
+var configStorageKeys = map[string]string{
+	PurposePolish:           "ai_polish",
+	PurposeMetadata:         "ai_metadata",
+	PurposeModeration:       "ai_moderation",
+	PurposeTranslation:      "ai_translation",
+	PurposeAutomationReview: "ai_automation_review",
+}
+
+var ErrUnsupportedPurpose = errors.New("unsupported AI config purpose")
+
+// NormalizeConfigPurpose 解析用途名称，空值视为润色，兼容 automation-review 与 ai_ 前缀写法
+func NormalizeConfigPurpose(value string) (string, error) {
+	purpose := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(value)), "-", "_")
+	purpose = strings.TrimPrefix(purpose, "ai_")
+	if purpose == "" {
+		return PurposePolish, nil
+	}
+	if _, ok := configStorageKeys[purpose]; !ok {
+		return "", ErrUnsupportedPurpose
+	}
+	return purpose, nil
+}
+
+// ConfigStorageKey 返回用途在数据库中的存储键
+func ConfigStorageKey(purpose string) string {
+	return configStorageKeys[purpose]
+}
+
+// ConfigAPIKeyAAD 返回加密 API Key 时绑定的附加数据，不同用途的密文不能互换
+func ConfigAPIKeyAAD(purpose string) string {
+	if purpose == PurposePolish {
+		return polishAPIKeyAAD
+	}
+	return "nostalgia:ai-" + strings.ReplaceAll(purpose, "_", "-") + "-api-key"
+}
+
+// ResolvedConfig 某个用途最终生效的 AI 配置
+type ResolvedConfig struct {
+	Provider         string
+	APIProtocol      string
+	BaseURL          string
+	Model            string
+	APIKey           string
+	Timeout          time.Duration
+	MaxInputChars    int
+	MaxContextChars  int
+	MaxSuggestions   int
+	PromptTemplates  map[string]string
+	EnabledRequested bool
+	Source           string
+	Purpose          string
+	// Inherited 该用途未单独配置，沿用润色配置
+	Inherited bool
+}
+
+func (cfg ResolvedConfig) APIKeyConfigured() bool {
+	return strings.TrimSpace(cfg.APIKey) != ""
+}
+
+// Usable 已启用且必填项齐全
+func (cfg ResolvedConfig) Usable() bool {
+	return cfg.EnabledRequested &&
+		strings.TrimSpace(cfg.Provider) != "" &&
+		strings.TrimSpace(cfg.APIProtocol) != "" &&
+		strings.TrimSpace(cfg.BaseURL) != "" &&
+		strings.TrimSpace(cfg.Model) != "" &&
+		cfg.APIKeyConfigured()
+}
+
+// ConfigStore 读取后台保存的 AI 配置
+type ConfigStore interface {
+	GetAIProviderConfig(ctx context.Context, purpose string) (db.AiProviderConfig, error)
+}
+
+// ConfigResolver 按用途解析 AI 配置：优先使用后台保存的配置，其次沿用润色配置，最后使用环境变量
+type ConfigResolver struct {
+	config util.Config
+	store  ConfigStore
+}
+
+// NewConfigResolver store 为 nil 时只使用环境变量中的配置
+func NewConfigResolver(config util.Config, store ConfigStore) *ConfigResolver {
+	return &ConfigResolver{
+		config: config,
+		store:  store,
+	}
+}
+
+// Resolve 读取指定用途的配置，未单独配置时沿用润色配置
+func (resolver *ConfigResolver) Resolve(ctx context.Context, purpose string) (ResolvedConfig, error) {
+	if purpose == PurposePolish {
+		return resolver.resolvePolish(ctx)
+	}
+
+	if resolver.store != nil {
+		row, err := resolver.store.GetAIProviderConfig(ctx, ConfigStorageKey(purpose))
+		if err == nil {
+			return resolver.FromRow(row), nil
+		}
+		if !errors.Is(err, db.ErrRecordNotFound) {
+			return ResolvedConfig{}, err
+		}
+	}
+
+	cfg, err := resolver.resolvePolish(ctx)
+	if err != nil {
+		return ResolvedConfig{}, err
+	}
+	cfg.Purpose = purpose
+	cfg.Inherited = true
+	return cfg, nil
+}
+
+func (resolver *ConfigResolver) resolvePolish(ctx context.Context) (ResolvedConfig, error) {
+	if resolver.store == nil {
+		return resolver.Runtime(), nil
+	}
+
+	row, err := resolver.store.GetAIProviderConfig(ctx, ConfigStorageKey(PurposePolish))
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return resolver.Runtime(), nil
+	}
+	if err != nil {
+		return ResolvedConfig{}, err
+	}
+
+	return resolver.FromRow(row), nil
+}
+
+// Runtime 返回环境变量中的润色配置
+func (resolver *ConfigResolver) Runtime() ResolvedConfig {
+	config := resolver.config
+	return ResolvedConfig{
+		Provider:         strings.TrimSpace(config.AIPolishProvider),
+		APIProtocol:      NormalizeAPIProtocol(config.AIPolishAPIProtocol),
+		BaseURL:          strings.TrimSpace(config.AIPolishBaseURL),
+		Model:            strings.TrimSpace(config.AIPolishModel),
+		APIKey:           strings.TrimSpace(config.AIPolishAPIKey),
+		Timeout:          normalizedDuration(config.AIPolishTimeout, 60*time.Second),
+		MaxInputChars:    NormalizedPositive(config.AIPolishMaxInputChars, 6000),
+		MaxContextChars:  NormalizedNonNegative(config.AIPolishMaxContextChars, 4000),
+		MaxSuggestions:   NormalizedPositive(config.AIPolishMaxSuggestions, 3),
+		PromptTemplates:  DefaultPromptTemplates(),
+		EnabledRequested: true,
+		Source:           ConfigSourceEnv,
+		Purpose:          PurposePolish,
+	}
+}
+
+// FromRow 解密数据库中的配置，API Key 无法解密时视为未配置
+func (resolver *ConfigResolver) FromRow(row db.AiProviderConfig) ResolvedConfig {
+	purpose, err := NormalizeConfigPurpose(row.Purpose)
+	if err != nil {
+		purpose = PurposePolish
+	}
+	apiKey, err := secrets.DecryptString(row.ApiKeyCiphertext, resolver.config.TokenSymmetricKey, ConfigAPIKeyAAD(purpose))
+	if err != nil {
+		apiKey = ""
+	}
+
+	return ResolvedConfig{
+		Provider:         strings.TrimSpace(row.Provider),
+		APIProtocol:      NormalizeAPIProtocol(row.ApiProtocol),
+		BaseURL:          strings.TrimSpace(row.BaseUrl),
+		Model:            strings.TrimSpace(row.Model),
+		APIKey:           strings.TrimSpace(apiKey),
+		Timeout:          normalizedDuration(time.Duration(row.TimeoutMs)*time.Millisecond, 30*time.Second),
+		MaxInputChars:    NormalizedPositive(int(row.MaxInputChars), 6000),
+		MaxContextChars:  NormalizedNonNegative(int(row.MaxContextChars), 4000),
+		MaxSuggestions:   NormalizedPositive(int(row.MaxSuggestions), 3),
+		PromptTemplates:  DecodePromptTemplates(row.PromptTemplates),
+		EnabledRequested: row.Enabled,
+		Source:           ConfigSourceDB,
+		Purpose:          purpose,
+	}
+}
+
+// ServiceConfig 转换为调用模型所需的配置
+func (resolver *ConfigResolver) ServiceConfig(cfg ResolvedConfig) ServiceConfig {
+	return ServiceConfig{
+		Provider:         cfg.Provider,
+		APIProtocol:      cfg.APIProtocol,
+		BaseURL:          cfg.BaseURL,
+		APIKey:           cfg.APIKey,
+		Model:            cfg.Model,
+		Timeout:          cfg.Timeout,
+		MaxInputChars:    cfg.MaxInputChars,
+		MaxContextChars:  cfg.MaxContextChars,
+		MaxSuggestions:   cfg.MaxSuggestions,
+		PromptTemplates:  cfg.PromptTemplates,
+		HTTPProxyAddress: resolver.config.HTTPProxyAddr,
+	}
+}
+
+// TextPolisher 按用途构建 AI 服务，配置不可用时返回 ErrDisabled
+func (resolver *ConfigResolver) TextPolisher(ctx context.Context, purpose string) (TextPolisher, error) {
+	purpose, err := NormalizeConfigPurpose(purpose)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := resolver.Resolve(ctx, purpose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI config: %w", err)
+	}
+	if !cfg.Usable() {
+		return nil, ErrDisabled
+	}
+	return NewPolishService(resolver.ServiceConfig(cfg), nil), nil
+}
+
+// DecodePromptTemplates 解析保存的提示词模板，缺失或损坏时使用默认模板
+func DecodePromptTemplates(raw []byte) map[string]string {
+	if len(raw) == 0 {
+		return DefaultPromptTemplates()
+	}
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return DefaultPromptTemplates()
+	}
+	return NormalizePromptTemplates(values)
+}
+
+// NormalizeAPIProtocol 未知协议按 chat/completions 处理
+func NormalizeAPIProtocol(value string) string {
+	switch strings.Trim(strings.TrimSpace(value), "/") {
+	case APIProtocolResponses:
+		return APIProtocolResponses
+	case APIProtocolMessages:
+		return APIProtocolMessages
+	default:
+		return APIProtocolChatCompletions
+	}
+}
+
+func IsSupportedAPIProtocol(value string) bool {
+	switch value {
+	case APIProtocolChatCompletions, APIProtocolResponses, APIProtocolMessages:
+		return true
+	default:
+		return false
+	}
+}
+
+func normalizedDuration(value time.Duration, fallback time.Duration) time.Duration {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// NormalizedPositive 配置值不大于 0 时使用默认值
+func NormalizedPositive(value int, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// NormalizedNonNegative 配置值小于 0 时使用默认值，允许为 0
+func NormalizedNonNegative(value int, fallback int) int {
+	if value < 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+package ai
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/secrets"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeConfigStore struct {
+	rows map[string]db.AiProviderConfig
+}
+
+func (store *fakeConfigStore) GetAIProviderConfig(ctx context.Context, purpose string) (db.AiProviderConfig, error) {
+	row, ok := store.rows[purpose]
+	if !ok {
+		return db.AiProviderConfig{}, db.ErrRecordNotFound
+	}
+	return row, nil
+}
+
+func TestConfigResolverInheritsPolishConfig(t *testing.T) {
+	config := util.Config{TokenSymmetricKey: util.RandomString(32)}
+	ciphertext, err := secrets.EncryptString("db-secret", config.TokenSymmetricKey, ConfigAPIKeyAAD(PurposePolish))
+	require.NoError(t, err)
+
+	store := &fakeConfigStore{rows: map[string]db.AiProviderConfig{
+		"ai_polish": {
+			Purpose:          "ai_polish",
+			Provider:         "openai",
+			ApiProtocol:      APIProtocolChatCompletions,
+			BaseUrl:          "https://ai.example.com/v1",
+			Model:            "writer-model",
+			ApiKeyCiphertext: ciphertext,
+			Enabled:          true,
+		},
+	}}
+	resolver := NewConfigResolver(config, store)
+
+	cfg, err := resolver.Resolve(context.Background(), PurposeModeration)
+	require.NoError(t, err)
+	require.True(t, cfg.Inherited)
+	require.Equal(t, PurposeModeration, cfg.Purpose)
+	require.Equal(t, ConfigSourceDB, cfg.Source)
+	require.Equal(t, "db-secret", cfg.APIKey)
+	require.True(t, cfg.Usable())
+
+	polisher, err := resolver.TextPolisher(context.Background(), "ai-moderation")
+	require.NoError(t, err)
+	require.NotNil(t, polisher)
+}
+
+func TestConfigResolverTextPolisherRequiresUsableConfig(t *testing.T) {
+	resolver := NewConfigResolver(util.Config{}, nil)
+
+	_, err := resolver.TextPolisher(context.Background(), PurposeModeration)
+	require.ErrorIs(t, err, ErrDisabled)
+
+	_, err = resolver.TextPolisher(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrUnsupportedPurpose)
+}
@@ -2,8 +2,12 @@ package ai
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+
+	"golang.org/x/net/html"
 )
 
 type parseSuggestionsEnvelope struct {
@@ -76,3 +80,86 @@ func normalizeSuggestions(values []Suggestion, max int) []Suggestion {
 	}
 	return suggestions
 }
+
+// allowedFragmentTags CKEditor 可以直接接收的标签白名单
+var allowedFragmentTags = map[string]bool{
+	"p": true, "br": true, "hr": true, "span": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "blockquote": true, "pre": true, "code": true,
+	"strong": true, "b": true, "em": true, "i": true, "u": true, "s": true,
+	"a": true, "img": true, "figure": true, "figcaption": true,
+	"table": true, "thead": true, "tbody": true, "tr": true, "th": true, "td": true,
+}
+
+// ValidateHTMLFragment 校验生成内容是否为可直接插入 CKEditor 的安全 HTML 片段
+func ValidateHTMLFragment(content string) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return fmt.Errorf("%w: empty html fragment", ErrMalformedResponse)
+	}
+	if strings.Contains(content, "```") {
+		return fmt.Errorf("%w: markdown fence in html fragment", ErrMalformedResponse)
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			if errors.Is(tokenizer.Err(), io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("%w: %v", ErrMalformedResponse, tokenizer.Err())
+		case html.DoctypeToken:
+			return fmt.Errorf("%w: html document is not a fragment", ErrMalformedResponse)
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			token := tokenizer.Token()
+			if !allowedFragmentTags[token.Data] {
+				return fmt.Errorf("%w: tag <%s> is not allowed", ErrMalformedResponse, token.Data)
+			}
+			for _, attr := range token.Attr {
+				if err := validateFragmentAttribute(attr); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func validateFragmentAttribute(attr html.Attribute) error {
+	name := strings.ToLower(attr.Key)
+	if strings.HasPrefix(name, "on") || name == "style" {
+		return fmt.Errorf("%w: attribute %s is not allowed", ErrMalformedResponse, name)
+	}
+	if name == "href" || name == "src" {
+		value := strings.ToLower(strings.Join(strings.Fields(attr.Val), ""))
+		for _, scheme := range []string{"javascript:", "vbscript:", "data:"} {
+			if strings.HasPrefix(value, scheme) {
+				return fmt.Errorf("%w: %s scheme is not allowed", ErrMalformedResponse, strings.TrimSuffix(scheme, ":"))
+			}
+		}
+	}
+	return nil
+}
+
+// FilterHTMLFragmentSuggestions 丢弃未通过 HTML 片段校验的候选，全部不合格时返回首个错误
+func FilterHTMLFragmentSuggestions(suggestions []Suggestion) ([]Suggestion, error) {
+	valid := make([]Suggestion, 0, len(suggestions))
+	var firstErr error
+	for _, suggestion := range suggestions {
+		if err := ValidateHTMLFragment(suggestion.Content); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		valid = append(valid, suggestion)
+	}
+	if len(valid) == 0 {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("%w: no html fragment suggestions", ErrMalformedResponse)
+		}
+		return nil, firstErr
+	}
+	return valid, nil
+}
@@ -57,3 +57,33 @@ func TestParseSuggestionsRejectsEmptyOutput(t *testing.T) {
 
 	require.ErrorIs(t, err, ErrMalformedResponse)
 }
+
+func TestValidateHTMLFragment(t *testing.T) {
+	require.NoError(t, ValidateHTMLFragment(`<h2>缓存策略</h2><ul><li>读穿透</li></ul><p>见 <a href="https://go.dev">文档</a></p>`))
+	require.NoError(t, ValidateHTMLFragment(`<pre><code>if a < b {}</code></pre>`))
+
+	for _, content := range []string{
+		"",
+		"<!DOCTYPE html><html><body><p>x</p></body></html>",
+		"<p>x</p><script>alert(1)</script>",
+		`<p onclick="alert(1)">x</p>`,
+		`<p style="color:red">x</p>`,
+		`<a href=" javascript:alert(1)">x</a>`,
+		`<img src="data:image/png;base64,AAAA">`,
+		"```html\n<p>x</p>\n```",
+	} {
+		require.ErrorIs(t, ValidateHTMLFragment(content), ErrMalformedResponse, content)
+	}
+}
+
+func TestFilterHTMLFragmentSuggestions(t *testing.T) {
+	suggestions, err := FilterHTMLFragmentSuggestions([]Suggestion{
+		{Content: "<iframe src=\"https://x\"></iframe>"},
+		{Content: "<p>续写内容</p>", Reason: "衔接自然"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Suggestion{{Content: "<p>续写内容</p>", Reason: "衔接自然"}}, suggestions)
+
+	_, err = FilterHTMLFragmentSuggestions([]Suggestion{{Content: "<style>p{}</style>"}})
+	require.ErrorIs(t, err, ErrMalformedResponse)
+}
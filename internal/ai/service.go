@@ -2,6 +2,8 @@ package ai
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 )
@@ -33,28 +35,12 @@ func (service *PolishService) Polish(ctx context.Context, req PolishRequest) (Po
 		return PolishResponse{}, err
 	}
 
-	req.ArticleTitle = limitRunes(req.ArticleTitle, service.config.MaxContextChars)
-	req.ArticleSummary = limitRunes(req.ArticleSummary, service.config.MaxContextChars)
-	req.ArticleExcerpt = limitRunes(req.ArticleExcerpt, service.config.MaxContextChars)
-	req.RichText = limitRunes(req.RichText, service.config.MaxInputChars)
-
 	adapter, err := service.factory(service.config)
 	if err != nil {
 		return PolishResponse{}, err
 	}
 
-	prompt := RenderPromptTemplate(service.config.PromptTemplates[req.Mode], PromptRenderData{
-		Mode:           req.Mode,
-		Target:         req.Target,
-		Text:           req.Text,
-		RichText:       req.RichText,
-		InputFormat:    req.InputFormat,
-		ArticleTitle:   req.ArticleTitle,
-		ArticleSummary: req.ArticleSummary,
-		ArticleExcerpt: req.ArticleExcerpt,
-		Locale:         req.Locale,
-		MaxSuggestions: service.config.MaxSuggestions,
-	})
+	prompt := RenderPromptTemplate(service.config.PromptTemplates[req.Mode], promptRenderData(req, service.config))
 
 	generated, err := adapter.Generate(ctx, GenerateRequest{
 		Protocol: service.config.APIProtocol,
@@ -69,6 +55,12 @@ func (service *PolishService) Polish(ctx context.Context, req PolishRequest) (Po
 	if err != nil {
 		return PolishResponse{}, err
 	}
+	if htmlFragmentModes[req.Mode] {
+		suggestions, err = FilterHTMLFragmentSuggestions(suggestions)
+		if err != nil {
+			return PolishResponse{}, err
+		}
+	}
 
 	return PolishResponse{
 		Suggestions: suggestions,
@@ -78,6 +70,56 @@ func (service *PolishService) Polish(ctx context.Context, req PolishRequest) (Po
 	}, nil
 }
 
+// PromptPreview 模板试渲染结果
+type PromptPreview struct {
+	Prompt              string
+	UnknownPlaceholders []string
+}
+
+// PreviewPrompt 按与 Polish 相同的截断规则渲染模板但不调用模型；template 为空时使用配置中的模板
+func PreviewPrompt(config ServiceConfig, req PolishRequest, template string) PromptPreview {
+	req = req.normalized()
+	if strings.TrimSpace(template) == "" {
+		template = NormalizePromptTemplates(config.PromptTemplates)[req.Mode]
+	}
+	return PromptPreview{
+		Prompt:              RenderPromptTemplate(template, promptRenderData(req, config)),
+		UnknownPlaceholders: UnknownPlaceholders(template),
+	}
+}
+
+// PolishCacheKey 按渲染后的提示词、模型与协议计算请求摘要，用于缓存相同的生成结果
+func PolishCacheKey(config ServiceConfig, req PolishRequest) string {
+	req = req.normalized()
+	prompt := RenderPromptTemplate(NormalizePromptTemplates(config.PromptTemplates)[req.Mode], promptRenderData(req, config))
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		normalizeProviderAPIProtocol(config.APIProtocol),
+		strings.TrimSpace(config.Model),
+		prompt,
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func promptRenderData(req PolishRequest, config ServiceConfig) PromptRenderData {
+	excerpt := limitRunes(req.ArticleExcerpt, config.MaxContextChars)
+	if req.Mode == ModeContinue {
+		excerpt = limitTailRunes(req.ArticleExcerpt, config.MaxContextChars)
+	}
+	return PromptRenderData{
+		Mode:           req.Mode,
+		Target:         req.Target,
+		Text:           req.Text,
+		RichText:       limitRunes(req.RichText, config.MaxInputChars),
+		InputFormat:    req.InputFormat,
+		ArticleTitle:   limitRunes(req.ArticleTitle, config.MaxContextChars),
+		ArticleSummary: limitRunes(req.ArticleSummary, config.MaxContextChars),
+		ArticleExcerpt: excerpt,
+		Locale:         req.Locale,
+		Categories:     req.Categories,
+		MaxSuggestions: config.MaxSuggestions,
+	}
+}
+
 func (service *PolishService) disabled() bool {
 	return service.config.BaseURL == "" || service.config.APIKey == "" || service.config.Model == ""
 }
@@ -175,3 +175,109 @@ func TestPolishServiceValidatesInput(t *testing.T) {
 
 	require.ErrorIs(t, err, ErrInvalidInput)
 }
+
+func TestValidateRequestMetadataModes(t *testing.T) {
+	require.NoError(t, ValidateRequest(PolishRequest{Mode: ModeSlugCandidates, Target: TargetSlug}, 6000))
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeSlugCandidates, Target: TargetTitle}, 6000), ErrInvalidInput)
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeCategoryCandidates, Target: TargetCategory}, 6000), ErrInvalidInput)
+	require.NoError(t, ValidateRequest(PolishRequest{
+		Mode:       ModeCategoryCandidates,
+		Target:     TargetCategory,
+		Categories: []string{"后端"},
+	}, 6000))
+}
+
+func TestValidateRequestCommentModeration(t *testing.T) {
+	require.NoError(t, ValidateRequest(PolishRequest{Mode: ModeCommentModeration, Target: TargetComment, Text: "写得不错"}, 6000))
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeCommentModeration, Target: TargetComment}, 6000), ErrInvalidInput)
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeCommentModeration, Target: TargetTitle, Text: "hi"}, 6000), ErrInvalidInput)
+}
+
+func TestPolishCacheKeyDependsOnPromptModelAndProtocol(t *testing.T) {
+	config := ServiceConfig{
+		APIProtocol:     APIProtocolChatCompletions,
+		Model:           "writer-model",
+		MaxInputChars:   6000,
+		MaxSuggestions:  3,
+		PromptTemplates: map[string]string{ModeImprove: "improve {{text}}"},
+	}
+	req := PolishRequest{Mode: ModeImprove, Target: TargetContentSelection, Text: "原文"}
+
+	key := PolishCacheKey(config, req)
+	require.Len(t, key, 64)
+	require.Equal(t, key, PolishCacheKey(config, PolishRequest{Mode: ModeImprove, Target: TargetContentSelection, Text: " 原文 "}))
+
+	otherText := req
+	otherText.Text = "另一段"
+	require.NotEqual(t, key, PolishCacheKey(config, otherText))
+
+	otherModel := config
+	otherModel.Model = "other-model"
+	require.NotEqual(t, key, PolishCacheKey(otherModel, req))
+
+	otherProtocol := config
+	otherProtocol.APIProtocol = APIProtocolResponses
+	require.NotEqual(t, key, PolishCacheKey(otherProtocol, req))
+}
+
+func TestPolishServiceContinueUsesTailExcerptAndValidatesHTML(t *testing.T) {
+	adapter := &fakeProviderAdapter{output: `{"suggestions":[{"content":"<script>x</script>"},{"content":"<p>接着写</p>"}]}`}
+	service := NewPolishService(ServiceConfig{
+		Provider:        "openai",
+		APIProtocol:     APIProtocolChatCompletions,
+		BaseURL:         "https://ai.example.com/v1",
+		APIKey:          "secret-key",
+		Model:           "writer-model",
+		MaxInputChars:   6000,
+		MaxContextChars: 4,
+		MaxSuggestions:  3,
+		PromptTemplates: map[string]string{ModeContinue: "excerpt={{article_excerpt}}"},
+	}, func(ServiceConfig) (ProviderAdapter, error) {
+		return adapter, nil
+	})
+
+	resp, err := service.Polish(context.Background(), PolishRequest{
+		Mode:           ModeContinue,
+		Target:         TargetContentSelection,
+		ArticleExcerpt: "前文很长光标前",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "excerpt=长光标前", adapter.request.Prompt)
+	require.Equal(t, []Suggestion{{Content: "<p>接着写</p>"}}, resp.Suggestions)
+}
+
+func TestPolishServiceRejectsUnsafeOutline(t *testing.T) {
+	adapter := &fakeProviderAdapter{output: `{"suggestions":[{"content":"<html><body><h2>大纲</h2></body></html>"}]}`}
+	service := NewPolishService(ServiceConfig{
+		Provider:       "openai",
+		APIProtocol:    APIProtocolChatCompletions,
+		BaseURL:        "https://ai.example.com/v1",
+		APIKey:         "secret-key",
+		Model:          "writer-model",
+		MaxInputChars:  6000,
+		MaxSuggestions: 3,
+	}, func(ServiceConfig) (ProviderAdapter, error) {
+		return adapter, nil
+	})
+
+	_, err := service.Polish(context.Background(), PolishRequest{
+		Mode:         ModeOutline,
+		Target:       TargetContentSelection,
+		ArticleTitle: "Redis 缓存实践",
+		Text:         "穿透、雪崩、击穿",
+	})
+
+	require.ErrorIs(t, err, ErrMalformedResponse)
+	require.Contains(t, adapter.request.Prompt, "Redis 缓存实践")
+	require.Contains(t, adapter.request.Prompt, "穿透、雪崩、击穿")
+}
+
+func TestValidateRequestWritingModes(t *testing.T) {
+	require.NoError(t, ValidateRequest(PolishRequest{Mode: ModeOutline, Target: TargetContentSelection, ArticleTitle: "标题"}, 6000))
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeOutline, Target: TargetContentSelection}, 6000), ErrInvalidInput)
+	require.NoError(t, ValidateRequest(PolishRequest{Mode: ModeContinue, Target: TargetContentSelection, ArticleExcerpt: "前文"}, 6000))
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeContinue, Target: TargetContentSelection}, 6000), ErrInvalidInput)
+	require.NoError(t, ValidateRequest(PolishRequest{Mode: ModeSectionDraft, Target: TargetContentSelection, Text: "缓存击穿"}, 6000))
+	require.ErrorIs(t, ValidateRequest(PolishRequest{Mode: ModeSectionDraft, Target: TargetTitle, Text: "缓存击穿"}, 6000), ErrInvalidInput)
+}
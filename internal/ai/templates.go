@@ -1,6 +1,7 @@
 package ai
 
 import (
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,6 +17,7 @@ type PromptRenderData struct {
 	ArticleSummary string
 	ArticleExcerpt string
 	Locale         string
+	Categories     []string
 	MaxSuggestions int
 }
 
@@ -86,11 +88,113 @@ article_title={{article_title}}
 article_summary={{article_summary}}
 article_excerpt:
 {{article_excerpt}}`,
+		ModeSlugCandidates: `请基于文章上下文生成 URL 短标识（slug）候选。最多 {{max_suggestions}} 个。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。slug 候选 content 必须是纯文本，不要包含 HTML 或 Markdown，只能使用小写英文字母、数字和单个连字符，长度 5-100，中文标题请意译为英文单词。
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt:
+{{article_excerpt}}`,
+		ModeSEODescriptionCandidates: `请基于文章上下文生成 SEO 描述（meta description）候选。最多 {{max_suggestions}} 个。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。描述候选 content 必须是纯文本，不要包含 HTML 或 Markdown，长度控制在 160 字以内，准确概括文章并包含核心关键词。
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt:
+{{article_excerpt}}`,
+		ModeTagCandidates: `请基于文章上下文生成文章标签候选。最多 {{max_suggestions}} 个。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。每个候选只包含一个标签，content 必须是纯文本，不要包含 HTML 或 Markdown，不要带 # 号，尽量简短。
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt:
+{{article_excerpt}}`,
+		ModeCoverAltCandidates: `请基于文章上下文为文章封面图生成替代文本（alt）候选。最多 {{max_suggestions}} 个。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。替代文本候选 content 必须是纯文本，不要包含 HTML 或 Markdown，长度控制在 125 字以内，描述封面应传达的主题。
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt:
+{{article_excerpt}}`,
+		ModeCategoryCandidates: `请基于文章上下文，从已有分类中选择最合适的分类候选。最多 {{max_suggestions}} 个。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。分类候选 content 必须是纯文本，不要包含 HTML 或 Markdown，且必须与下列已有分类名称完全一致，不要创造新分类。
+categories:
+{{categories}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt:
+{{article_excerpt}}`,
+		ModeTranslate: `你是专业技术文章译者，请把以下内容翻译为 {{locale}}。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}，只需要 1 个候选。
+
+【翻译输出硬性要求】
+- 当 target=content_selection 时，suggestions[].content 必须是可直接插入 CKEditor 的 HTML fragment 字符串，且必须以 rich_text 作为主要输入。
+- 逐段忠实翻译，不要增删信息，不要总结或改写观点。
+- 完整保留原有 HTML 结构、标签顺序和属性，包括 h1-h6、ul、ol、li、blockquote、table、pre、code、strong、em、u、a、img、figure，不要扁平化。
+- pre、code 中的代码、链接地址、图片地址和专有名词保持原样，只翻译自然语言文本。
+- 当 target=title 或 target=summary 时，content 必须是纯文本，不要包含 HTML 或 Markdown。
+- 不要返回完整 HTML 文档，不要返回 Markdown 代码围栏。
+
+target={{target}}
+locale={{locale}}
+article_title={{article_title}}
+input_format={{input_format}}
+plain_text:
+{{text}}
+rich_text:
+{{rich_text}}`,
+		ModeCommentModeration: `你是博客评论审核员，请判断以下读者评论是否为垃圾信息或恶意内容。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}，只需要 1 个候选。
+content 只能是 ok、spam、toxic 之一：广告推广、引流链接、无意义灌水为 spam；辱骂、人身攻击、歧视、骚扰为 toxic；其余正常评论（包括批评意见）为 ok。
+reason 用一句话说明判断依据。
+comment:
+{{text}}`,
+		ModeOutline: `你是专业技术博客作者，请根据文章标题与作者笔记生成文章大纲。最多 {{max_suggestions}} 个候选。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。
+
+【HTML 片段硬性要求】
+- suggestions[].content 必须是可直接插入 CKEditor 的 HTML fragment 字符串，不要返回完整 HTML 文档，不要返回 Markdown 或代码围栏。
+- 只能使用 p、h2、h3、h4、ul、ol、li、blockquote、pre、code、strong、em、u、a、table、thead、tbody、tr、th、td、br 标签。
+- 不要包含 script、style、iframe 等标签，不要使用 on* 事件属性、style 属性或 javascript: 链接。
+- 大纲使用 h2/h3 表示章节层级，每个章节下用 ul/li 列出 2-4 个要点，不要展开成完整正文。
+
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+notes:
+{{text}}`,
+		ModeContinue: `你是专业技术博客作者，请从光标位置自然续写文章。最多 {{max_suggestions}} 个候选。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。
+
+【HTML 片段硬性要求】
+- suggestions[].content 必须是可直接插入 CKEditor 的 HTML fragment 字符串，不要返回完整 HTML 文档，不要返回 Markdown 或代码围栏。
+- 只能使用 p、h2、h3、h4、ul、ol、li、blockquote、pre、code、strong、em、u、a、table、thead、tbody、tr、th、td、br 标签。
+- 不要包含 script、style、iframe 等标签，不要使用 on* 事件属性、style 属性或 javascript: 链接。
+- 续写内容必须紧接 article_excerpt 的末尾，保持原有语气、术语和论证思路，不要重复已有内容，不要总结全文。
+- 续写长度控制在 1-3 个段落。
+
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+article_excerpt（光标前的内容）:
+{{article_excerpt}}
+writing_hint:
+{{text}}`,
+		ModeSectionDraft: `你是专业技术博客作者，请为文章中的指定章节撰写初稿。最多 {{max_suggestions}} 个候选。必须只返回 JSON：{"suggestions":[{"content":"...","reason":"..."}]}。
+
+【HTML 片段硬性要求】
+- suggestions[].content 必须是可直接插入 CKEditor 的 HTML fragment 字符串，不要返回完整 HTML 文档，不要返回 Markdown 或代码围栏。
+- 只能使用 p、h2、h3、h4、ul、ol、li、blockquote、pre、code、strong、em、u、a、table、thead、tbody、tr、th、td、br 标签。
+- 不要包含 script、style、iframe 等标签，不要使用 on* 事件属性、style 属性或 javascript: 链接。
+- 只撰写 section 指定的这一节，以该节的 h2 或 h3 标题开头，不要撰写其他章节。
+- 结合 outline 中的上下文保持前后衔接，代码示例放在 pre/code 中。
+
+locale={{locale}}
+article_title={{article_title}}
+article_summary={{article_summary}}
+section:
+{{text}}
+outline:
+{{rich_text}}`,
 	}
 }
 
 func PromptTemplateKeys() []string {
-	keys := []string{ModeImprove, ModeShorten, ModeExpand, ModeTitleCandidates, ModeSummaryCandidates}
+	keys := []string{
+		ModeImprove, ModeShorten, ModeExpand, ModeTitleCandidates, ModeSummaryCandidates,
+		ModeSlugCandidates, ModeSEODescriptionCandidates, ModeTagCandidates, ModeCoverAltCandidates, ModeCategoryCandidates,
+		ModeTranslate, ModeCommentModeration, ModeOutline, ModeContinue, ModeSectionDraft,
+	}
 	sort.Strings(keys)
 	return keys
 }
@@ -107,8 +211,10 @@ func NormalizePromptTemplates(values map[string]string) map[string]string {
 	return normalized
 }
 
-func RenderPromptTemplate(template string, data PromptRenderData) string {
-	replacements := map[string]string{
+var promptPlaceholderPattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+func promptReplacements(data PromptRenderData) map[string]string {
+	return map[string]string{
 		"{{mode}}":            data.Mode,
 		"{{target}}":          data.Target,
 		"{{text}}":            data.Text,
@@ -118,11 +224,41 @@ func RenderPromptTemplate(template string, data PromptRenderData) string {
 		"{{article_summary}}": data.ArticleSummary,
 		"{{article_excerpt}}": data.ArticleExcerpt,
 		"{{locale}}":          data.Locale,
+		"{{categories}}":      strings.Join(data.Categories, "\n"),
 		"{{max_suggestions}}": strconv.Itoa(data.MaxSuggestions),
 	}
+}
+
+func RenderPromptTemplate(template string, data PromptRenderData) string {
 	rendered := template
-	for token, value := range replacements {
+	for token, value := range promptReplacements(data) {
 		rendered = strings.ReplaceAll(rendered, token, value)
 	}
 	return rendered
 }
+
+// PromptPlaceholders 返回模板支持的全部占位符
+func PromptPlaceholders() []string {
+	replacements := promptReplacements(PromptRenderData{})
+	placeholders := make([]string, 0, len(replacements))
+	for token := range replacements {
+		placeholders = append(placeholders, token)
+	}
+	sort.Strings(placeholders)
+	return placeholders
+}
+
+// UnknownPlaceholders 找出模板中无法被替换的占位符，按首次出现顺序返回
+func UnknownPlaceholders(template string) []string {
+	known := promptReplacements(PromptRenderData{})
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, token := range promptPlaceholderPattern.FindAllString(template, -1) {
+		if _, ok := known[token]; ok || seen[token] {
+			continue
+		}
+		seen[token] = true
+		unknown = append(unknown, token)
+	}
+	return unknown
+}
@@ -53,8 +53,40 @@ func TestDefaultContentPromptTemplatesAskForHTMLFragments(t *testing.T) {
 
 func TestDefaultTitleAndSummaryPromptTemplatesStayPlainText(t *testing.T) {
 	defaults := DefaultPromptTemplates()
-	for _, mode := range []string{ModeTitleCandidates, ModeSummaryCandidates} {
+	for _, mode := range []string{
+		ModeTitleCandidates, ModeSummaryCandidates,
+		ModeSlugCandidates, ModeSEODescriptionCandidates, ModeTagCandidates, ModeCoverAltCandidates, ModeCategoryCandidates,
+	} {
 		require.Contains(t, defaults[mode], "纯文本", mode)
 		require.Contains(t, defaults[mode], "不要包含 HTML 或 Markdown", mode)
 	}
 }
+
+func TestRenderPromptTemplateJoinsCategories(t *testing.T) {
+	rendered := RenderPromptTemplate(DefaultPromptTemplates()[ModeCategoryCandidates], PromptRenderData{
+		Categories: []string{"后端", "数据库"},
+	})
+
+	require.Contains(t, rendered, "categories:\n后端\n数据库\n")
+	require.NotContains(t, rendered, "{{categories}}")
+}
+
+func TestUnknownPlaceholders(t *testing.T) {
+	unknown := UnknownPlaceholders("{{text}} {{ text }} {{title}} {{title}} {{article_title}}")
+
+	require.Equal(t, []string{"{{ text }}", "{{title}}"}, unknown)
+	for mode, template := range DefaultPromptTemplates() {
+		require.Empty(t, UnknownPlaceholders(template), mode)
+	}
+}
+
+func TestPreviewPromptUsesConfiguredTemplateAndLimits(t *testing.T) {
+	preview := PreviewPrompt(ServiceConfig{
+		MaxContextChars: 2,
+		MaxSuggestions:  3,
+		PromptTemplates: map[string]string{ModeTitleCandidates: "{{article_title}} {{max_suggestions}} {{unknown}}"},
+	}, PolishRequest{Mode: ModeTitleCandidates, ArticleTitle: "长标题"}, "")
+
+	require.Equal(t, "长标 3 {{unknown}}", preview.Prompt)
+	require.Equal(t, []string{"{{unknown}}"}, preview.UnknownPlaceholders)
+}
@@ -15,9 +15,27 @@ const (
 	ModeTitleCandidates   = "title_candidates"
 	ModeSummaryCandidates = "summary_candidates"
 
+	ModeSlugCandidates           = "slug_candidates"
+	ModeSEODescriptionCandidates = "seo_description_candidates"
+	ModeTagCandidates            = "tag_candidates"
+	ModeCoverAltCandidates       = "cover_alt_candidates"
+	ModeCategoryCandidates       = "category_candidates"
+	ModeTranslate                = "translate"
+	ModeCommentModeration        = "comment_moderation"
+
+	ModeOutline      = "outline"
+	ModeContinue     = "continue"
+	ModeSectionDraft = "section_draft"
+
 	TargetContentSelection = "content_selection"
 	TargetTitle            = "title"
 	TargetSummary          = "summary"
+	TargetSlug             = "slug"
+	TargetSEODescription   = "seo_description"
+	TargetTags             = "tags"
+	TargetCoverAlt         = "cover_alt"
+	TargetCategory         = "category"
+	TargetComment          = "comment"
 
 	APIProtocolChatCompletions = "chat/completions"
 	APIProtocolResponses       = "responses"
@@ -89,6 +107,7 @@ type PolishRequest struct {
 	ArticleSummary string
 	ArticleExcerpt string
 	Locale         string
+	Categories     []string
 }
 
 type Suggestion struct {
@@ -114,6 +133,13 @@ func (req PolishRequest) normalized() PolishRequest {
 	req.ArticleSummary = strings.TrimSpace(req.ArticleSummary)
 	req.ArticleExcerpt = strings.TrimSpace(req.ArticleExcerpt)
 	req.Locale = strings.TrimSpace(req.Locale)
+	categories := make([]string, 0, len(req.Categories))
+	for _, category := range req.Categories {
+		if category = strings.TrimSpace(category); category != "" {
+			categories = append(categories, category)
+		}
+	}
+	req.Categories = categories
 	if req.Locale == "" {
 		req.Locale = "zh-CN"
 	}
@@ -123,6 +149,15 @@ func (req PolishRequest) normalized() PolishRequest {
 	return req
 }
 
+// metadataTargets 元数据生成模式与目标字段的对应关系
+var metadataTargets = map[string]string{
+	ModeSlugCandidates:           TargetSlug,
+	ModeSEODescriptionCandidates: TargetSEODescription,
+	ModeTagCandidates:            TargetTags,
+	ModeCoverAltCandidates:       TargetCoverAlt,
+	ModeCategoryCandidates:       TargetCategory,
+}
+
 func validateRequest(req PolishRequest, maxInputChars int) error {
 	req = req.normalized()
 	if maxInputChars <= 0 {
@@ -150,6 +185,48 @@ func validateRequest(req PolishRequest, maxInputChars int) error {
 		if req.Target != TargetSummary {
 			return fmt.Errorf("%w: summary candidates require summary target", ErrInvalidInput)
 		}
+	case ModeOutline:
+		if req.Target != TargetContentSelection {
+			return fmt.Errorf("%w: outline requires content selection target", ErrInvalidInput)
+		}
+		if req.ArticleTitle == "" {
+			return fmt.Errorf("%w: article title is required", ErrInvalidInput)
+		}
+	case ModeContinue:
+		if req.Target != TargetContentSelection {
+			return fmt.Errorf("%w: continue requires content selection target", ErrInvalidInput)
+		}
+		if req.ArticleExcerpt == "" {
+			return fmt.Errorf("%w: article excerpt is required", ErrInvalidInput)
+		}
+	case ModeSectionDraft:
+		if req.Target != TargetContentSelection {
+			return fmt.Errorf("%w: section draft requires content selection target", ErrInvalidInput)
+		}
+		if req.Text == "" {
+			return fmt.Errorf("%w: section heading is required", ErrInvalidInput)
+		}
+	case ModeTranslate:
+		if req.Target != TargetContentSelection && req.Target != TargetTitle && req.Target != TargetSummary {
+			return fmt.Errorf("%w: translate requires content selection, title or summary target", ErrInvalidInput)
+		}
+		if req.Text == "" && req.RichText == "" {
+			return fmt.Errorf("%w: text is required", ErrInvalidInput)
+		}
+	case ModeCommentModeration:
+		if req.Target != TargetComment {
+			return fmt.Errorf("%w: comment moderation requires comment target", ErrInvalidInput)
+		}
+		if req.Text == "" {
+			return fmt.Errorf("%w: text is required", ErrInvalidInput)
+		}
+	case ModeSlugCandidates, ModeSEODescriptionCandidates, ModeTagCandidates, ModeCoverAltCandidates, ModeCategoryCandidates:
+		if req.Target != metadataTargets[req.Mode] {
+			return fmt.Errorf("%w: mode %s requires %s target", ErrInvalidInput, req.Mode, metadataTargets[req.Mode])
+		}
+		if req.Mode == ModeCategoryCandidates && len(req.Categories) == 0 {
+			return fmt.Errorf("%w: categories are required", ErrInvalidInput)
+		}
 	default:
 		return fmt.Errorf("%w: unsupported mode", ErrInvalidInput)
 	}
@@ -163,6 +240,25 @@ func ValidateRequest(req PolishRequest, maxInputChars int) error {
 	return validateRequest(req, maxInputChars)
 }
 
+// htmlFragmentModes 需要返回 CKEditor HTML 片段并经过解析器校验的生成模式
+var htmlFragmentModes = map[string]bool{
+	ModeOutline:      true,
+	ModeContinue:     true,
+	ModeSectionDraft: true,
+}
+
+// limitTailRunes 保留末尾 max 个字符，用于续写时截取光标前最近的上下文
+func limitTailRunes(value string, max int) string {
+	if max <= 0 {
+		return value
+	}
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[len(runes)-max:])
+}
+
 func limitRunes(value string, max int) string {
 	if max <= 0 {
 		return value
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+)
+
+type AIPolishEntry struct {
+	Response ai.PolishResponse `json:"response"`
+	CachedAt time.Time         `json:"cached_at"`
+}
+
+type AIPolishCache struct {
+	cache Cache
+	ttl   time.Duration
+}
+
+// NewAIPolishCache ttl 不大于 0 时视为关闭缓存，读写都会跳过
+func NewAIPolishCache(cache Cache, ttl time.Duration) *AIPolishCache {
+	return &AIPolishCache{cache: cache, ttl: ttl}
+}
+
+func (c *AIPolishCache) enabled() bool {
+	return c != nil && c.cache != nil && c.ttl > 0
+}
+
+// Get 关闭缓存后不再读取之前写入的结果
+func (c *AIPolishCache) Get(ctx context.Context, hash string) (AIPolishEntry, bool, error) {
+	var entry AIPolishEntry
+	if !c.enabled() {
+		return entry, false, nil
+	}
+	ok, err := c.cache.Get(ctx, key.GetAIPolishResponseKey(hash), &entry)
+	return entry, ok, err
+}
+
+func (c *AIPolishCache) Set(ctx context.Context, hash string, entry AIPolishEntry) error {
+	if !c.enabled() {
+		return nil
+	}
+	return c.cache.Set(ctx, key.GetAIPolishResponseKey(hash), entry, c.ttl)
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIPolishCacheRoundTrip(t *testing.T) {
+	fake := newFakeCache()
+	polishCache := NewAIPolishCache(fake, time.Hour)
+	entry := AIPolishEntry{
+		Response: ai.PolishResponse{
+			Suggestions: []ai.Suggestion{{Content: "更好", Reason: "更顺"}},
+			Mode:        ai.ModeImprove,
+			Model:       "writer-model",
+		},
+		CachedAt: time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	require.NoError(t, polishCache.Set(context.Background(), "abc", entry))
+	require.Equal(t, time.Hour, fake.ttls[key.GetAIPolishResponseKey("abc")])
+
+	got, ok, err := polishCache.Get(context.Background(), "abc")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, entry, got)
+}
+
+func TestAIPolishCacheSkipsWhenTTLDisabled(t *testing.T) {
+	fake := newFakeCache()
+	require.NoError(t, NewAIPolishCache(fake, time.Hour).Set(context.Background(), "abc", AIPolishEntry{}))
+
+	polishCache := NewAIPolishCache(fake, 0)
+	require.NoError(t, polishCache.Set(context.Background(), "def", AIPolishEntry{}))
+	require.NotContains(t, fake.values, key.GetAIPolishResponseKey("def"))
+
+	// 关闭缓存后不再返回之前写入的结果
+	_, ok, err := polishCache.Get(context.Background(), "abc")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = NewAIPolishCache(nil, time.Hour).Get(context.Background(), "abc")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
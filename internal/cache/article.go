@@ -35,6 +35,7 @@ func (a *ArticleCache) GetByID(ctx context.Context, id uuid.UUID) (db.GetArticle
 		return article, false, nil
 	}
 	ok, err := a.cache.Get(ctx, key.GetArticleIDKey(id), &article)
+	recordGet(StatsArticleDetail, ok, err)
 	return article, ok, err
 }
 
@@ -51,6 +52,7 @@ func (a *ArticleCache) GetBySlug(ctx context.Context, slug string) (db.GetArticl
 		return article, false, nil
 	}
 	ok, err := a.cache.Get(ctx, key.GetArticleSlugKey(slug), &article)
+	recordGet(StatsArticleDetail, ok, err)
 	return article, ok, err
 }
 
@@ -72,10 +74,12 @@ func (a *ArticleCache) GetList(ctx context.Context, params ArticleListParams) (A
 
 	version, err := a.listVersion(ctx, params.CategoryID)
 	if err != nil {
+		recordGet(StatsArticleList, false, err)
 		return page, false, err
 	}
 
 	ok, err := a.cache.Get(ctx, key.GetArticleListKey(version, params.CategoryID, params.Page, params.Limit), &page)
+	recordGet(StatsArticleList, ok, err)
 	return page, ok, err
 }
 
@@ -100,6 +104,41 @@ func (a *ArticleCache) SetList(ctx context.Context, params ArticleListParams, pa
 	return a.cache.Set(ctx, key.GetArticleListKey(version, params.CategoryID, params.Page, params.Limit), page, WithJitter(ttl))
 }
 
+func (a *ArticleCache) GetRelated(ctx context.Context, id uuid.UUID, limit int32) ([]db.ListRelatedArticlesRow, bool, error) {
+	var articles []db.ListRelatedArticlesRow
+	if a == nil || a.cache == nil {
+		return articles, false, nil
+	}
+
+	version, err := a.listVersion(ctx, 0)
+	if err != nil {
+		recordGet(StatsArticleRelated, false, err)
+		return articles, false, err
+	}
+
+	ok, err := a.cache.Get(ctx, key.GetArticleRelatedKey(version, id, limit), &articles)
+	recordGet(StatsArticleRelated, ok, err)
+	return articles, ok, err
+}
+
+func (a *ArticleCache) SetRelated(ctx context.Context, id uuid.UUID, limit int32, articles []db.ListRelatedArticlesRow) error {
+	if a == nil || a.cache == nil {
+		return nil
+	}
+
+	version, err := a.listVersion(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	ttl := ArticleRelatedTTL
+	if len(articles) == 0 {
+		ttl = EmptyArticleListTTL
+	}
+
+	return a.cache.Set(ctx, key.GetArticleRelatedKey(version, id, limit), articles, WithJitter(ttl))
+}
+
 func (a *ArticleCache) BumpListVersion(ctx context.Context, categoryIDs ...int64) error {
 	if a == nil || a.cache == nil {
 		return nil
@@ -139,6 +178,31 @@ func (a *ArticleCache) InvalidateDetails(ctx context.Context, keys ...string) er
 	return nil
 }
 
+// Purge 删除单篇文章的详情、相关文章与评论缓存，返回删除的键数量
+func (a *ArticleCache) Purge(ctx context.Context, id uuid.UUID, slug string) (int64, error) {
+	if a == nil || a.cache == nil {
+		return 0, nil
+	}
+	patterns := []string{
+		ExactPattern(key.GetArticleIDKey(id)),
+		PrefixPattern(key.GetArticleCommentKey(id)),
+		key.GetArticleRelatedPattern(id),
+	}
+	if slug != "" {
+		patterns = append(patterns, ExactPattern(key.GetArticleSlugKey(slug)))
+	}
+
+	var deleted int64
+	for _, pattern := range patterns {
+		count, err := a.cache.DelByPattern(ctx, pattern, key.VersionKeyPatterns...)
+		deleted += count
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
 func (a *ArticleCache) listVersion(ctx context.Context, categoryID int64) (int64, error) {
 	var version int64
 	if a == nil || a.cache == nil {
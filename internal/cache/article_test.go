@@ -3,6 +3,8 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -74,10 +76,60 @@ func TestArticleCacheBumpsAllAndCategoryListVersions(t *testing.T) {
 	require.Equal(t, int64(1), fake.increments[key.GetArticleListVersionKey(8)])
 }
 
+func TestArticleCacheInvalidatesRelatedArticlesWithListVersion(t *testing.T) {
+	fake := newFakeCache()
+	articleCache := NewArticleCache(fake)
+	articleID := uuid.New()
+	related := []db.ListRelatedArticlesRow{{ID: uuid.New(), Title: "related"}}
+
+	err := fake.Set(context.Background(), key.GetArticleListVersionKey(0), int64(3), time.Hour)
+	require.NoError(t, err)
+	err = articleCache.SetRelated(context.Background(), articleID, 5, related)
+	require.NoError(t, err)
+
+	cached, ok, err := articleCache.GetRelated(context.Background(), articleID, 5)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, related[0].Title, cached[0].Title)
+
+	cacheKey := key.GetArticleRelatedKey(3, articleID, 5)
+	require.GreaterOrEqual(t, fake.ttls[cacheKey], ArticleRelatedTTL)
+	require.LessOrEqual(t, fake.ttls[cacheKey], ArticleRelatedTTL+ArticleRelatedTTL/10)
+
+	// 文章更新后全站列表版本号递增，旧的相关文章缓存不再命中
+	err = fake.Set(context.Background(), key.GetArticleListVersionKey(0), int64(4), time.Hour)
+	require.NoError(t, err)
+	_, ok, err = articleCache.GetRelated(context.Background(), articleID, 5)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestArticleCachePurgeKeepsVersionKeys(t *testing.T) {
+	fake := newFakeCache()
+	articleCache := NewArticleCache(fake)
+	articleID := uuid.New()
+	ctx := context.Background()
+
+	versionKey := key.GetArticleCommentVersionKey(articleID)
+	threadKey := key.GetArticleCommentPageKey(articleID, 3, 0, 10)
+	require.NoError(t, fake.Set(ctx, versionKey, int64(3), time.Hour))
+	require.NoError(t, fake.Set(ctx, threadKey, "thread", time.Hour))
+	require.NoError(t, fake.Set(ctx, key.GetArticleIDKey(articleID), "article", time.Hour))
+
+	deleted, err := articleCache.Purge(ctx, articleID, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	// 版本号保留，删除后归零会让旧版本的评论缓存重新命中
+	require.Contains(t, fake.values, versionKey)
+	require.NotContains(t, fake.values, threadKey)
+}
+
 type fakeCache struct {
 	values     map[string][]byte
 	ttls       map[string]time.Duration
 	increments map[string]int64
+	hashes     map[string]map[string]int64
 }
 
 func newFakeCache() *fakeCache {
@@ -85,6 +137,7 @@ func newFakeCache() *fakeCache {
 		values:     make(map[string][]byte),
 		ttls:       make(map[string]time.Duration),
 		increments: make(map[string]int64),
+		hashes:     make(map[string]map[string]int64),
 	}
 }
 
@@ -113,9 +166,19 @@ func (f *fakeCache) Set(_ context.Context, cacheKey string, value any, ttl time.
 func (f *fakeCache) Del(_ context.Context, cacheKey string) error {
 	delete(f.values, cacheKey)
 	delete(f.ttls, cacheKey)
+	delete(f.hashes, cacheKey)
 	return nil
 }
 
+func (f *fakeCache) SetAndDel(ctx context.Context, values map[string]any, ttl time.Duration, delKey string) error {
+	for cacheKey, value := range values {
+		if err := f.Set(ctx, cacheKey, value, ttl); err != nil {
+			return err
+		}
+	}
+	return f.Del(ctx, delKey)
+}
+
 func (f *fakeCache) SetNX(ctx context.Context, cacheKey string, value interface{}, ttl time.Duration) (bool, error) {
 	if _, ok := f.values[cacheKey]; ok {
 		return false, nil
@@ -138,6 +201,64 @@ func (f *fakeCache) IsExpired(context.Context, string) (bool, error) {
 	return false, nil
 }
 
+func (f *fakeCache) HIncrBy(_ context.Context, cacheKey string, field string, incr int64) (int64, error) {
+	if f.hashes[cacheKey] == nil {
+		f.hashes[cacheKey] = make(map[string]int64)
+	}
+	f.hashes[cacheKey][field] += incr
+	return f.hashes[cacheKey][field], nil
+}
+
+func (f *fakeCache) HMGet(_ context.Context, cacheKey string, fields ...string) ([]string, error) {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		if value, ok := f.hashes[cacheKey][field]; ok {
+			values[i] = strconv.FormatInt(value, 10)
+		}
+	}
+	return values, nil
+}
+
+func (f *fakeCache) HGetAll(_ context.Context, cacheKey string) (map[string]string, error) {
+	values := make(map[string]string, len(f.hashes[cacheKey]))
+	for field, value := range f.hashes[cacheKey] {
+		values[field] = strconv.FormatInt(value, 10)
+	}
+	return values, nil
+}
+
+func (f *fakeCache) Rename(_ context.Context, cacheKey string, newKey string) (bool, error) {
+	hash, ok := f.hashes[cacheKey]
+	if !ok {
+		return false, nil
+	}
+	f.hashes[newKey] = hash
+	delete(f.hashes, cacheKey)
+	return true, nil
+}
+
+func (f *fakeCache) TTL(_ context.Context, cacheKey string) (time.Duration, bool, error) {
+	if _, ok := f.values[cacheKey]; !ok {
+		return 0, false, nil
+	}
+	if ttl, ok := f.ttls[cacheKey]; ok {
+		return ttl, true, nil
+	}
+	return -1, true, nil
+}
+
+func (f *fakeCache) DelByPattern(_ context.Context, pattern string, exclude ...string) (int64, error) {
+	var deleted int64
+	for cacheKey := range f.values {
+		if ok, _ := path.Match(pattern, cacheKey); ok && !matchesAnyPattern(cacheKey, exclude) {
+			delete(f.values, cacheKey)
+			delete(f.ttls, cacheKey)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
 func (f *fakeCache) Close() error {
 	return nil
 }
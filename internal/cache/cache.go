@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"path"
+	"strings"
 	"time"
 )
 
@@ -10,8 +12,47 @@ type Cache interface {
 	Get(ctx context.Context, key string, dest any) (bool, error)
 	Set(ctx context.Context, key string, value any, ttl time.Duration) error
 	Del(ctx context.Context, key string) error
+	// SetAndDel 在同一事务中写入 values 并删除 delKey，读取方不会看到只完成一半的状态
+	SetAndDel(ctx context.Context, values map[string]any, ttl time.Duration, delKey string) error
 	SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error)
 	Incr(ctx context.Context, key string) (int64, error)
 	IsExpired(ctx context.Context, key string) (bool, error)
+	HIncrBy(ctx context.Context, key string, field string, incr int64) (int64, error)
+	HMGet(ctx context.Context, key string, fields ...string) ([]string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	// Rename 源键不存在时返回 false
+	Rename(ctx context.Context, key string, newKey string) (bool, error)
+	// TTL 键不存在时 exists 为 false，没有过期时间时 ttl 小于 0
+	TTL(ctx context.Context, key string) (ttl time.Duration, exists bool, err error)
+	// DelByPattern 删除匹配通配符的全部键，跳过匹配 exclude 中任一模式的键，返回删除数量
+	DelByPattern(ctx context.Context, pattern string, exclude ...string) (int64, error)
 	Close() error
 }
+
+// PrefixPattern 转义前缀中的通配符，生成匹配该前缀全部键的模式
+func PrefixPattern(prefix string) string {
+	return ExactPattern(prefix) + "*"
+}
+
+// matchesAnyPattern 判断键是否匹配任一通配符模式，模式语法与 Redis 的 glob 一致
+func matchesAnyPattern(cacheKey string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, cacheKey); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExactPattern 转义键中的通配符，生成只匹配该键本身的模式
+func ExactPattern(cacheKey string) string {
+	var builder strings.Builder
+	for _, r := range cacheKey {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			builder.WriteRune('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
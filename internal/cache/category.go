@@ -6,6 +6,9 @@ import (
 	"github.com/MonitorAllen/nostalgia/internal/cache/key"
 )
 
+// MaxCachedCategories 分类数量少于该值时缓存完整列表，分页在内存中完成
+const MaxCachedCategories int32 = 200
+
 type CategoryCache struct {
 	cache Cache
 }
@@ -18,7 +21,9 @@ func (c *CategoryCache) GetList(ctx context.Context, dest any) (bool, error) {
 	if c == nil || c.cache == nil {
 		return false, nil
 	}
-	return c.cache.Get(ctx, key.CategoryAllKey, dest)
+	ok, err := c.cache.Get(ctx, key.CategoryAllKey, dest)
+	recordGet(StatsCategoryList, ok, err)
+	return ok, err
 }
 
 func (c *CategoryCache) SetList(ctx context.Context, value any) error {
@@ -34,3 +39,14 @@ func (c *CategoryCache) InvalidateList(ctx context.Context) error {
 	}
 	return c.cache.Del(ctx, key.CategoryAllKey)
 }
+
+// Purge 删除分类列表缓存并使该分类的文章列表失效
+func (c *CategoryCache) Purge(ctx context.Context, categoryID int64) (int64, error) {
+	if c == nil || c.cache == nil {
+		return 0, nil
+	}
+	if err := NewArticleCache(c.cache).BumpListVersion(ctx, categoryID); err != nil {
+		return 0, err
+	}
+	return c.cache.DelByPattern(ctx, ExactPattern(key.CategoryAllKey))
+}
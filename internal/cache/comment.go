@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/google/uuid"
+)
+
+// CommentCache 按文章缓存评论分页、回复分页与评论总数，评论变更时递增文章的评论版本号统一失效
+type CommentCache struct {
+	cache Cache
+}
+
+func NewCommentCache(cache Cache) *CommentCache {
+	return &CommentCache{cache: cache}
+}
+
+// CommentThread 固定一次请求读取到的版本号，
+// 避免查询数据库期间评论发生变更后，旧数据被写入新版本的键
+type CommentThread struct {
+	cache     Cache
+	articleID uuid.UUID
+	version   int64
+}
+
+// Thread 读取文章当前的评论版本号，缓存未配置时返回 nil，其方法均不做任何操作
+func (c *CommentCache) Thread(ctx context.Context, articleID uuid.UUID) (*CommentThread, error) {
+	if c == nil || c.cache == nil {
+		return nil, nil
+	}
+	var version int64
+	_, err := c.cache.Get(ctx, key.GetArticleCommentVersionKey(articleID), &version)
+	if err != nil {
+		recordGet(StatsArticleComment, false, err)
+		return nil, err
+	}
+	return &CommentThread{cache: c.cache, articleID: articleID, version: version}, nil
+}
+
+// Invalidate 旧版本的键不再被读取，等待过期即可
+func (c *CommentCache) Invalidate(ctx context.Context, articleID uuid.UUID) error {
+	if c == nil || c.cache == nil {
+		return nil
+	}
+	_, err := c.cache.Incr(ctx, key.GetArticleCommentVersionKey(articleID))
+	return err
+}
+
+func (t *CommentThread) GetPage(ctx context.Context, afterID int64, limit int32, dest any) (bool, error) {
+	if t == nil {
+		return false, nil
+	}
+	return t.get(ctx, key.GetArticleCommentPageKey(t.articleID, t.version, afterID, limit), dest)
+}
+
+func (t *CommentThread) SetPage(ctx context.Context, afterID int64, limit int32, value any) error {
+	if t == nil {
+		return nil
+	}
+	return t.set(ctx, key.GetArticleCommentPageKey(t.articleID, t.version, afterID, limit), value)
+}
+
+func (t *CommentThread) GetReplies(ctx context.Context, parentID int64, afterID int64, limit int32, dest any) (bool, error) {
+	if t == nil {
+		return false, nil
+	}
+	return t.get(ctx, key.GetArticleCommentRepliesKey(t.articleID, t.version, parentID, afterID, limit), dest)
+}
+
+func (t *CommentThread) SetReplies(ctx context.Context, parentID int64, afterID int64, limit int32, value any) error {
+	if t == nil {
+		return nil
+	}
+	return t.set(ctx, key.GetArticleCommentRepliesKey(t.articleID, t.version, parentID, afterID, limit), value)
+}
+
+func (t *CommentThread) GetCount(ctx context.Context, dest any) (bool, error) {
+	if t == nil {
+		return false, nil
+	}
+	return t.get(ctx, key.GetArticleCommentCountKey(t.articleID, t.version), dest)
+}
+
+func (t *CommentThread) SetCount(ctx context.Context, value any) error {
+	if t == nil {
+		return nil
+	}
+	return t.set(ctx, key.GetArticleCommentCountKey(t.articleID, t.version), value)
+}
+
+func (t *CommentThread) get(ctx context.Context, cacheKey string, dest any) (bool, error) {
+	ok, err := t.cache.Get(ctx, cacheKey, dest)
+	recordGet(StatsArticleComment, ok, err)
+	return ok, err
+}
+
+func (t *CommentThread) set(ctx context.Context, cacheKey string, value any) error {
+	return t.cache.Set(ctx, cacheKey, value, WithJitter(ArticleCommentTTL))
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentThreadInvalidation(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeCache()
+	commentCache := NewCommentCache(fake)
+	articleID := uuid.New()
+
+	thread, err := commentCache.Thread(ctx, articleID)
+	require.NoError(t, err)
+	require.NoError(t, thread.SetPage(ctx, 0, 10, []int64{1, 2}))
+	require.NoError(t, thread.SetCount(ctx, int64(2)))
+
+	var page []int64
+	ok, err := thread.GetPage(ctx, 0, 10, &page)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []int64{1, 2}, page)
+
+	// 读取旧版本后发生变更，旧请求写回的数据不会被新版本读到
+	require.NoError(t, commentCache.Invalidate(ctx, articleID))
+	require.NoError(t, thread.SetPage(ctx, 0, 10, []int64{1, 2}))
+
+	fresh, err := commentCache.Thread(ctx, articleID)
+	require.NoError(t, err)
+	ok, err = fresh.GetPage(ctx, 0, 10, &page)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	var count int64
+	ok, err = fresh.GetCount(ctx, &count)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestCommentThreadWithoutCache(t *testing.T) {
+	thread, err := NewCommentCache(nil).Thread(context.Background(), uuid.New())
+	require.NoError(t, err)
+	require.Nil(t, thread)
+
+	var page []int64
+	ok, err := thread.GetReplies(context.Background(), 1, 0, 10, &page)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, thread.SetReplies(context.Background(), 1, 0, 10, page))
+}
@@ -18,7 +18,9 @@ func (c *ContributionCache) Get(ctx context.Context, dest any) (bool, error) {
 	if c == nil || c.cache == nil {
 		return false, nil
 	}
-	return c.cache.Get(ctx, key.GetUserContributionsKey(), dest)
+	ok, err := c.cache.Get(ctx, key.GetUserContributionsKey(), dest)
+	recordGet(StatsContributions, ok, err)
+	return ok, err
 }
 
 func (c *ContributionCache) Set(ctx context.Context, value any) error {
@@ -0,0 +1,217 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/google/uuid"
+)
+
+const (
+	ArticleCounterViews = "views"
+	ArticleCounterLikes = "likes"
+)
+
+// ArticleCounterDelta 尚未写入数据库的计数增量
+type ArticleCounterDelta struct {
+	Views int64
+	Likes int64
+}
+
+// ArticleCounterCache 在 Redis 中累积浏览量与点赞数，由定时任务批量写回数据库
+type ArticleCounterCache struct {
+	cache Cache
+}
+
+func NewArticleCounterCache(cache Cache) *ArticleCounterCache {
+	return &ArticleCounterCache{cache: cache}
+}
+
+// IncrViews 未配置缓存时返回 false，调用方应直接更新数据库
+func (a *ArticleCounterCache) IncrViews(ctx context.Context, id uuid.UUID) (bool, error) {
+	return a.incr(ctx, ArticleCounterViews, id)
+}
+
+// IncrLikes 未配置缓存时返回 false，调用方应直接更新数据库
+func (a *ArticleCounterCache) IncrLikes(ctx context.Context, id uuid.UUID) (bool, error) {
+	return a.incr(ctx, ArticleCounterLikes, id)
+}
+
+func (a *ArticleCounterCache) incr(ctx context.Context, metric string, id uuid.UUID) (bool, error) {
+	if a == nil || a.cache == nil {
+		return false, nil
+	}
+	if _, err := a.cache.HIncrBy(ctx, key.ArticleCounterPendingKey, key.GetArticleCounterField(metric, id), 1); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Pending 返回文章尚未写回的增量，包括正在写回的批次，用于详情接口展示实时计数
+func (a *ArticleCounterCache) Pending(ctx context.Context, id uuid.UUID) (ArticleCounterDelta, error) {
+	var delta ArticleCounterDelta
+	if a == nil || a.cache == nil {
+		return delta, nil
+	}
+
+	for _, cacheKey := range []string{key.ArticleCounterPendingKey, key.ArticleCounterFlushingKey} {
+		values, err := a.cache.HMGet(ctx, cacheKey,
+			key.GetArticleCounterField(ArticleCounterViews, id),
+			key.GetArticleCounterField(ArticleCounterLikes, id),
+		)
+		if err != nil {
+			return ArticleCounterDelta{}, err
+		}
+		if len(values) != 2 {
+			return ArticleCounterDelta{}, fmt.Errorf("unexpected pending counter values: %d", len(values))
+		}
+
+		views, err := parseCounterValue(values[0])
+		if err != nil {
+			return ArticleCounterDelta{}, err
+		}
+		likes, err := parseCounterValue(values[1])
+		if err != nil {
+			return ArticleCounterDelta{}, err
+		}
+		delta.Views += views
+		delta.Likes += likes
+	}
+	return delta, nil
+}
+
+// ArticleCounterTotals 最近一次写回后数据库中的计数
+type ArticleCounterTotals struct {
+	Views int32 `json:"views"`
+	Likes int32 `json:"likes"`
+}
+
+// FlushedTotals 读取最近一次写回后的计数，文章还没有写回过时返回 false
+func (a *ArticleCounterCache) FlushedTotals(ctx context.Context, id uuid.UUID) (ArticleCounterTotals, bool, error) {
+	var totals ArticleCounterTotals
+	if a == nil || a.cache == nil {
+		return totals, false, nil
+	}
+	ok, err := a.cache.Get(ctx, key.GetArticleCounterTotalsKey(id), &totals)
+	return totals, ok, err
+}
+
+// ClearFlushedTotals 计数绕过缓冲直接写入数据库后清除记录的计数，避免其掩盖这次更新
+func (a *ArticleCounterCache) ClearFlushedTotals(ctx context.Context, id uuid.UUID) error {
+	if a == nil || a.cache == nil {
+		return nil
+	}
+	return a.cache.Del(ctx, key.GetArticleCounterTotalsKey(id))
+}
+
+// AcquireFlushLock 保证同一时间只有一个任务在写回计数
+func (a *ArticleCounterCache) AcquireFlushLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	if a == nil || a.cache == nil {
+		return false, nil
+	}
+	return a.cache.SetNX(ctx, key.ArticleCounterFlushLockKey, 1, ttl)
+}
+
+func (a *ArticleCounterCache) ReleaseFlushLock(ctx context.Context) error {
+	if a == nil || a.cache == nil {
+		return nil
+	}
+	return a.cache.Del(ctx, key.ArticleCounterFlushLockKey)
+}
+
+// ArticleCounterBatch 一批待写回的增量，ID 在重试时保持不变
+type ArticleCounterBatch struct {
+	ID     string
+	Deltas map[uuid.UUID]ArticleCounterDelta
+}
+
+// TakePending 将累积的增量整体移到待写回的键中并返回。
+// 上次写回失败留下的批次会连同原来的批次 ID 优先返回，新的增量留到下一轮
+func (a *ArticleCounterCache) TakePending(ctx context.Context) (ArticleCounterBatch, error) {
+	var batch ArticleCounterBatch
+	if a == nil || a.cache == nil {
+		return batch, nil
+	}
+
+	values, err := a.cache.HGetAll(ctx, key.ArticleCounterFlushingKey)
+	if err != nil {
+		return batch, err
+	}
+	if len(values) == 0 {
+		ok, err := a.cache.Rename(ctx, key.ArticleCounterPendingKey, key.ArticleCounterFlushingKey)
+		if err != nil || !ok {
+			return batch, err
+		}
+		if values, err = a.cache.HGetAll(ctx, key.ArticleCounterFlushingKey); err != nil {
+			return batch, err
+		}
+	}
+
+	batch.Deltas = make(map[uuid.UUID]ArticleCounterDelta, len(values))
+	for field, value := range values {
+		if batchID, ok := strings.CutPrefix(field, key.ArticleCounterBatchFieldPrefix); ok {
+			// 正常情况下只有一个批次 ID，取最小值保证每次读取结果一致
+			if batch.ID == "" || batchID < batch.ID {
+				batch.ID = batchID
+			}
+			continue
+		}
+
+		metric, rawID, ok := strings.Cut(field, ":")
+		if !ok {
+			continue
+		}
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			continue
+		}
+		count, err := parseCounterValue(value)
+		if err != nil {
+			return ArticleCounterBatch{}, err
+		}
+
+		delta := batch.Deltas[id]
+		switch metric {
+		case ArticleCounterViews:
+			delta.Views += count
+		case ArticleCounterLikes:
+			delta.Likes += count
+		default:
+			continue
+		}
+		batch.Deltas[id] = delta
+	}
+
+	if batch.ID == "" {
+		batch.ID = uuid.NewString()
+		if _, err := a.cache.HIncrBy(ctx, key.ArticleCounterFlushingKey, key.ArticleCounterBatchFieldPrefix+batch.ID, 1); err != nil {
+			return ArticleCounterBatch{}, err
+		}
+	}
+	return batch, nil
+}
+
+// CompleteFlush 数据库写入成功后记录写回后的计数，并在同一事务中清除本批增量与批次 ID，
+// 详情接口不会把本批增量叠加到已经包含它的计数上
+func (a *ArticleCounterCache) CompleteFlush(ctx context.Context, totals map[uuid.UUID]ArticleCounterTotals) error {
+	if a == nil || a.cache == nil {
+		return nil
+	}
+
+	values := make(map[string]any, len(totals))
+	for id, total := range totals {
+		values[key.GetArticleCounterTotalsKey(id)] = total
+	}
+	return a.cache.SetAndDel(ctx, values, ArticleCounterTotalsTTL, key.ArticleCounterFlushingKey)
+}
+
+func parseCounterValue(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
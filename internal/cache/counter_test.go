@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleCounterCacheBuffersAndFlushes(t *testing.T) {
+	fake := newFakeCache()
+	counterCache := NewArticleCounterCache(fake)
+	articleID := uuid.New()
+	otherID := uuid.New()
+
+	for range 3 {
+		buffered, err := counterCache.IncrViews(context.Background(), articleID)
+		require.NoError(t, err)
+		require.True(t, buffered)
+	}
+	_, err := counterCache.IncrLikes(context.Background(), articleID)
+	require.NoError(t, err)
+	_, err = counterCache.IncrViews(context.Background(), otherID)
+	require.NoError(t, err)
+
+	delta, err := counterCache.Pending(context.Background(), articleID)
+	require.NoError(t, err)
+	require.Equal(t, ArticleCounterDelta{Views: 3, Likes: 1}, delta)
+
+	batch, err := counterCache.TakePending(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, batch.ID)
+	require.Equal(t, map[uuid.UUID]ArticleCounterDelta{
+		articleID: {Views: 3, Likes: 1},
+		otherID:   {Views: 1},
+	}, batch.Deltas)
+
+	// 写回期间的新增量进入新的缓冲区，不会混入本批，但仍计入实时计数
+	_, err = counterCache.IncrViews(context.Background(), articleID)
+	require.NoError(t, err)
+	delta, err = counterCache.Pending(context.Background(), articleID)
+	require.NoError(t, err)
+	require.Equal(t, ArticleCounterDelta{Views: 4, Likes: 1}, delta)
+
+	// 写回失败时保留本批和批次 ID，下一轮优先重试
+	retried, err := counterCache.TakePending(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batch, retried)
+
+	require.NoError(t, counterCache.CompleteFlush(context.Background(), nil))
+	next, err := counterCache.TakePending(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, batch.ID, next.ID)
+	require.Equal(t, map[uuid.UUID]ArticleCounterDelta{articleID: {Views: 1}}, next.Deltas)
+	require.NoError(t, counterCache.CompleteFlush(context.Background(), nil))
+
+	next, err = counterCache.TakePending(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, next.ID)
+	require.Empty(t, next.Deltas)
+}
+
+func TestArticleCounterCacheFlushedTotals(t *testing.T) {
+	fake := newFakeCache()
+	counterCache := NewArticleCounterCache(fake)
+	articleID := uuid.New()
+
+	_, ok, err := counterCache.FlushedTotals(context.Background(), articleID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = counterCache.IncrViews(context.Background(), articleID)
+	require.NoError(t, err)
+	_, err = counterCache.TakePending(context.Background())
+	require.NoError(t, err)
+
+	// 写回后的计数与清除本批同时生效，本批增量不会再计入待写回计数
+	totals := ArticleCounterTotals{Views: 12, Likes: 3}
+	require.NoError(t, counterCache.CompleteFlush(context.Background(), map[uuid.UUID]ArticleCounterTotals{articleID: totals}))
+	require.Equal(t, ArticleCounterTotalsTTL, fake.ttls[key.GetArticleCounterTotalsKey(articleID)])
+
+	got, ok, err := counterCache.FlushedTotals(context.Background(), articleID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, totals, got)
+
+	delta, err := counterCache.Pending(context.Background(), articleID)
+	require.NoError(t, err)
+	require.Zero(t, delta)
+
+	require.NoError(t, counterCache.ClearFlushedTotals(context.Background(), articleID))
+	_, ok, err = counterCache.FlushedTotals(context.Background(), articleID)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestArticleCounterCacheFlushLock(t *testing.T) {
+	fake := newFakeCache()
+	counterCache := NewArticleCounterCache(fake)
+
+	locked, err := counterCache.AcquireFlushLock(context.Background(), time.Minute)
+	require.NoError(t, err)
+	require.True(t, locked)
+	require.Equal(t, time.Minute, fake.ttls[key.ArticleCounterFlushLockKey])
+
+	locked, err = counterCache.AcquireFlushLock(context.Background(), time.Minute)
+	require.NoError(t, err)
+	require.False(t, locked)
+
+	require.NoError(t, counterCache.ReleaseFlushLock(context.Background()))
+	locked, err = counterCache.AcquireFlushLock(context.Background(), time.Minute)
+	require.NoError(t, err)
+	require.True(t, locked)
+}
+
+func TestArticleCounterCacheWithoutCache(t *testing.T) {
+	counterCache := NewArticleCounterCache(nil)
+
+	buffered, err := counterCache.IncrLikes(context.Background(), uuid.New())
+	require.NoError(t, err)
+	require.False(t, buffered)
+
+	delta, err := counterCache.Pending(context.Background(), uuid.New())
+	require.NoError(t, err)
+	require.Zero(t, delta)
+}
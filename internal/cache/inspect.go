@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// KeyInspection 管理后台查看的缓存键信息，TTL 小于 0 表示没有过期时间
+type KeyInspection struct {
+	Exists bool
+	TTL    time.Duration
+	Value  json.RawMessage
+}
+
+// Inspect 直接读取 Redis 中的原始值，绕过本地缓存，避免把原始 JSON 写入本地条目
+func Inspect(ctx context.Context, c Cache, cacheKey string) (KeyInspection, error) {
+	if local, ok := c.(*LocalCache); ok {
+		c = local.remote
+	}
+
+	var inspection KeyInspection
+	ttl, exists, err := c.TTL(ctx, cacheKey)
+	if err != nil || !exists {
+		return inspection, err
+	}
+	inspection.Exists = true
+	inspection.TTL = ttl
+
+	// 键在两次读取之间过期时视为不存在
+	ok, err := c.Get(ctx, cacheKey, &inspection.Value)
+	if err != nil {
+		return inspection, err
+	}
+	inspection.Exists = ok
+	return inspection, nil
+}
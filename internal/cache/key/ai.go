@@ -0,0 +1,11 @@
+package key
+
+import "fmt"
+
+const (
+	AIPolishResponseKey = "cache:ai:polish:%s"
+)
+
+func GetAIPolishResponseKey(hash string) string {
+	return fmt.Sprintf(AIPolishResponseKey, hash)
+}
@@ -10,9 +10,25 @@ const (
 	ArticleIDKey                  = "cache:article:id:%s"
 	ArticleSlugKey                = "cache:article:slug:%s"
 	ArticleCommentKey             = "cache:article:comment:%s"
+	ArticleCommentVersionKey      = "cache:article:comment:%s:version"
+	ArticleCommentPageKey         = "cache:article:comment:%s:v:%d:after:%d:limit:%d"
+	ArticleCommentRepliesKey      = "cache:article:comment:%s:v:%d:parent:%d:after:%d:limit:%d"
+	ArticleCommentCountKey        = "cache:article:comment:%s:v:%d:count"
 	ArticleListVersionAllKey      = "cache:article:list:version:all"
 	ArticleListVersionCategoryKey = "cache:article:list:version:category:%d"
 	ArticleListKey                = "cache:article:list:v:%d:category:%s:page:%d:limit:%d"
+	ArticleRelatedKey             = "cache:article:related:v:%d:%s:limit:%d"
+	ArticleRelatedPattern         = "cache:article:related:v:*:%s:limit:*"
+
+	// 浏览量与点赞数的缓冲增量，字段为 views:<id> 或 likes:<id>
+	ArticleCounterPendingKey   = "counter:article:pending"
+	ArticleCounterFlushingKey  = "counter:article:flushing"
+	ArticleCounterFlushLockKey = "lock:article:counter:flush"
+	ArticleCounterFieldKey     = "%s:%s"
+	// 写回中的批次 ID 保存在 flushing 键的字段中，与本批增量一起删除
+	ArticleCounterBatchFieldPrefix = "batch:"
+	// 最近一次写回后数据库中的计数，详情接口以此为准叠加未写回的增量
+	ArticleCounterTotalsKey = "counter:article:totals:%s"
 
 	ArticleLikeOnceUserIDKey = "idempotency:article:like:user:%s:%s"
 	ArticleViewOnceUserIDKey = "idempotency:article:view:user:%s:%s"
@@ -20,6 +36,13 @@ const (
 	ArticleViewOnceGuestKey  = "idempotency:article:view:guest:%s:%s"
 )
 
+// VersionKeyPatterns 匹配文章列表与评论的版本号键。版本号键删除后会从 0 重新计数，
+// 仍在有效期内的旧版本缓存会重新生效，按前缀清除缓存时需要保留
+var VersionKeyPatterns = []string{
+	"cache:*:version",
+	"cache:*:version:*",
+}
+
 func GetArticleIDKey(id uuid.UUID) string {
 	return fmt.Sprintf(ArticleIDKey, id.String())
 }
@@ -39,6 +62,45 @@ func GetArticleListKey(version int64, categoryID int64, page int32, limit int32)
 	return fmt.Sprintf(ArticleListKey, version, articleListCategoryBucket(categoryID), page, limit)
 }
 
+// GetArticleRelatedKey 相关文章依赖其他文章的标签与分类，使用全站列表版本号统一失效
+func GetArticleRelatedKey(version int64, id uuid.UUID, limit int32) string {
+	return fmt.Sprintf(ArticleRelatedKey, version, id.String(), limit)
+}
+
+// GetArticleRelatedPattern 匹配某篇文章所有版本与数量的相关文章缓存
+func GetArticleRelatedPattern(id uuid.UUID) string {
+	return fmt.Sprintf(ArticleRelatedPattern, id.String())
+}
+
+// GetArticleCommentKey 文章评论缓存的公共前缀
+func GetArticleCommentKey(id uuid.UUID) string {
+	return fmt.Sprintf(ArticleCommentKey, id.String())
+}
+
+func GetArticleCommentVersionKey(id uuid.UUID) string {
+	return fmt.Sprintf(ArticleCommentVersionKey, id.String())
+}
+
+func GetArticleCommentPageKey(id uuid.UUID, version int64, afterID int64, limit int32) string {
+	return fmt.Sprintf(ArticleCommentPageKey, id.String(), version, afterID, limit)
+}
+
+func GetArticleCommentRepliesKey(id uuid.UUID, version int64, parentID int64, afterID int64, limit int32) string {
+	return fmt.Sprintf(ArticleCommentRepliesKey, id.String(), version, parentID, afterID, limit)
+}
+
+func GetArticleCommentCountKey(id uuid.UUID, version int64) string {
+	return fmt.Sprintf(ArticleCommentCountKey, id.String(), version)
+}
+
+func GetArticleCounterField(metric string, id uuid.UUID) string {
+	return fmt.Sprintf(ArticleCounterFieldKey, metric, id.String())
+}
+
+func GetArticleCounterTotalsKey(id uuid.UUID) string {
+	return fmt.Sprintf(ArticleCounterTotalsKey, id.String())
+}
+
 func articleListCategoryBucket(categoryID int64) string {
 	if categoryID == 0 {
 		return "all"
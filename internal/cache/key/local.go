@@ -0,0 +1,6 @@
+package key
+
+const (
+	// LocalCacheInvalidationChannel 各实例通过该频道同步淘汰进程内缓存
+	LocalCacheInvalidationChannel = "cache:local:invalidate"
+)
@@ -0,0 +1,10 @@
+package key
+
+import "fmt"
+
+// SchedulerLastRunKey 定时任务最近一次执行结果，不在 cache: 命名空间下，清除缓存时不会被删除
+const SchedulerLastRunKey = "scheduler:last_run:%s"
+
+func GetSchedulerLastRunKey(taskType string) string {
+	return fmt.Sprintf(SchedulerLastRunKey, taskType)
+}
@@ -0,0 +1,29 @@
+package key
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SearchSuggestKey    = "cache:search:suggest:limit:%d:%s"
+	SearchVocabularyKey = "cache:search:vocabulary"
+
+	SearchClickOnceKey      = "idempotency:search:click:%d:%s"
+	SearchClickRateLimitKey = "ratelimit:search:click:%s:%d"
+)
+
+func GetSearchSuggestKey(prefix string, limit int32) string {
+	return fmt.Sprintf(SearchSuggestKey, limit, strings.ToLower(prefix))
+}
+
+func GetSearchClickOnceKey(searchID int64, articleID uuid.UUID) string {
+	return fmt.Sprintf(SearchClickOnceKey, searchID, articleID.String())
+}
+
+// GetSearchClickRateLimitKey 按固定窗口计数，window 为窗口序号
+func GetSearchClickRateLimitKey(ip string, window int64) string {
+	return fmt.Sprintf(SearchClickRateLimitKey, ip, window)
+}
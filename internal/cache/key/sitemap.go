@@ -0,0 +1,10 @@
+package key
+
+import "fmt"
+
+// SitemapKey 站点地图随文章列表版本号失效，文章发布、更新或删除后重新生成
+const SitemapKey = "cache:sitemap:v:%d:%s"
+
+func GetSitemapKey(version int64, name string) string {
+	return fmt.Sprintf(SitemapKey, version, name)
+}
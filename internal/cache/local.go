@@ -0,0 +1,335 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DefaultLocalCacheMaxEntries = 1000
+	DefaultLocalCacheTTL        = 5 * time.Second
+)
+
+// localCacheablePrefixes 只有读多写少、失效路径明确的文章详情与列表进入进程内缓存
+var localCacheablePrefixes = []string{
+	"cache:article:id:",
+	"cache:article:slug:",
+	"cache:article:list:",
+	"cache:article:related:",
+}
+
+// PubSub 用于跨实例广播本地缓存失效
+type PubSub interface {
+	Publish(ctx context.Context, channel string, message string) error
+	// Subscribe 阻塞直到 ctx 结束，每收到一条消息调用一次 handler
+	Subscribe(ctx context.Context, channel string, handler func(message string)) error
+}
+
+type localInvalidation struct {
+	Origin string   `json:"origin"`
+	Keys   []string `json:"keys,omitempty"`
+	// Flush 按模式删除时无法逐个对应本地条目，直接清空
+	Flush bool `json:"flush,omitempty"`
+}
+
+type localEntry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+// LocalCache 在 Redis 前增加一层有界的进程内缓存，命中时省去网络往返与 JSON 解码。
+// 删除或递增可缓存的键时通过 PubSub 通知其他实例淘汰，消息丢失时由较短的 TTL 兜底。
+// 命中时返回的值与缓存共享底层数组，调用方不应修改其中的切片元素
+type LocalCache struct {
+	remote     Cache
+	pubsub     PubSub
+	ttl        time.Duration
+	maxEntries int
+	instanceID string
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// order 最近使用的条目在前
+	order *list.List
+}
+
+// NewLocalCache 包装远端缓存，pubsub 为 nil 时只依赖 TTL 过期
+func NewLocalCache(config util.Config, remote Cache, pubsub PubSub) *LocalCache {
+	ttl := config.LocalCacheTTL
+	if ttl <= 0 {
+		ttl = DefaultLocalCacheTTL
+	}
+	maxEntries := config.LocalCacheMaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultLocalCacheMaxEntries
+	}
+
+	return &LocalCache{
+		remote:     remote,
+		pubsub:     pubsub,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		instanceID: uuid.NewString(),
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Run 订阅其他实例的失效通知，阻塞直到 ctx 结束
+func (l *LocalCache) Run(ctx context.Context) error {
+	if l.pubsub == nil {
+		<-ctx.Done()
+		return nil
+	}
+	return l.pubsub.Subscribe(ctx, key.LocalCacheInvalidationChannel, l.handleInvalidation)
+}
+
+func (l *LocalCache) Ping(ctx context.Context) error {
+	return l.remote.Ping(ctx)
+}
+
+func (l *LocalCache) Get(ctx context.Context, cacheKey string, dest any) (bool, error) {
+	if !isLocalCacheable(cacheKey) {
+		return l.remote.Get(ctx, cacheKey, dest)
+	}
+	if l.load(cacheKey, dest) {
+		return true, nil
+	}
+
+	ok, err := l.remote.Get(ctx, cacheKey, dest)
+	if err != nil || !ok {
+		return ok, err
+	}
+	if target := reflect.ValueOf(dest); target.Kind() == reflect.Pointer && !target.IsNil() {
+		l.store(cacheKey, target.Elem().Interface(), l.ttl)
+	}
+	return true, nil
+}
+
+func (l *LocalCache) Set(ctx context.Context, cacheKey string, value any, ttl time.Duration) error {
+	if err := l.remote.Set(ctx, cacheKey, value, ttl); err != nil {
+		return err
+	}
+	if isLocalCacheable(cacheKey) {
+		localTTL := l.ttl
+		if ttl > 0 && ttl < localTTL {
+			localTTL = ttl
+		}
+		l.store(cacheKey, value, localTTL)
+	}
+	return nil
+}
+
+func (l *LocalCache) Del(ctx context.Context, cacheKey string) error {
+	if err := l.remote.Del(ctx, cacheKey); err != nil {
+		return err
+	}
+	l.invalidate(ctx, cacheKey)
+	return nil
+}
+
+func (l *LocalCache) SetAndDel(ctx context.Context, values map[string]any, ttl time.Duration, delKey string) error {
+	if err := l.remote.SetAndDel(ctx, values, ttl, delKey); err != nil {
+		return err
+	}
+	localTTL := l.ttl
+	if ttl > 0 && ttl < localTTL {
+		localTTL = ttl
+	}
+	for cacheKey, value := range values {
+		if isLocalCacheable(cacheKey) {
+			l.store(cacheKey, value, localTTL)
+		}
+	}
+	l.invalidate(ctx, delKey)
+	return nil
+}
+
+func (l *LocalCache) SetNX(ctx context.Context, cacheKey string, value interface{}, ttl time.Duration) (bool, error) {
+	return l.remote.SetNX(ctx, cacheKey, value, ttl)
+}
+
+// Incr 列表版本号递增后需要让所有实例重新读取版本号
+func (l *LocalCache) Incr(ctx context.Context, cacheKey string) (int64, error) {
+	value, err := l.remote.Incr(ctx, cacheKey)
+	if err != nil {
+		return 0, err
+	}
+	l.invalidate(ctx, cacheKey)
+	return value, nil
+}
+
+func (l *LocalCache) IsExpired(ctx context.Context, cacheKey string) (bool, error) {
+	return l.remote.IsExpired(ctx, cacheKey)
+}
+
+func (l *LocalCache) HIncrBy(ctx context.Context, cacheKey string, field string, incr int64) (int64, error) {
+	return l.remote.HIncrBy(ctx, cacheKey, field, incr)
+}
+
+func (l *LocalCache) HMGet(ctx context.Context, cacheKey string, fields ...string) ([]string, error) {
+	return l.remote.HMGet(ctx, cacheKey, fields...)
+}
+
+func (l *LocalCache) HGetAll(ctx context.Context, cacheKey string) (map[string]string, error) {
+	return l.remote.HGetAll(ctx, cacheKey)
+}
+
+func (l *LocalCache) Rename(ctx context.Context, cacheKey string, newKey string) (bool, error) {
+	return l.remote.Rename(ctx, cacheKey, newKey)
+}
+
+func (l *LocalCache) TTL(ctx context.Context, cacheKey string) (time.Duration, bool, error) {
+	return l.remote.TTL(ctx, cacheKey)
+}
+
+func (l *LocalCache) DelByPattern(ctx context.Context, pattern string, exclude ...string) (int64, error) {
+	deleted, err := l.remote.DelByPattern(ctx, pattern, exclude...)
+	if err != nil {
+		return deleted, err
+	}
+	l.flush()
+	l.publish(ctx, localInvalidation{Origin: l.instanceID, Flush: true}, pattern)
+	return deleted, nil
+}
+
+func (l *LocalCache) Close() error {
+	return l.remote.Close()
+}
+
+// invalidate 淘汰本地条目并通知其他实例，通知失败只影响其他实例的新鲜度，不向调用方返回错误
+func (l *LocalCache) invalidate(ctx context.Context, cacheKey string) {
+	if !isLocalCacheable(cacheKey) {
+		return
+	}
+	l.evict(cacheKey)
+	l.publish(ctx, localInvalidation{Origin: l.instanceID, Keys: []string{cacheKey}}, cacheKey)
+}
+
+func (l *LocalCache) publish(ctx context.Context, invalidation localInvalidation, cacheKey string) {
+	if l.pubsub == nil {
+		return
+	}
+
+	message, err := json.Marshal(invalidation)
+	if err == nil {
+		err = l.pubsub.Publish(ctx, key.LocalCacheInvalidationChannel, string(message))
+	}
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("key", cacheKey).
+			Str("module", "cache").
+			Str("action", "local_invalidate").
+			Msg("广播本地缓存失效失败，其他实例将等待过期")
+	}
+}
+
+func (l *LocalCache) handleInvalidation(message string) {
+	var invalidation localInvalidation
+	if err := json.Unmarshal([]byte(message), &invalidation); err != nil {
+		log.Warn().Err(err).Str("module", "cache").Str("action", "local_invalidate").Msg("无法解析本地缓存失效消息")
+		return
+	}
+	if invalidation.Origin == l.instanceID {
+		return
+	}
+	if invalidation.Flush {
+		l.flush()
+		return
+	}
+	for _, cacheKey := range invalidation.Keys {
+		l.evict(cacheKey)
+	}
+}
+
+func (l *LocalCache) load(cacheKey string, dest any) bool {
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[cacheKey]
+	if !ok {
+		return false
+	}
+	entry := element.Value.(*localEntry)
+	if !l.now().Before(entry.expiresAt) {
+		l.removeElement(element)
+		return false
+	}
+
+	value := reflect.ValueOf(entry.value)
+	if !value.IsValid() || !value.Type().AssignableTo(target.Elem().Type()) {
+		return false
+	}
+	target.Elem().Set(value)
+	l.order.MoveToFront(element)
+	return true
+}
+
+func (l *LocalCache) store(cacheKey string, value any, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expiresAt := l.now().Add(ttl)
+	if element, ok := l.entries[cacheKey]; ok {
+		entry := element.Value.(*localEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.entries[cacheKey] = l.order.PushFront(&localEntry{key: cacheKey, value: value, expiresAt: expiresAt})
+	for l.order.Len() > l.maxEntries {
+		l.removeElement(l.order.Back())
+	}
+}
+
+func (l *LocalCache) evict(cacheKey string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[cacheKey]; ok {
+		l.removeElement(element)
+	}
+}
+
+func (l *LocalCache) flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = make(map[string]*list.Element)
+	l.order.Init()
+}
+
+func (l *LocalCache) removeElement(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.entries, element.Value.(*localEntry).key)
+}
+
+func isLocalCacheable(cacheKey string) bool {
+	for _, prefix := range localCacheablePrefixes {
+		if strings.HasPrefix(cacheKey, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePubSub 同步投递消息，模拟多个实例订阅同一频道
+type fakePubSub struct {
+	handlers []func(message string)
+}
+
+func (f *fakePubSub) Publish(_ context.Context, _ string, message string) error {
+	for _, handler := range f.handlers {
+		handler(message)
+	}
+	return nil
+}
+
+func (f *fakePubSub) Subscribe(ctx context.Context, _ string, handler func(message string)) error {
+	f.handlers = append(f.handlers, handler)
+	return nil
+}
+
+func newTestLocalCache(t *testing.T, remote Cache, pubsub PubSub, maxEntries int) *LocalCache {
+	localCache := NewLocalCache(util.Config{LocalCacheMaxEntries: maxEntries, LocalCacheTTL: time.Minute}, remote, pubsub)
+	if pubsub != nil {
+		require.NoError(t, localCache.Run(context.Background()))
+	}
+	return localCache
+}
+
+func TestLocalCacheServesArticleReadsFromMemory(t *testing.T) {
+	remote := newFakeCache()
+	localCache := newTestLocalCache(t, remote, nil, 10)
+	article := db.GetArticleRow{ID: uuid.New(), Title: "local"}
+	cacheKey := key.GetArticleIDKey(article.ID)
+
+	require.NoError(t, remote.Set(context.Background(), cacheKey, article, time.Hour))
+
+	var cached db.GetArticleRow
+	ok, err := localCache.Get(context.Background(), cacheKey, &cached)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, article.Title, cached.Title)
+
+	// 远端被直接删除后仍从本地命中，说明第二次读取没有访问 Redis
+	delete(remote.values, cacheKey)
+	cached = db.GetArticleRow{}
+	ok, err = localCache.Get(context.Background(), cacheKey, &cached)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, article.Title, cached.Title)
+}
+
+func TestLocalCacheSkipsOtherKeys(t *testing.T) {
+	remote := newFakeCache()
+	localCache := newTestLocalCache(t, remote, nil, 10)
+
+	require.NoError(t, localCache.Set(context.Background(), key.CategoryAllKey, []string{"go"}, time.Hour))
+	require.Empty(t, localCache.entries)
+
+	delete(remote.values, key.CategoryAllKey)
+	var categories []string
+	ok, err := localCache.Get(context.Background(), key.CategoryAllKey, &categories)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestLocalCacheExpiresAndEvictsLeastRecentlyUsed(t *testing.T) {
+	remote := newFakeCache()
+	localCache := newTestLocalCache(t, remote, nil, 2)
+	now := time.Now()
+	localCache.now = func() time.Time { return now }
+
+	first := key.GetArticleSlugKey("first")
+	second := key.GetArticleSlugKey("second")
+	third := key.GetArticleSlugKey("third")
+	require.NoError(t, localCache.Set(context.Background(), first, db.GetArticleBySlugRow{Title: "first"}, time.Hour))
+	require.NoError(t, localCache.Set(context.Background(), second, db.GetArticleBySlugRow{Title: "second"}, 10*time.Second))
+
+	var article db.GetArticleBySlugRow
+	require.True(t, localCache.load(first, &article))
+	require.NoError(t, localCache.Set(context.Background(), third, db.GetArticleBySlugRow{Title: "third"}, time.Hour))
+
+	require.Len(t, localCache.entries, 2)
+	require.NotContains(t, localCache.entries, second)
+
+	// 远端 TTL 短于本地 TTL 时以远端为准
+	now = now.Add(30 * time.Second)
+	require.NoError(t, localCache.Set(context.Background(), second, db.GetArticleBySlugRow{Title: "second"}, 10*time.Second))
+	now = now.Add(15 * time.Second)
+	require.False(t, localCache.load(second, &article))
+	require.True(t, localCache.load(third, &article))
+}
+
+func TestLocalCacheInvalidatesOtherInstances(t *testing.T) {
+	remote := newFakeCache()
+	pubsub := &fakePubSub{}
+	writer := newTestLocalCache(t, remote, pubsub, 10)
+	reader := newTestLocalCache(t, remote, pubsub, 10)
+	ctx := context.Background()
+
+	articleID := uuid.New()
+	detailKey := key.GetArticleIDKey(articleID)
+	versionKey := key.GetArticleListVersionKey(0)
+	require.NoError(t, remote.Set(ctx, detailKey, db.GetArticleRow{ID: articleID, Title: "old"}, time.Hour))
+
+	var article db.GetArticleRow
+	ok, err := reader.Get(ctx, detailKey, &article)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, NewArticleCache(reader).BumpListVersion(ctx))
+	version, err := NewArticleCache(reader).listVersion(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+	require.Contains(t, reader.entries, versionKey)
+
+	writerArticles := NewArticleCache(writer)
+	require.NoError(t, writerArticles.InvalidateDetails(ctx, detailKey))
+	require.NoError(t, writerArticles.BumpListVersion(ctx))
+
+	require.NotContains(t, reader.entries, detailKey)
+	require.NotContains(t, reader.entries, versionKey)
+	version, err = NewArticleCache(reader).listVersion(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+}
+
+func TestLocalCacheDelByPatternFlushesAllInstances(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeCache()
+	pubsub := &fakePubSub{}
+	writer := newTestLocalCache(t, remote, pubsub, 10)
+	reader := newTestLocalCache(t, remote, pubsub, 10)
+	articleID := uuid.New()
+	cacheKey := key.GetArticleIDKey(articleID)
+
+	require.NoError(t, remote.Set(ctx, cacheKey, db.GetArticleRow{ID: articleID}, time.Hour))
+	var cached db.GetArticleRow
+	ok, err := reader.Get(ctx, cacheKey, &cached)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Contains(t, reader.entries, cacheKey)
+
+	deleted, err := writer.DelByPattern(ctx, PrefixPattern("cache:article:id:"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	require.Empty(t, reader.entries)
+
+	ok, err = reader.Get(ctx, cacheKey, &cached)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
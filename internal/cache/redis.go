@@ -6,6 +6,7 @@ import (
 	"errors"
 	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/redis/go-redis/v9"
+	"strings"
 	"time"
 )
 
@@ -57,6 +58,26 @@ func (r *RedisCache) Del(ctx context.Context, key string) error {
 	return r.rdb.Del(ctx, key).Err()
 }
 
+func (r *RedisCache) SetAndDel(ctx context.Context, values map[string]any, ttl time.Duration, delKey string) error {
+	encoded := make(map[string][]byte, len(values))
+	for cacheKey, value := range values {
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		encoded[cacheKey] = bytes
+	}
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for cacheKey, bytes := range encoded {
+			pipe.Set(ctx, cacheKey, bytes, ttl)
+		}
+		pipe.Del(ctx, delKey)
+		return nil
+	})
+	return err
+}
+
 func (r *RedisCache) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
 	bytes, err := json.Marshal(value)
 	if err != nil {
@@ -83,10 +104,126 @@ func (r *RedisCache) IsExpired(ctx context.Context, key string) (bool, error) {
 	return isExpiredTTL(ttl), nil
 }
 
+func (r *RedisCache) HIncrBy(ctx context.Context, key string, field string, incr int64) (int64, error) {
+	return r.rdb.HIncrBy(ctx, key, field, incr).Result()
+}
+
+// HMGet 按字段顺序返回值，不存在的字段为空字符串
+func (r *RedisCache) HMGet(ctx context.Context, key string, fields ...string) ([]string, error) {
+	values, err := r.rdb.HMGet(ctx, key, fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(values))
+	for i, value := range values {
+		if str, ok := value.(string); ok {
+			result[i] = str
+		}
+	}
+	return result, nil
+}
+
+func (r *RedisCache) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return r.rdb.HGetAll(ctx, key).Result()
+}
+
+func (r *RedisCache) Rename(ctx context.Context, key string, newKey string) (bool, error) {
+	err := r.rdb.Rename(ctx, key, newKey).Err()
+	if err != nil {
+		if isNoSuchKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *RedisCache) TTL(ctx context.Context, key string) (time.Duration, bool, error) {
+	ttl, err := r.rdb.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, false, err
+	}
+	// -2 表示键不存在，-1 表示没有过期时间
+	if ttl == time.Duration(-2) {
+		return 0, false, nil
+	}
+	return ttl, true, nil
+}
+
+// DelByPattern 使用 SCAN 遍历，避免 KEYS 阻塞 Redis
+func (r *RedisCache) DelByPattern(ctx context.Context, pattern string, exclude ...string) (int64, error) {
+	const batchSize = 500
+
+	var deleted int64
+	batch := make([]string, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		count, err := r.rdb.Del(ctx, batch...).Result()
+		deleted += count
+		batch = batch[:0]
+		return err
+	}
+
+	iter := r.rdb.Scan(ctx, 0, pattern, batchSize).Iterator()
+	for iter.Next(ctx) {
+		if matchesAnyPattern(iter.Val(), exclude) {
+			continue
+		}
+		batch = append(batch, iter.Val())
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return deleted, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return deleted, err
+	}
+	return deleted, flush()
+}
+
+func (r *RedisCache) Publish(ctx context.Context, channel string, message string) error {
+	return r.rdb.Publish(ctx, channel, message).Err()
+}
+
+// Subscribe 断线后由客户端自动重新订阅，期间的消息会丢失
+func (r *RedisCache) Subscribe(ctx context.Context, channel string, handler func(message string)) error {
+	pubsub := r.rdb.Subscribe(ctx, channel)
+	defer pubsub.Close()
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			handler(message.Payload)
+		}
+	}
+}
+
 func (r *RedisCache) Close() error {
 	return r.rdb.Close()
 }
 
+func isNoSuchKeyError(err error) bool {
+	var redisErr redis.Error
+	return errors.As(err, &redisErr) && strings.Contains(redisErr.Error(), "no such key")
+}
+
 func isExpiredTTL(ttl time.Duration) bool {
 	if ttl == time.Duration(-1) {
 		return false
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+)
+
+// PeriodicJobRun 定时任务最近一次执行的结果，按任务类型记录
+type PeriodicJobRun struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Succeeded  bool      `json:"succeeded"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// SchedulerCache 记录定时任务的执行结果，供管理后台查看
+type SchedulerCache struct {
+	cache Cache
+}
+
+func NewSchedulerCache(cache Cache) *SchedulerCache {
+	return &SchedulerCache{cache: cache}
+}
+
+// SetLastRun 不设置过期时间，执行间隔较长的任务也能查到上次结果
+func (s *SchedulerCache) SetLastRun(ctx context.Context, taskType string, run PeriodicJobRun) error {
+	if s == nil || s.cache == nil {
+		return nil
+	}
+	return s.cache.Set(ctx, key.GetSchedulerLastRunKey(taskType), run, 0)
+}
+
+// GetLastRun 任务从未执行或未配置缓存时 ok 为 false
+func (s *SchedulerCache) GetLastRun(ctx context.Context, taskType string) (run PeriodicJobRun, ok bool, err error) {
+	if s == nil || s.cache == nil {
+		return run, false, nil
+	}
+	ok, err = s.cache.Get(ctx, key.GetSchedulerLastRunKey(taskType), &run)
+	return run, ok, err
+}
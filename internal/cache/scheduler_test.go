@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerCacheLastRun(t *testing.T) {
+	fake := newFakeCache()
+	schedulerCache := NewSchedulerCache(fake)
+
+	_, ok, err := schedulerCache.GetLastRun(context.Background(), "task:cleanup_sessions")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	startedAt := time.Now().UTC().Truncate(time.Second)
+	run := PeriodicJobRun{
+		StartedAt:  startedAt,
+		FinishedAt: startedAt.Add(time.Second),
+		Error:      "boom",
+	}
+	require.NoError(t, schedulerCache.SetLastRun(context.Background(), "task:cleanup_sessions", run))
+	require.Equal(t, time.Duration(0), fake.ttls[key.GetSchedulerLastRunKey("task:cleanup_sessions")])
+
+	got, ok, err := schedulerCache.GetLastRun(context.Background(), "task:cleanup_sessions")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, run, got)
+
+	// 清除 cache: 命名空间时不会删除执行记录
+	_, err = fake.DelByPattern(context.Background(), "cache:*")
+	require.NoError(t, err)
+	_, ok, err = schedulerCache.GetLastRun(context.Background(), "task:cleanup_sessions")
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSchedulerCacheWithoutCache(t *testing.T) {
+	var schedulerCache *SchedulerCache
+
+	require.NoError(t, schedulerCache.SetLastRun(context.Background(), "task:warm_cache", PeriodicJobRun{}))
+	_, ok, err := schedulerCache.GetLastRun(context.Background(), "task:warm_cache")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/google/uuid"
+)
+
+type SearchSuggestions struct {
+	Titles []string            `json:"titles"`
+	Tags   []db.SuggestTagsRow `json:"tags"`
+}
+
+type SearchCache struct {
+	cache Cache
+}
+
+func NewSearchCache(cache Cache) *SearchCache {
+	return &SearchCache{cache: cache}
+}
+
+func (c *SearchCache) GetSuggestions(ctx context.Context, prefix string, limit int32) (SearchSuggestions, bool, error) {
+	var suggestions SearchSuggestions
+	if c == nil || c.cache == nil {
+		return suggestions, false, nil
+	}
+	ok, err := c.cache.Get(ctx, key.GetSearchSuggestKey(prefix, limit), &suggestions)
+	return suggestions, ok, err
+}
+
+func (c *SearchCache) SetSuggestions(ctx context.Context, prefix string, limit int32, suggestions SearchSuggestions) error {
+	if c == nil || c.cache == nil {
+		return nil
+	}
+	return c.cache.Set(ctx, key.GetSearchSuggestKey(prefix, limit), suggestions, WithJitter(SearchSuggestTTL))
+}
+
+// GetVocabulary 读取用于拼写纠正的词表
+func (c *SearchCache) GetVocabulary(ctx context.Context) ([]string, bool, error) {
+	var vocabulary []string
+	if c == nil || c.cache == nil {
+		return vocabulary, false, nil
+	}
+	ok, err := c.cache.Get(ctx, key.SearchVocabularyKey, &vocabulary)
+	return vocabulary, ok, err
+}
+
+func (c *SearchCache) SetVocabulary(ctx context.Context, vocabulary []string) error {
+	if c == nil || c.cache == nil {
+		return nil
+	}
+	return c.cache.Set(ctx, key.SearchVocabularyKey, vocabulary, WithJitter(SearchVocabularyTTL))
+}
+
+// MarkClick 同一次搜索中的同一篇文章只记录一次点击，未配置缓存时总是返回 true
+func (c *SearchCache) MarkClick(ctx context.Context, searchID int64, articleID uuid.UUID) (bool, error) {
+	if c == nil || c.cache == nil {
+		return true, nil
+	}
+	return c.cache.SetNX(ctx, key.GetSearchClickOnceKey(searchID, articleID), 1, SearchClickIdempotencyTTL)
+}
+
+// AllowClick 按 IP 固定窗口限流。窗口序号写在键名中，计数键先带过期时间创建再递增，不会留下永久键
+func (c *SearchCache) AllowClick(ctx context.Context, ip string, now time.Time) (bool, error) {
+	if c == nil || c.cache == nil {
+		return true, nil
+	}
+	rateKey := key.GetSearchClickRateLimitKey(ip, now.UnixNano()/int64(SearchClickRateWindow))
+	if _, err := c.cache.SetNX(ctx, rateKey, 0, 2*SearchClickRateWindow); err != nil {
+		return false, err
+	}
+	count, err := c.cache.Incr(ctx, rateKey)
+	if err != nil {
+		return false, err
+	}
+	return count <= SearchClickRateLimit, nil
+}
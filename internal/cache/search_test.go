@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCacheSuggestionsRoundTrip(t *testing.T) {
+	fake := newFakeCache()
+	searchCache := NewSearchCache(fake)
+	suggestions := SearchSuggestions{
+		Titles: []string{"Redis 缓存实践"},
+		Tags:   []db.SuggestTagsRow{{Name: "redis", ArticleCount: 3}},
+	}
+
+	require.NoError(t, searchCache.SetSuggestions(context.Background(), "Red", 5, suggestions))
+	cacheKey := key.GetSearchSuggestKey("red", 5)
+	require.GreaterOrEqual(t, fake.ttls[cacheKey], SearchSuggestTTL)
+	require.LessOrEqual(t, fake.ttls[cacheKey], SearchSuggestTTL+SearchSuggestTTL/10)
+
+	got, ok, err := searchCache.GetSuggestions(context.Background(), "RED", 5)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, suggestions, got)
+
+	_, ok, err = searchCache.GetSuggestions(context.Background(), "red", 10)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSearchCacheVocabulary(t *testing.T) {
+	fake := newFakeCache()
+	searchCache := NewSearchCache(fake)
+
+	require.NoError(t, searchCache.SetVocabulary(context.Background(), []string{"golang", "redis"}))
+
+	got, ok, err := searchCache.GetVocabulary(context.Background())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{"golang", "redis"}, got)
+
+	_, ok, err = NewSearchCache(nil).GetVocabulary(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSearchCacheMarkClick(t *testing.T) {
+	searchCache := NewSearchCache(newFakeCache())
+	articleID := uuid.New()
+
+	ok, err := searchCache.MarkClick(context.Background(), 42, articleID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = searchCache.MarkClick(context.Background(), 42, articleID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = searchCache.MarkClick(context.Background(), 42, uuid.New())
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSearchCacheAllowClick(t *testing.T) {
+	fake := newFakeCache()
+	searchCache := NewSearchCache(fake)
+	now := time.Now()
+
+	for i := 0; i < SearchClickRateLimit; i++ {
+		ok, err := searchCache.AllowClick(context.Background(), "10.0.0.1", now)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	ok, err := searchCache.AllowClick(context.Background(), "10.0.0.1", now)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// 计数键带过期时间，其他 IP 与下一个窗口不受影响
+	rateKey := key.GetSearchClickRateLimitKey("10.0.0.1", now.UnixNano()/int64(SearchClickRateWindow))
+	require.Equal(t, 2*SearchClickRateWindow, fake.ttls[rateKey])
+
+	ok, err = searchCache.AllowClick(context.Background(), "10.0.0.2", now)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = searchCache.AllowClick(context.Background(), "10.0.0.1", now.Add(SearchClickRateWindow))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
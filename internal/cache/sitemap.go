@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+)
+
+// SitemapCache 缓存生成好的站点地图 XML，name 区分索引与各个子站点地图
+type SitemapCache struct {
+	cache Cache
+}
+
+func NewSitemapCache(cache Cache) *SitemapCache {
+	return &SitemapCache{cache: cache}
+}
+
+func (c *SitemapCache) Get(ctx context.Context, name string) (string, bool, error) {
+	var document string
+	if c == nil || c.cache == nil {
+		return document, false, nil
+	}
+
+	version, err := NewArticleCache(c.cache).listVersion(ctx, 0)
+	if err != nil {
+		return document, false, err
+	}
+
+	ok, err := c.cache.Get(ctx, key.GetSitemapKey(version, name), &document)
+	return document, ok, err
+}
+
+func (c *SitemapCache) Set(ctx context.Context, name string, document string) error {
+	if c == nil || c.cache == nil {
+		return nil
+	}
+
+	version, err := NewArticleCache(c.cache).listVersion(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	return c.cache.Set(ctx, key.GetSitemapKey(version, name), document, WithJitter(SitemapTTL))
+}
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemapCacheUsesArticleListVersion(t *testing.T) {
+	fake := newFakeCache()
+	sitemapCache := NewSitemapCache(fake)
+
+	err := fake.Set(context.Background(), key.GetArticleListVersionKey(0), int64(2), time.Hour)
+	require.NoError(t, err)
+	err = sitemapCache.Set(context.Background(), "index", "<sitemapindex/>")
+	require.NoError(t, err)
+
+	document, ok, err := sitemapCache.Get(context.Background(), "index")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "<sitemapindex/>", document)
+
+	cacheKey := key.GetSitemapKey(2, "index")
+	require.GreaterOrEqual(t, fake.ttls[cacheKey], SitemapTTL)
+	require.LessOrEqual(t, fake.ttls[cacheKey], SitemapTTL+SitemapTTL/10)
+
+	err = fake.Set(context.Background(), key.GetArticleListVersionKey(0), int64(3), time.Hour)
+	require.NoError(t, err)
+	_, ok, err = sitemapCache.Get(context.Background(), "index")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
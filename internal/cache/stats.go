@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	StatsArticleDetail  = "article_detail"
+	StatsArticleList    = "article_list"
+	StatsArticleRelated = "article_related"
+	StatsArticleComment = "article_comment"
+	StatsCategoryList   = "category_list"
+	StatsContributions  = "contributions"
+)
+
+// Stats 某一类缓存读取的命中情况，计数只在当前进程内累计，重启后清零
+type Stats struct {
+	Namespace string
+	Hits      int64
+	Misses    int64
+	Errors    int64
+}
+
+// HitRate 没有读取记录时返回 0
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses + s.Errors
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type statsCounter struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+	errors atomic.Int64
+}
+
+var cacheStats sync.Map
+
+// recordGet 记录一次缓存读取，错误不计入未命中
+func recordGet(namespace string, ok bool, err error) {
+	value, _ := cacheStats.LoadOrStore(namespace, &statsCounter{})
+	counter := value.(*statsCounter)
+	switch {
+	case err != nil:
+		counter.errors.Add(1)
+	case ok:
+		counter.hits.Add(1)
+	default:
+		counter.misses.Add(1)
+	}
+}
+
+// StatsSnapshot 按命名空间排序返回当前进程的统计
+func StatsSnapshot() []Stats {
+	var snapshot []Stats
+	cacheStats.Range(func(namespace, value any) bool {
+		counter := value.(*statsCounter)
+		snapshot = append(snapshot, Stats{
+			Namespace: namespace.(string),
+			Hits:      counter.hits.Load(),
+			Misses:    counter.misses.Load(),
+			Errors:    counter.errors.Load(),
+		})
+		return true
+	})
+	slices.SortFunc(snapshot, func(a, b Stats) int {
+		if a.Namespace < b.Namespace {
+			return -1
+		}
+		if a.Namespace > b.Namespace {
+			return 1
+		}
+		return 0
+	})
+	return snapshot
+}
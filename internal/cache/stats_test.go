@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/stretchr/testify/require"
+)
+
+type failingGetCache struct {
+	*fakeCache
+}
+
+func (f failingGetCache) Get(context.Context, string, any) (bool, error) {
+	return false, errors.New("redis unavailable")
+}
+
+func statsFor(namespace string) Stats {
+	for _, stats := range StatsSnapshot() {
+		if stats.Namespace == namespace {
+			return stats
+		}
+	}
+	return Stats{Namespace: namespace}
+}
+
+func TestCategoryCacheRecordsStats(t *testing.T) {
+	ctx := context.Background()
+	before := statsFor(StatsCategoryList)
+
+	fake := newFakeCache()
+	categoryCache := NewCategoryCache(fake)
+	var categories []string
+	_, err := categoryCache.GetList(ctx, &categories)
+	require.NoError(t, err)
+	require.NoError(t, fake.Set(ctx, key.CategoryAllKey, []string{"go"}, 0))
+	_, err = categoryCache.GetList(ctx, &categories)
+	require.NoError(t, err)
+	_, err = NewCategoryCache(failingGetCache{fake}).GetList(ctx, &categories)
+	require.Error(t, err)
+
+	after := statsFor(StatsCategoryList)
+	require.Equal(t, before.Hits+1, after.Hits)
+	require.Equal(t, before.Misses+1, after.Misses)
+	require.Equal(t, before.Errors+1, after.Errors)
+}
+
+func TestStatsHitRate(t *testing.T) {
+	require.Zero(t, Stats{}.HitRate())
+	require.Equal(t, 0.75, Stats{Hits: 3, Misses: 1}.HitRate())
+}
+
+func TestPrefixPatternEscapesWildcards(t *testing.T) {
+	require.Equal(t, `cache:search:\*\?\[x\]*`, PrefixPattern("cache:search:*?[x]"))
+	require.Equal(t, key.CategoryAllKey, ExactPattern(key.CategoryAllKey))
+}
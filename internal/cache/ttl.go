@@ -9,13 +9,26 @@ const (
 	ArticleDetailTTL                = 24 * time.Hour
 	ArticleListTTL                  = 15 * time.Minute
 	EmptyArticleListTTL             = 5 * time.Minute
+	ArticleRelatedTTL               = 6 * time.Hour
 	CategoryListTTL                 = 12 * time.Hour
+	ArticleCommentTTL               = 30 * time.Minute
 	ContributionsTTL                = 12 * time.Hour
+	SitemapTTL                      = 6 * time.Hour
+	SearchSuggestTTL                = 10 * time.Minute
+	SearchVocabularyTTL             = time.Hour
 	AuthenticatedLikeIdempotencyTTL = 365 * 24 * time.Hour
 	GuestLikeIdempotencyTTL         = 7 * 24 * time.Hour
 	ArticleViewIdempotencyTTL       = 24 * time.Hour
+	SearchClickIdempotencyTTL       = 24 * time.Hour
+	SearchClickRateWindow           = time.Minute
+
+	// ArticleCounterTotalsTTL 需长于详情缓存的存活时间，详情缓存过期前写回的计数始终可读
+	ArticleCounterTotalsTTL = 2 * ArticleDetailTTL
 )
 
+// SearchClickRateLimit 同一 IP 在一个窗口内最多上报的点击次数
+const SearchClickRateLimit = 30
+
 func WithJitter(ttl time.Duration) time.Duration {
 	if ttl <= 0 {
 		return ttl
@@ -0,0 +1,149 @@
+package indexnow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+)
+
+// MaxURLsPerRequest 协议规定单次提交最多 10000 个 URL
+const MaxURLsPerRequest = 10000
+
+var (
+	ErrDisabled = errors.New("indexnow is not configured")
+	// ErrRejected 请求被搜索引擎拒绝（密钥无效、URL 不属于该站点等），重试没有意义
+	ErrRejected = errors.New("indexnow submission rejected")
+)
+
+var keyPattern = regexp.MustCompile(`^[a-zA-Z0-9-]{8,128}$`)
+
+// ValidKey 密钥需为 8-128 位字母、数字或连字符
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// Client 通过 IndexNow 协议向搜索引擎推送变更的 URL
+type Client struct {
+	endpoint   string
+	key        string
+	origin     string
+	httpClient *http.Client
+}
+
+// NewClient 根据配置创建客户端，未配置密钥或站点地址时返回 nil
+func NewClient(config util.Config) *Client {
+	key := strings.TrimSpace(config.IndexNowKey)
+	origin := util.NormalizeOrigin(config.Domain)
+	if !ValidKey(key) || origin == "" {
+		return nil
+	}
+
+	endpoint := strings.TrimSpace(config.IndexNowEndpoint)
+	if endpoint == "" {
+		endpoint = util.DefaultIndexNowEndpoint
+	}
+	timeout := config.IndexNowTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Client{
+		endpoint:   endpoint,
+		key:        key,
+		origin:     origin,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Endpoint 返回提交地址，用于记录提交日志
+func (client *Client) Endpoint() string {
+	if client == nil {
+		return ""
+	}
+	return client.endpoint
+}
+
+type submitRequest struct {
+	Host        string   `json:"host"`
+	Key         string   `json:"key"`
+	KeyLocation string   `json:"keyLocation"`
+	URLList     []string `json:"urlList"`
+}
+
+// Submit 提交属于本站的 URL，返回搜索引擎响应的状态码。
+// 4xx（429 除外）表示请求本身有问题，返回 ErrRejected；其余失败可以重试
+func (client *Client) Submit(ctx context.Context, urls []string) (int, error) {
+	if client == nil {
+		return 0, ErrDisabled
+	}
+
+	urlList := client.filterURLs(urls)
+	if len(urlList) == 0 {
+		return 0, fmt.Errorf("no urls belong to %s: %w", client.origin, ErrRejected)
+	}
+
+	parsed, err := url.Parse(client.origin)
+	if err != nil {
+		return 0, fmt.Errorf("invalid site origin: %w", err)
+	}
+
+	body, err := json.Marshal(submitRequest{
+		Host:        parsed.Host,
+		Key:         client.key,
+		KeyLocation: fmt.Sprintf("%s/%s.txt", client.origin, client.key),
+		URLList:     urlList,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal indexnow request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create indexnow request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to submit indexnow request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted:
+		return resp.StatusCode, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return resp.StatusCode, fmt.Errorf("indexnow responded with status %d", resp.StatusCode)
+	default:
+		return resp.StatusCode, fmt.Errorf("indexnow responded with status %d: %w", resp.StatusCode, ErrRejected)
+	}
+}
+
+// filterURLs 去重并丢弃不属于本站的 URL，超过协议上限的部分会被截断
+func (client *Client) filterURLs(urls []string) []string {
+	seen := make(map[string]bool, len(urls))
+	result := make([]string, 0, len(urls))
+	for _, value := range urls {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] || !strings.HasPrefix(value, client.origin+"/") {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+		if len(result) == MaxURLsPerRequest {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package indexnow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/stretchr/testify/require"
+)
+
+const testKey = "a1b2c3d4e5f6"
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(util.Config{
+		Domain:           "https://blog.example.com/",
+		IndexNowEndpoint: server.URL,
+		IndexNowKey:      testKey,
+	})
+	require.NotNil(t, client)
+	return client
+}
+
+func TestNewClientRequiresValidKeyAndDomain(t *testing.T) {
+	require.Nil(t, NewClient(util.Config{Domain: "https://blog.example.com"}))
+	require.Nil(t, NewClient(util.Config{Domain: "https://blog.example.com", IndexNowKey: "short"}))
+	require.Nil(t, NewClient(util.Config{IndexNowKey: testKey}))
+
+	client := NewClient(util.Config{Domain: "https://blog.example.com", IndexNowKey: testKey})
+	require.NotNil(t, client)
+	require.Equal(t, util.DefaultIndexNowEndpoint, client.Endpoint())
+}
+
+func TestSubmit(t *testing.T) {
+	var received submitRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	code, err := client.Submit(context.Background(), []string{
+		"https://blog.example.com/article/go",
+		"https://blog.example.com/article/go",
+		"https://evil.example.com/article/go",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, code)
+	require.Equal(t, submitRequest{
+		Host:        "blog.example.com",
+		Key:         testKey,
+		KeyLocation: "https://blog.example.com/" + testKey + ".txt",
+		URLList:     []string{"https://blog.example.com/article/go"},
+	}, received)
+}
+
+func TestSubmitErrors(t *testing.T) {
+	rejected := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	code, err := rejected.Submit(context.Background(), []string{"https://blog.example.com/article/go"})
+	require.ErrorIs(t, err, ErrRejected)
+	require.Equal(t, http.StatusForbidden, code)
+
+	throttled := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	code, err = throttled.Submit(context.Background(), []string{"https://blog.example.com/article/go"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrRejected)
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	_, err = throttled.Submit(context.Background(), []string{"https://other.example.com/"})
+	require.ErrorIs(t, err, ErrRejected)
+
+	var disabled *Client
+	_, err = disabled.Submit(context.Background(), []string{"https://blog.example.com/"})
+	require.ErrorIs(t, err, ErrDisabled)
+}
@@ -0,0 +1,90 @@
+package moderation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+)
+
+const (
+	// StatusPending 等待 AI 审核，审核完成前不公开
+	StatusPending   = "pending"
+	StatusPublished = "published"
+	StatusHeld      = "held"
+	StatusRejected  = "rejected"
+
+	// ReasonScreeningUnavailable 无法完成 AI 审核时转人工审核的原因
+	ReasonScreeningUnavailable = "ai: screening unavailable"
+
+	LabelOK    = "ok"
+	LabelSpam  = "spam"
+	LabelToxic = "toxic"
+)
+
+// Rules 评论启发式规则，MaxLinks 小于 0 表示不限制链接数量
+type Rules struct {
+	MaxLinks  int
+	Blocklist []string
+}
+
+// Verdict 评论审核结论
+type Verdict struct {
+	Held   bool
+	Reason string
+}
+
+// Status 返回结论对应的评论状态
+func (verdict Verdict) Status() string {
+	if verdict.Held {
+		return StatusHeld
+	}
+	return StatusPublished
+}
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+// CountLinks 统计评论中出现的链接数量
+func CountLinks(content string) int {
+	return len(linkPattern.FindAllStringIndex(content, -1))
+}
+
+// ScreenComment 使用链接数量与屏蔽词等廉价规则检查评论
+func ScreenComment(content string, rules Rules) Verdict {
+	if rules.MaxLinks >= 0 {
+		if links := CountLinks(content); links > rules.MaxLinks {
+			return Verdict{Held: true, Reason: fmt.Sprintf("heuristic: %d links exceeds limit %d", links, rules.MaxLinks)}
+		}
+	}
+
+	lowered := strings.ToLower(content)
+	for _, word := range rules.Blocklist {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" && strings.Contains(lowered, word) {
+			return Verdict{Held: true, Reason: fmt.Sprintf("heuristic: blocklisted term %q", word)}
+		}
+	}
+
+	return Verdict{}
+}
+
+// ClassifyAIResponse 解析 AI 审核结果，只有明确标记为 spam 或 toxic 的评论才会被拦截
+func ClassifyAIResponse(resp ai.PolishResponse) Verdict {
+	if len(resp.Suggestions) == 0 {
+		return Verdict{}
+	}
+
+	suggestion := resp.Suggestions[0]
+	label := strings.ToLower(strings.TrimSpace(suggestion.Content))
+	switch label {
+	case LabelSpam, LabelToxic:
+		reason := "ai: " + label
+		if suggestion.Reason != "" {
+			reason += ": " + suggestion.Reason
+		}
+		return Verdict{Held: true, Reason: reason}
+	default:
+		return Verdict{}
+	}
+}
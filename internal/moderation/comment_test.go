@@ -0,0 +1,35 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreenComment(t *testing.T) {
+	rules := Rules{MaxLinks: 2, Blocklist: []string{"Casino", " "}}
+
+	require.Equal(t, Verdict{}, ScreenComment("写得很好，学到了 https://go.dev", rules))
+
+	verdict := ScreenComment("https://a.com http://b.com www.c.com", rules)
+	require.True(t, verdict.Held)
+	require.Equal(t, StatusHeld, verdict.Status())
+	require.Contains(t, verdict.Reason, "3 links")
+
+	verdict = ScreenComment("online CASINO bonus", rules)
+	require.True(t, verdict.Held)
+	require.Contains(t, verdict.Reason, "casino")
+
+	require.False(t, ScreenComment("https://a.com https://b.com https://c.com", Rules{MaxLinks: -1}).Held)
+}
+
+func TestClassifyAIResponse(t *testing.T) {
+	verdict := ClassifyAIResponse(ai.PolishResponse{Suggestions: []ai.Suggestion{{Content: "Spam", Reason: "广告推广"}}})
+	require.True(t, verdict.Held)
+	require.Equal(t, "ai: spam: 广告推广", verdict.Reason)
+
+	require.Equal(t, Verdict{}, ClassifyAIResponse(ai.PolishResponse{Suggestions: []ai.Suggestion{{Content: "ok"}}}))
+	require.Equal(t, Verdict{}, ClassifyAIResponse(ai.PolishResponse{Suggestions: []ai.Suggestion{{Content: "不确定"}}}))
+	require.Equal(t, StatusPublished, ClassifyAIResponse(ai.PolishResponse{}).Status())
+}
@@ -0,0 +1,32 @@
+package search
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxAnalyticsQueryLength 记录查询词时保留的最大字符数
+	MaxAnalyticsQueryLength = 100
+	// DefaultAnalyticsWindow 搜索统计的默认时间窗口
+	DefaultAnalyticsWindow = 7 * 24 * time.Hour
+)
+
+var (
+	emailPattern  = regexp.MustCompile(`[\w.+-]+@[\w-]+(\.[\w-]+)+`)
+	digitsPattern = regexp.MustCompile(`\d{6,}`)
+)
+
+// NormalizeQuery 规范化查询词用于统计，并把邮箱和长数字（手机号、证件号等）替换为占位符
+func NormalizeQuery(input string) string {
+	query := strings.ToLower(input)
+	query = emailPattern.ReplaceAllString(query, "<email>")
+	query = digitsPattern.ReplaceAllString(query, "<number>")
+	query = strings.Join(strings.Fields(query), " ")
+
+	if runes := []rune(query); len(runes) > MaxAnalyticsQueryLength {
+		query = strings.TrimSpace(string(runes[:MaxAnalyticsQueryLength]))
+	}
+	return query
+}
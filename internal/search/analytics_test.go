@@ -0,0 +1,16 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	require.Equal(t, "go 并发 模式", NormalizeQuery("  Go   并发\t模式 "))
+	require.Equal(t, "联系 <email> 或 <number>", NormalizeQuery("联系 Someone.Name@Example.com 或 13800138000"))
+	require.Equal(t, "http 2024", NormalizeQuery("HTTP 2024"))
+	require.Len(t, []rune(NormalizeQuery(strings.Repeat("缓存", 80))), MaxAnalyticsQueryLength)
+	require.Empty(t, NormalizeQuery("   "))
+}
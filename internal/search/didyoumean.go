@@ -0,0 +1,123 @@
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+// Correct 在词表中查找与查询编辑距离最近的词，整体无匹配时逐个纠正分词后的片段
+func Correct(input string, vocabulary []string, limit int) []string {
+	query := strings.ToLower(strings.TrimSpace(input))
+	if query == "" || limit <= 0 || len(vocabulary) == 0 {
+		return nil
+	}
+
+	candidates, exact := closestTerms(query, vocabulary)
+	if exact {
+		return nil
+	}
+	if len(candidates) > 0 {
+		if len(candidates) > limit {
+			candidates = candidates[:limit]
+		}
+		return candidates
+	}
+
+	var corrected []string
+	changed := false
+	for _, segment := range segmenter.Cut(query, true) {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+		terms, exact := closestTerms(segment, vocabulary)
+		if !exact && len(terms) > 0 {
+			segment = terms[0]
+			changed = true
+		}
+		corrected = append(corrected, segment)
+	}
+	if !changed {
+		return nil
+	}
+	return []string{strings.Join(corrected, " ")}
+}
+
+// closestTerms 返回距离阈值内的候选词，按距离和字典序排序，exact 表示词表中已有该词
+func closestTerms(query string, vocabulary []string) (terms []string, exact bool) {
+	queryRunes := []rune(query)
+	threshold := maxEditDistance(len(queryRunes))
+	if threshold == 0 {
+		for _, term := range vocabulary {
+			if strings.ToLower(term) == query {
+				return nil, true
+			}
+		}
+		return nil, false
+	}
+
+	type candidate struct {
+		term     string
+		distance int
+	}
+	var candidates []candidate
+	seen := make(map[string]bool)
+	for _, term := range vocabulary {
+		lowered := strings.ToLower(strings.TrimSpace(term))
+		if lowered == "" || seen[lowered] {
+			continue
+		}
+		seen[lowered] = true
+
+		distance := editDistance(queryRunes, []rune(lowered))
+		if distance == 0 {
+			return nil, true
+		}
+		if distance <= threshold {
+			candidates = append(candidates, candidate{term: term, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].term < candidates[j].term
+	})
+	for _, c := range candidates {
+		terms = append(terms, c.term)
+	}
+	return terms, false
+}
+
+// maxEditDistance 越短的词允许的编辑距离越小，单字不做纠正
+func maxEditDistance(length int) int {
+	switch {
+	case length <= 1:
+		return 0
+	case length <= 4:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,21 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrect(t *testing.T) {
+	vocabulary := []string{"Redis", "golang", "PostgreSQL", "gin"}
+
+	require.Equal(t, []string{"golang"}, Correct("golnag", vocabulary, 3))
+	require.Equal(t, []string{"Redis"}, Correct(" REDSI ", vocabulary, 3))
+	require.Equal(t, []string{"Redis golang"}, Correct("redsi golnag", vocabulary, 3))
+	require.Equal(t, []string{"rat", "rust"}, Correct("rut", []string{"ruts", "rust", "rat", "Rust"}, 2))
+
+	require.Nil(t, Correct("Golang", vocabulary, 3))
+	require.Nil(t, Correct("g", vocabulary, 3))
+	require.Nil(t, Correct("kubernetes", vocabulary, 3))
+	require.Nil(t, Correct("golnag", nil, 3))
+}
@@ -0,0 +1,53 @@
+package search
+
+import (
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/util"
+)
+
+// Highlighter 将 PGroonga 的高亮标记替换为配置的标签，并限制片段数量
+type Highlighter struct {
+	SnippetWidth int32
+	SnippetCount int
+	OpenTag      string
+	CloseTag     string
+}
+
+// NewHighlighter 根据配置创建 Highlighter，未配置的选项使用默认值
+func NewHighlighter(config util.Config) Highlighter {
+	highlighter := Highlighter{
+		SnippetWidth: util.DefaultSearchSnippetWidth,
+		SnippetCount: util.DefaultSearchSnippetCount,
+		OpenTag:      util.DefaultSearchHighlightOpenTag,
+		CloseTag:     util.DefaultSearchHighlightCloseTag,
+	}
+	if config.SearchSnippetWidth > 0 {
+		highlighter.SnippetWidth = int32(config.SearchSnippetWidth)
+	}
+	if config.SearchSnippetCount > 0 {
+		highlighter.SnippetCount = config.SearchSnippetCount
+	}
+	if config.SearchHighlightOpenTag != "" && config.SearchHighlightCloseTag != "" {
+		highlighter.OpenTag = config.SearchHighlightOpenTag
+		highlighter.CloseTag = config.SearchHighlightCloseTag
+	}
+	return highlighter
+}
+
+// Apply 原地处理搜索结果的高亮标题与片段
+func (highlighter Highlighter) Apply(rows []db.SearchArticlesRow) {
+	for i := range rows {
+		rows[i].HighlightedTitle = util.ReplaceHighlightMarkup(rows[i].HighlightedTitle, highlighter.OpenTag, highlighter.CloseTag)
+
+		snippets := make([]string, 0, len(rows[i].Snippets))
+		for _, snippet := range rows[i].Snippets {
+			if len(snippets) >= highlighter.SnippetCount {
+				break
+			}
+			if snippet = util.ReplaceHighlightMarkup(snippet, highlighter.OpenTag, highlighter.CloseTag); snippet != "" {
+				snippets = append(snippets, snippet)
+			}
+		}
+		rows[i].Snippets = snippets
+	}
+}
@@ -0,0 +1,25 @@
+package search
+
+import (
+	"testing"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlighterApply(t *testing.T) {
+	highlighter := NewHighlighter(util.Config{SearchSnippetCount: 2, SearchHighlightOpenTag: "<em>"})
+	require.Equal(t, int32(util.DefaultSearchSnippetWidth), highlighter.SnippetWidth)
+	// 只配置一侧标签时使用默认标签，避免生成不闭合的标记
+	require.Equal(t, util.DefaultSearchHighlightOpenTag, highlighter.OpenTag)
+
+	rows := []db.SearchArticlesRow{{
+		HighlightedTitle: `<span class="keyword">Go</span> 并发`,
+		Snippets:         []string{" ", `一 <span class="keyword">Go</span>`, "二", "三"},
+	}}
+	highlighter.Apply(rows)
+
+	require.Equal(t, "<mark>Go</mark> 并发", rows[0].HighlightedTitle)
+	require.Equal(t, []string{"一 <mark>Go</mark>", "二"}, rows[0].Snippets)
+}
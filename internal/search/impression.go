@@ -0,0 +1,61 @@
+package search
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImpressionTTL 搜索结果展示后允许上报点击的时长
+const ImpressionTTL = 24 * time.Hour
+
+// impressionSignatureLabel 区分同一密钥下的其他签名用途
+const impressionSignatureLabel = "nostalgia:search-impression"
+
+var (
+	ErrInvalidImpression = errors.New("invalid search impression")
+	ErrExpiredImpression = errors.New("search impression expired")
+)
+
+// SignImpression 为一次搜索生成展示 ID，格式为 <search_id>.<unix>.<signature>，上报点击时必须携带
+func SignImpression(searchID int64, issuedAt time.Time, secret string) string {
+	payload := strconv.FormatInt(searchID, 10) + "." + strconv.FormatInt(issuedAt.Unix(), 10)
+	return payload + "." + impressionSignature(payload, secret)
+}
+
+// VerifyImpression 校验展示 ID 的签名与有效期，返回对应的搜索记录 ID
+func VerifyImpression(impression string, secret string, now time.Time) (int64, error) {
+	parts := strings.Split(impression, ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidImpression
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(impressionSignature(payload, secret))) {
+		return 0, ErrInvalidImpression
+	}
+
+	searchID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || searchID <= 0 {
+		return 0, ErrInvalidImpression
+	}
+	issuedAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidImpression
+	}
+	if now.Sub(time.Unix(issuedAt, 0)) > ImpressionTTL {
+		return 0, fmt.Errorf("%w: issued at %d", ErrExpiredImpression, issuedAt)
+	}
+	return searchID, nil
+}
+
+func impressionSignature(payload string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(impressionSignatureLabel + "\n" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
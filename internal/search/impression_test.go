@@ -0,0 +1,32 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyImpression(t *testing.T) {
+	secret := util.RandomString(32)
+	now := time.Now()
+
+	impression := SignImpression(42, now, secret)
+	searchID, err := VerifyImpression(impression, secret, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), searchID)
+
+	_, err = VerifyImpression(impression, util.RandomString(32), now)
+	require.ErrorIs(t, err, ErrInvalidImpression)
+
+	// 篡改搜索 ID 后签名不匹配
+	_, err = VerifyImpression("43"+impression[2:], secret, now)
+	require.ErrorIs(t, err, ErrInvalidImpression)
+
+	_, err = VerifyImpression("42", secret, now)
+	require.ErrorIs(t, err, ErrInvalidImpression)
+
+	_, err = VerifyImpression(impression, secret, now.Add(ImpressionTTL+time.Minute))
+	require.ErrorIs(t, err, ErrExpiredImpression)
+}
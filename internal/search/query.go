@@ -0,0 +1,182 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	SortRelevance  = "relevance"
+	SortNewest     = "newest"
+	SortMostViewed = "most_viewed"
+)
+
+// DateLayout 筛选日期的格式
+const DateLayout = "2006-01-02"
+
+var (
+	ErrInvalidSort      = errors.New("invalid search sort")
+	ErrInvalidDate      = errors.New("invalid search date")
+	ErrInvalidDateRange = errors.New("search end date is before start date")
+)
+
+// Filter 搜索筛选条件，日期按 Location 解析，结束日期包含当天
+type Filter struct {
+	Keyword    string
+	CategoryID int64
+	Tag        string
+	StartDate  string
+	EndDate    string
+	Sort       string
+	IsPublish  pgtype.Bool
+	// Location 为空时使用 UTC，年份分面使用同一时区
+	Location *time.Location
+}
+
+// Query 解析后的搜索条件，用于生成各查询的参数
+type Query struct {
+	Keyword    string
+	CategoryID pgtype.Int8
+	Tag        pgtype.Text
+	StartTime  pgtype.Timestamptz
+	EndTime    pgtype.Timestamptz
+	SortBy     string
+	IsPublish  pgtype.Bool
+	TimeZone   string
+}
+
+// ParseFilter 校验筛选条件并对关键词分词
+func ParseFilter(filter Filter) (Query, error) {
+	location := filter.Location
+	if location == nil {
+		location = time.UTC
+	}
+	query := Query{
+		Keyword:   BuildKeyword(filter.Keyword),
+		SortBy:    filter.Sort,
+		IsPublish: filter.IsPublish,
+		TimeZone:  location.String(),
+	}
+
+	switch query.SortBy {
+	case "":
+		query.SortBy = SortRelevance
+	case SortRelevance, SortNewest, SortMostViewed:
+	default:
+		return Query{}, fmt.Errorf("%w: %q", ErrInvalidSort, filter.Sort)
+	}
+
+	if filter.CategoryID > 0 {
+		query.CategoryID = pgtype.Int8{Int64: filter.CategoryID, Valid: true}
+	}
+	if tag := strings.TrimSpace(filter.Tag); tag != "" {
+		query.Tag = pgtype.Text{String: tag, Valid: true}
+	}
+
+	var startDate, endDate time.Time
+	var err error
+	if filter.StartDate != "" {
+		if startDate, err = time.ParseInLocation(DateLayout, filter.StartDate, location); err != nil {
+			return Query{}, fmt.Errorf("%w: %q", ErrInvalidDate, filter.StartDate)
+		}
+		query.StartTime = pgtype.Timestamptz{Time: startDate, Valid: true}
+	}
+	if filter.EndDate != "" {
+		if endDate, err = time.ParseInLocation(DateLayout, filter.EndDate, location); err != nil {
+			return Query{}, fmt.Errorf("%w: %q", ErrInvalidDate, filter.EndDate)
+		}
+		if query.StartTime.Valid && endDate.Before(startDate) {
+			return Query{}, ErrInvalidDateRange
+		}
+		query.EndTime = pgtype.Timestamptz{Time: endDate.AddDate(0, 0, 1), Valid: true}
+	}
+
+	return query, nil
+}
+
+func (query Query) searchParams(limit, offset, snippetWidth int32) db.SearchArticlesParams {
+	return db.SearchArticlesParams{
+		Limit:        limit,
+		Offset:       offset,
+		Keyword:      query.Keyword,
+		SnippetWidth: snippetWidth,
+		IsPublish:    query.IsPublish,
+		CategoryID:   query.CategoryID,
+		Tag:          query.Tag,
+		StartTime:    query.StartTime,
+		EndTime:      query.EndTime,
+		SortBy:       query.SortBy,
+	}
+}
+
+func (query Query) countParams() db.CountSearchArticlesParams {
+	return db.CountSearchArticlesParams{
+		Keyword:    query.Keyword,
+		IsPublish:  query.IsPublish,
+		CategoryID: query.CategoryID,
+		Tag:        query.Tag,
+		StartTime:  query.StartTime,
+		EndTime:    query.EndTime,
+	}
+}
+
+// Result 一页搜索结果、总数与分面统计
+type Result struct {
+	Articles   []db.SearchArticlesRow
+	Count      int64
+	Categories []db.ListSearchArticleCategoryFacetsRow
+	Years      []db.ListSearchArticleYearFacetsRow
+}
+
+// Search 执行搜索并统计分面，没有命中时跳过分面查询
+func Search(ctx context.Context, store db.Querier, query Query, limit, offset int32, highlighter Highlighter) (Result, error) {
+	result := Result{
+		Categories: []db.ListSearchArticleCategoryFacetsRow{},
+		Years:      []db.ListSearchArticleYearFacetsRow{},
+	}
+
+	articles, err := store.SearchArticles(ctx, query.searchParams(limit, offset, highlighter.SnippetWidth))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to search articles: %w", err)
+	}
+	highlighter.Apply(articles)
+	result.Articles = articles
+
+	result.Count, err = store.CountSearchArticles(ctx, query.countParams())
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to count search articles: %w", err)
+	}
+	if result.Count == 0 {
+		return result, nil
+	}
+
+	result.Categories, err = store.ListSearchArticleCategoryFacets(ctx, db.ListSearchArticleCategoryFacetsParams{
+		Keyword:   query.Keyword,
+		IsPublish: query.IsPublish,
+		Tag:       query.Tag,
+		StartTime: query.StartTime,
+		EndTime:   query.EndTime,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to list category facets: %w", err)
+	}
+
+	result.Years, err = store.ListSearchArticleYearFacets(ctx, db.ListSearchArticleYearFacetsParams{
+		TimeZone:   query.TimeZone,
+		Keyword:    query.Keyword,
+		IsPublish:  query.IsPublish,
+		CategoryID: query.CategoryID,
+		Tag:        query.Tag,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to list year facets: %w", err)
+	}
+
+	return result, nil
+}
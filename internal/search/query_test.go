@@ -0,0 +1,63 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	query, err := ParseFilter(Filter{Keyword: "Go", IsPublish: pgtype.Bool{Bool: true, Valid: true}})
+	require.NoError(t, err)
+	require.Equal(t, `"go"`, query.Keyword)
+	require.Equal(t, SortRelevance, query.SortBy)
+	require.False(t, query.CategoryID.Valid)
+	require.False(t, query.Tag.Valid)
+	require.False(t, query.StartTime.Valid)
+	require.True(t, query.IsPublish.Valid)
+	require.Equal(t, "UTC", query.TimeZone)
+
+	query, err = ParseFilter(Filter{
+		Keyword:    "Go",
+		CategoryID: 2,
+		Tag:        " golang ",
+		StartDate:  "2024-03-01",
+		EndDate:    "2024-03-01",
+		Sort:       SortNewest,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Int8{Int64: 2, Valid: true}, query.CategoryID)
+	require.Equal(t, pgtype.Text{String: "golang", Valid: true}, query.Tag)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), query.StartTime.Time)
+	// 结束日期包含当天
+	require.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), query.EndTime.Time)
+	require.Equal(t, SortNewest, query.SortBy)
+	require.False(t, query.IsPublish.Valid)
+
+	// 日期与年份分面使用同一时区
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	query, err = ParseFilter(Filter{Keyword: "Go", StartDate: "2024-03-01", Location: shanghai})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, shanghai), query.StartTime.Time)
+	require.Equal(t, "Asia/Shanghai", query.TimeZone)
+
+	_, err = ParseFilter(Filter{Keyword: "Go", Sort: "random"})
+	require.ErrorIs(t, err, ErrInvalidSort)
+
+	_, err = ParseFilter(Filter{Keyword: "Go", StartDate: "2024/03/01"})
+	require.ErrorIs(t, err, ErrInvalidDate)
+
+	_, err = ParseFilter(Filter{Keyword: "Go", StartDate: "2024-03-02", EndDate: "2024-03-01"})
+	require.ErrorIs(t, err, ErrInvalidDateRange)
+}
+
+func TestLocation(t *testing.T) {
+	require.Equal(t, time.UTC, Location(util.Config{}))
+	require.Equal(t, time.UTC, Location(util.Config{SearchTimezone: "Local"}))
+	require.Equal(t, time.UTC, Location(util.Config{SearchTimezone: "Mars/Olympus"}))
+	require.Equal(t, "Asia/Shanghai", Location(util.Config{SearchTimezone: " Asia/Shanghai "}).String())
+}
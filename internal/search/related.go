@@ -0,0 +1,25 @@
+package search
+
+import "unicode/utf8"
+
+// MaxRelatedTerms 相关文章查询最多使用的检索词数量
+const MaxRelatedTerms = 12
+
+// BuildRelatedKeyword 使用文章标题和摘要组装相关文章的 PGroonga 查询语句。
+// 单字片段多为虚词，会被丢弃；没有可用检索词时返回空字符串，此时只按标签和分类推荐
+func BuildRelatedKeyword(title, summary string) string {
+	var terms []string
+	for _, term := range segmentTerms(normalizeKeyword(title + " " + summary)) {
+		if utf8.RuneCountInString(term) < 2 {
+			continue
+		}
+		terms = append(terms, term)
+		if len(terms) == MaxRelatedTerms {
+			break
+		}
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	return joinTerms(terms)
+}
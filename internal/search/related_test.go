@@ -0,0 +1,20 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRelatedKeyword(t *testing.T) {
+	require.Equal(t, `"go" OR "并发" OR "redis"`, BuildRelatedKeyword("Go 并发", "Redis 的 a"))
+	require.Empty(t, BuildRelatedKeyword("的 a", " ！"))
+
+	var words []string
+	for i := 0; i < MaxRelatedTerms+5; i++ {
+		words = append(words, "term"+string(rune('a'+i)))
+	}
+	summary := strings.Join(words, " ")
+	require.Len(t, strings.Split(BuildRelatedKeyword("", summary), " OR "), MaxRelatedTerms)
+}
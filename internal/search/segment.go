@@ -0,0 +1,68 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/go-ego/gse"
+	"golang.org/x/text/unicode/norm"
+)
+
+var segmenter gse.Segmenter
+
+var termEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func init() {
+	segmenter.LoadDict()
+}
+
+// BuildKeyword 使用分词结果组装 PGroonga 查询语句。
+// 索引使用默认的 TokenBigram 分词与 NormalizerAuto 规范化，这里先做同样的 NFKC 规范化和小写转换，
+// 丢弃纯标点片段，并为每个词加上引号，避免 -、OR、括号等字符被解析为查询语法
+func BuildKeyword(input string) string {
+	normalized := normalizeKeyword(input)
+
+	terms := segmentTerms(normalized)
+	if len(terms) == 0 {
+		return quoteTerm(strings.TrimSpace(normalized))
+	}
+	return joinTerms(terms)
+}
+
+func normalizeKeyword(input string) string {
+	return strings.ToLower(norm.NFKC.String(input))
+}
+
+// segmentTerms 返回去重后的检索词，保持分词顺序
+func segmentTerms(normalized string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, segment := range segmenter.Cut(normalized, true) {
+		segment = strings.TrimSpace(segment)
+		if !isSearchTerm(segment) || seen[segment] {
+			continue
+		}
+		seen[segment] = true
+		terms = append(terms, segment)
+	}
+	return terms
+}
+
+func joinTerms(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, quoteTerm(term))
+	}
+	return strings.Join(quoted, " OR ")
+}
+
+// isSearchTerm 至少包含一个字母或数字的片段才参与检索
+func isSearchTerm(segment string) bool {
+	return strings.IndexFunc(segment, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsNumber(r)
+	}) >= 0
+}
+
+func quoteTerm(term string) string {
+	return `"` + termEscaper.Replace(term) + `"`
+}
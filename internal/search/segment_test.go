@@ -0,0 +1,18 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildKeyword(t *testing.T) {
+	require.Equal(t, `"go"`, BuildKeyword("Go"))
+	require.Equal(t, `"go" OR "并发"`, BuildKeyword("Go 并发"))
+	// 全角字符与索引一样先做 NFKC 规范化
+	require.Equal(t, `"go" OR "redis"`, BuildKeyword("ＧＯ，Redis go"))
+	// 查询语法字符不会泄漏到 PGroonga 查询中
+	require.Equal(t, `"c" OR "or"`, BuildKeyword("-c ( OR )"))
+	require.Equal(t, `"+++"`, BuildKeyword(" +++ "))
+	require.Equal(t, `"a\"b"`, quoteTerm(`a"b`))
+}
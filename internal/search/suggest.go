@@ -0,0 +1,99 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultDidYouMeanLimit 零结果时最多返回的纠正建议数
+const DefaultDidYouMeanLimit = 3
+
+// Suggester 提供输入联想与拼写纠正，结果缓存在 Redis 中，缓存异常时降级为仅数据库
+type Suggester struct {
+	store db.Querier
+	cache *cachepkg.SearchCache
+}
+
+func NewSuggester(store db.Querier, cache cachepkg.Cache) *Suggester {
+	return &Suggester{store: store, cache: cachepkg.NewSearchCache(cache)}
+}
+
+// Suggest 按前缀联想已发布文章的标题与标签
+func (suggester *Suggester) Suggest(ctx context.Context, prefix string, limit int32) (cachepkg.SearchSuggestions, error) {
+	prefix = strings.TrimSpace(prefix)
+
+	suggestions, ok, err := suggester.cache.GetSuggestions(ctx, prefix, limit)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.GetSearchSuggestKey(prefix, limit)).
+			Str("module", "search").
+			Str("action", "cache_get").
+			Msg("获取搜索联想缓存失败，降级为仅数据库")
+	}
+	if ok {
+		return suggestions, nil
+	}
+
+	titles, err := suggester.store.SuggestArticleTitles(ctx, db.SuggestArticleTitlesParams{
+		Prefix:     prefix,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return cachepkg.SearchSuggestions{}, fmt.Errorf("failed to suggest article titles: %w", err)
+	}
+	tags, err := suggester.store.SuggestTags(ctx, db.SuggestTagsParams{
+		Prefix:     prefix,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return cachepkg.SearchSuggestions{}, fmt.Errorf("failed to suggest tags: %w", err)
+	}
+	suggestions = cachepkg.SearchSuggestions{Titles: titles, Tags: tags}
+
+	if err := suggester.cache.SetSuggestions(ctx, prefix, limit, suggestions); err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.GetSearchSuggestKey(prefix, limit)).
+			Str("module", "search").
+			Str("action", "cache_set").
+			Msg("写入搜索联想缓存失败")
+	}
+
+	return suggestions, nil
+}
+
+// DidYouMean 使用标签与分类名组成的词表为零结果查询给出纠正建议
+func (suggester *Suggester) DidYouMean(ctx context.Context, keyword string) ([]string, error) {
+	vocabulary, ok, err := suggester.cache.GetVocabulary(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key.SearchVocabularyKey).
+			Str("module", "search").
+			Str("action", "cache_get").
+			Msg("获取搜索词表缓存失败，降级为仅数据库")
+	}
+	if !ok {
+		vocabulary, err = suggester.store.ListSearchVocabulary(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list search vocabulary: %w", err)
+		}
+		if err := suggester.cache.SetVocabulary(ctx, vocabulary); err != nil {
+			log.Error().
+				Err(err).
+				Str("key", key.SearchVocabularyKey).
+				Str("module", "search").
+				Str("action", "cache_set").
+				Msg("写入搜索词表缓存失败")
+		}
+	}
+
+	return Correct(keyword, vocabulary, DefaultDidYouMeanLimit), nil
+}
@@ -0,0 +1,71 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestLoadsAndCachesOnMiss(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	cache := mockcache.NewMockCache(ctrl)
+
+	cacheKey := key.GetSearchSuggestKey("red", 5)
+	cache.EXPECT().Get(gomock.Any(), cacheKey, gomock.Any()).Return(false, nil)
+	store.EXPECT().
+		SuggestArticleTitles(gomock.Any(), db.SuggestArticleTitlesParams{Prefix: "red", MaxResults: 5}).
+		Return([]string{"Redis 缓存实践"}, nil)
+	store.EXPECT().
+		SuggestTags(gomock.Any(), db.SuggestTagsParams{Prefix: "red", MaxResults: 5}).
+		Return([]db.SuggestTagsRow{{Name: "redis", ArticleCount: 2}}, nil)
+	cache.EXPECT().Set(gomock.Any(), cacheKey, gomock.Any(), gomock.Any()).Return(nil)
+
+	suggestions, err := NewSuggester(store, cache).Suggest(context.Background(), " red ", 5)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"Redis 缓存实践"}, suggestions.Titles)
+	require.Equal(t, "redis", suggestions.Tags[0].Name)
+}
+
+func TestSuggestUsesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	cache := mockcache.NewMockCache(ctrl)
+
+	cache.EXPECT().
+		Get(gomock.Any(), key.GetSearchSuggestKey("go", 5), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, dest any) (bool, error) {
+			*dest.(*cachepkg.SearchSuggestions) = cachepkg.SearchSuggestions{Titles: []string{"Go 并发"}}
+			return true, nil
+		})
+	store.EXPECT().SuggestArticleTitles(gomock.Any(), gomock.Any()).Times(0)
+
+	suggestions, err := NewSuggester(store, cache).Suggest(context.Background(), "go", 5)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"Go 并发"}, suggestions.Titles)
+}
+
+func TestDidYouMeanDegradesOnCacheError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	cache := mockcache.NewMockCache(ctrl)
+
+	cache.EXPECT().Get(gomock.Any(), key.SearchVocabularyKey, gomock.Any()).Return(false, errors.New("redis down"))
+	store.EXPECT().ListSearchVocabulary(gomock.Any()).Return([]string{"golang", "redis"}, nil)
+	cache.EXPECT().Set(gomock.Any(), key.SearchVocabularyKey, gomock.Any(), gomock.Any()).Return(errors.New("redis down"))
+
+	suggestions, err := NewSuggester(store, cache).DidYouMean(context.Background(), "golnag")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"golang"}, suggestions)
+}
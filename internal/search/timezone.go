@@ -0,0 +1,33 @@
+package search
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/rs/zerolog/log"
+)
+
+// locations 按名称缓存已加载的时区，避免每次搜索都读取时区数据
+var locations sync.Map
+
+// Location 返回日期筛选与年份分面共用的时区，未配置或无法识别时使用 UTC。
+// 年份分面在数据库中按时区名称换算，因此只接受 IANA 名称，不接受 Local
+func Location(config util.Config) *time.Location {
+	name := strings.TrimSpace(config.SearchTimezone)
+	if name == "" || name == "UTC" {
+		return time.UTC
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		log.Warn().Err(err).Str("timezone", name).Msg("invalid search timezone, falling back to UTC")
+		loc = time.UTC
+	}
+	locations.Store(name, loc)
+	return loc
+}
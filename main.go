@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"errors"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
 	"github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
 	"net"
 	"net/http"
 	"os"
@@ -85,15 +87,30 @@ func main() {
 	}
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
+	taskInspector := worker.NewRedisTaskInspector(redisOpt)
 
 	redisCache := cache.NewRedisCache(config)
+	// 文章详情与列表先读进程内缓存，失效通过 Redis 发布订阅同步到所有实例
+	localCache := cache.NewLocalCache(config, redisCache, redisCache)
+
+	// gRPC 服务与后台任务共用同一份 AI 配置解析
+	aiConfigs := ai.NewConfigResolver(config, store)
 
 	waitGroup, ctx := errgroup.WithContext(ctx)
 
-	runTaskProcessor(ctx, waitGroup, config, redisOpt, store, redisCache)
-	runGatewayServer(ctx, waitGroup, config, store, taskDistributor, redisCache)
-	runGrpcServer(ctx, waitGroup, config, store, taskDistributor, redisCache)
-	runGinServer(ctx, waitGroup, config, store, taskDistributor, redisCache)
+	runLocalCacheInvalidation(ctx, waitGroup, localCache)
+
+	runTaskProcessor(ctx, waitGroup, config, redisOpt, store, localCache, aiConfigs)
+	runScheduler(ctx, waitGroup, config, redisOpt)
+	runGatewayServer(ctx, waitGroup, config, store, taskDistributor, taskInspector, localCache, aiConfigs)
+	runGrpcServer(ctx, waitGroup, config, store, taskDistributor, taskInspector, localCache, aiConfigs)
+	runGinServer(ctx, waitGroup, config, store, taskDistributor, localCache)
+
+	// 部署后预热首页列表与热门文章，多实例同时启动时只会执行一次
+	err = taskDistributor.DistributeTaskWarmCacheDefault(ctx, worker.NewPayloadWarmCache(config))
+	if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+		log.Error().Err(err).Msg("failed to distribute cache warm task")
+	}
 
 	err = waitGroup.Wait()
 	if err != nil {
@@ -114,9 +131,9 @@ func runDBMigration(migrationURL string, dbSource string) {
 	log.Info().Msg("db migrated successfully")
 }
 
-func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, cache cache.Cache) {
+func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, cache cache.Cache, aiConfigs *ai.ConfigResolver) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, cache, mailer)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, config, store, cache, mailer, aiConfigs.TextPolisher, indexnow.NewClient(config))
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
@@ -134,8 +151,39 @@ func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config uti
 	})
 }
 
-func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributor worker.TaskDistributor, cache cache.Cache) {
-	server, err := gapi.NewServer(config, store, taskDistributor, cache)
+// runLocalCacheInvalidation 订阅失效通知，订阅失败时本地缓存只依赖 TTL 过期，不影响服务启动
+func runLocalCacheInvalidation(ctx context.Context, waitGroup *errgroup.Group, localCache *cache.LocalCache) {
+	waitGroup.Go(func() error {
+		if err := localCache.Run(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to subscribe local cache invalidation")
+		}
+		return nil
+	})
+}
+
+// runScheduler 按配置定时投递计数写回、缓存预热与清理任务，多实例部署时由持有租约的实例负责投递。
+// 调度器关闭时仍投递计数写回，否则浏览与点赞增量无法落库
+func runScheduler(ctx context.Context, waitGroup *errgroup.Group, config util.Config, redisOpt asynq.RedisClientOpt) {
+	jobs, err := worker.PeriodicJobs(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid periodic jobs config")
+	}
+	if !config.SchedulerEnabled {
+		log.Info().Msg("periodic scheduler is disabled, only required jobs are scheduled")
+		jobs = worker.RequiredPeriodicJobs(jobs)
+	}
+
+	scheduler := worker.NewPeriodicScheduler(redisOpt, jobs)
+	waitGroup.Go(func() error {
+		log.Info().Msg("start periodic scheduler")
+		err := scheduler.Run(ctx)
+		log.Info().Msg("periodic scheduler is stopped")
+		return err
+	})
+}
+
+func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector, cache cache.Cache, aiConfigs *ai.ConfigResolver) {
+	server, err := gapi.NewServer(config, store, taskDistributor, taskInspector, cache, aiConfigs)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server:")
 	}
@@ -177,8 +225,8 @@ func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, config util.C
 	})
 }
 
-func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributor worker.TaskDistributor, cache cache.Cache) {
-	server, err := gapi.NewServer(config, store, taskDistributor, cache)
+func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector, cache cache.Cache, aiConfigs *ai.ConfigResolver) {
+	server, err := gapi.NewServer(config, store, taskDistributor, taskInspector, cache, aiConfigs)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server:")
 	}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v3.12.4
+// source: rpc_generate_article_metadata.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GenerateArticleMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateArticleMetadataRequest) Reset() {
+	*x = GenerateArticleMetadataRequest{}
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateArticleMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateArticleMetadataRequest) ProtoMessage() {}
+
+func (x *GenerateArticleMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateArticleMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GenerateArticleMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_article_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateArticleMetadataRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *GenerateArticleMetadataRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ArticleCategoryCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleCategoryCandidate) Reset() {
+	*x = ArticleCategoryCandidate{}
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleCategoryCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleCategoryCandidate) ProtoMessage() {}
+
+func (x *ArticleCategoryCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleCategoryCandidate.ProtoReflect.Descriptor instead.
+func (*ArticleCategoryCandidate) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_article_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArticleCategoryCandidate) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ArticleCategoryCandidate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArticleCategoryCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GenerateArticleMetadataResponse struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Slugs           []*PolishSuggestion         `protobuf:"bytes,1,rep,name=slugs,proto3" json:"slugs,omitempty"`
+	SeoDescriptions []*PolishSuggestion         `protobuf:"bytes,2,rep,name=seo_descriptions,json=seoDescriptions,proto3" json:"seo_descriptions,omitempty"`
+	Tags            []*PolishSuggestion         `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	CoverAlts       []*PolishSuggestion         `protobuf:"bytes,4,rep,name=cover_alts,json=coverAlts,proto3" json:"cover_alts,omitempty"`
+	Categories      []*ArticleCategoryCandidate `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Model           string                      `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateArticleMetadataResponse) Reset() {
+	*x = GenerateArticleMetadataResponse{}
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateArticleMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateArticleMetadataResponse) ProtoMessage() {}
+
+func (x *GenerateArticleMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_article_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateArticleMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GenerateArticleMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_article_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateArticleMetadataResponse) GetSlugs() []*PolishSuggestion {
+	if x != nil {
+		return x.Slugs
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataResponse) GetSeoDescriptions() []*PolishSuggestion {
+	if x != nil {
+		return x.SeoDescriptions
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataResponse) GetTags() []*PolishSuggestion {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataResponse) GetCoverAlts() []*PolishSuggestion {
+	if x != nil {
+		return x.CoverAlts
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataResponse) GetCategories() []*ArticleCategoryCandidate {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GenerateArticleMetadataResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+var File_rpc_generate_article_metadata_proto protoreflect.FileDescriptor
+
+var file_rpc_generate_article_metadata_proto_rawDesc = string([]byte{
+	0x0a, 0x23, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x6f, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0x67, 0x0a, 0x18, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x1f, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x05, 0x73, 0x6c, 0x75, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x6c, 0x75, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x73, 0x65,
+	0x6f, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x65, 0x6f, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61,
+	0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x26,
+	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e, 0x2f, 0x6e, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x67, 0x69, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_generate_article_metadata_proto_rawDescOnce sync.Once
+	file_rpc_generate_article_metadata_proto_rawDescData []byte
+)
+
+func file_rpc_generate_article_metadata_proto_rawDescGZIP() []byte {
+	file_rpc_generate_article_metadata_proto_rawDescOnce.Do(func() {
+		file_rpc_generate_article_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_generate_article_metadata_proto_rawDesc), len(file_rpc_generate_article_metadata_proto_rawDesc)))
+	})
+	return file_rpc_generate_article_metadata_proto_rawDescData
+}
+
+var file_rpc_generate_article_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_generate_article_metadata_proto_goTypes = []any{
+	(*GenerateArticleMetadataRequest)(nil),  // 0: pb.GenerateArticleMetadataRequest
+	(*ArticleCategoryCandidate)(nil),        // 1: pb.ArticleCategoryCandidate
+	(*GenerateArticleMetadataResponse)(nil), // 2: pb.GenerateArticleMetadataResponse
+	(*PolishSuggestion)(nil),                // 3: pb.PolishSuggestion
+}
+var file_rpc_generate_article_metadata_proto_depIdxs = []int32{
+	3, // 0: pb.GenerateArticleMetadataResponse.slugs:type_name -> pb.PolishSuggestion
+	3, // 1: pb.GenerateArticleMetadataResponse.seo_descriptions:type_name -> pb.PolishSuggestion
+	3, // 2: pb.GenerateArticleMetadataResponse.tags:type_name -> pb.PolishSuggestion
+	3, // 3: pb.GenerateArticleMetadataResponse.cover_alts:type_name -> pb.PolishSuggestion
+	1, // 4: pb.GenerateArticleMetadataResponse.categories:type_name -> pb.ArticleCategoryCandidate
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_rpc_generate_article_metadata_proto_init() }
+func file_rpc_generate_article_metadata_proto_init() {
+	if File_rpc_generate_article_metadata_proto != nil {
+		return
+	}
+	file_rpc_polish_text_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_generate_article_metadata_proto_rawDesc), len(file_rpc_generate_article_metadata_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_generate_article_metadata_proto_goTypes,
+		DependencyIndexes: file_rpc_generate_article_metadata_proto_depIdxs,
+		MessageInfos:      file_rpc_generate_article_metadata_proto_msgTypes,
+	}.Build()
+	File_rpc_generate_article_metadata_proto = out.File
+	file_rpc_generate_article_metadata_proto_goTypes = nil
+	file_rpc_generate_article_metadata_proto_depIdxs = nil
+}
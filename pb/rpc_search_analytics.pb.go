@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v3.12.4
+// source: rpc_search_analytics.proto
+
+package pb
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSearchAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 不传时统计最近 7 天
+	StartTime     *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit         int32                `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchAnalyticsRequest) Reset() {
+	*x = GetSearchAnalyticsRequest{}
+	mi := &file_rpc_search_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSearchAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_search_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSearchAnalyticsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchQueryStat struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Query            string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches         int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	ClickedSearches  int64                  `protobuf:"varint,3,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"`
+	ClickThroughRate float64                `protobuf:"fixed64,4,opt,name=click_through_rate,json=clickThroughRate,proto3" json:"click_through_rate,omitempty"`
+	AvgResultCount   float64                `protobuf:"fixed64,5,opt,name=avg_result_count,json=avgResultCount,proto3" json:"avg_result_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchQueryStat) Reset() {
+	*x = SearchQueryStat{}
+	mi := &file_rpc_search_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQueryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQueryStat) ProtoMessage() {}
+
+func (x *SearchQueryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQueryStat.ProtoReflect.Descriptor instead.
+func (*SearchQueryStat) Descriptor() ([]byte, []int) {
+	return file_rpc_search_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchQueryStat) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQueryStat) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetClickThroughRate() float64 {
+	if x != nil {
+		return x.ClickThroughRate
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetAvgResultCount() float64 {
+	if x != nil {
+		return x.AvgResultCount
+	}
+	return 0
+}
+
+type ZeroResultQueryStat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches       int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	LastSearchedAt *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_searched_at,json=lastSearchedAt,proto3" json:"last_searched_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ZeroResultQueryStat) Reset() {
+	*x = ZeroResultQueryStat{}
+	mi := &file_rpc_search_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZeroResultQueryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZeroResultQueryStat) ProtoMessage() {}
+
+func (x *ZeroResultQueryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZeroResultQueryStat.ProtoReflect.Descriptor instead.
+func (*ZeroResultQueryStat) Descriptor() ([]byte, []int) {
+	return file_rpc_search_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ZeroResultQueryStat) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ZeroResultQueryStat) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *ZeroResultQueryStat) GetLastSearchedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSearchedAt
+	}
+	return nil
+}
+
+type GetSearchAnalyticsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StartTime          *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Searches           int64                  `protobuf:"varint,3,opt,name=searches,proto3" json:"searches,omitempty"`
+	ZeroResultSearches int64                  `protobuf:"varint,4,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	ClickedSearches    int64                  `protobuf:"varint,5,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"`
+	ClickThroughRate   float64                `protobuf:"fixed64,6,opt,name=click_through_rate,json=clickThroughRate,proto3" json:"click_through_rate,omitempty"`
+	AvgClickPosition   float64                `protobuf:"fixed64,7,opt,name=avg_click_position,json=avgClickPosition,proto3" json:"avg_click_position,omitempty"`
+	TopQueries         []*SearchQueryStat     `protobuf:"bytes,8,rep,name=top_queries,json=topQueries,proto3" json:"top_queries,omitempty"`
+	ZeroResultQueries  []*ZeroResultQueryStat `protobuf:"bytes,9,rep,name=zero_result_queries,json=zeroResultQueries,proto3" json:"zero_result_queries,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSearchAnalyticsResponse) Reset() {
+	*x = GetSearchAnalyticsResponse{}
+	mi := &file_rpc_search_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSearchAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_search_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSearchAnalyticsResponse) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsResponse) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsResponse) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *GetSearchAnalyticsResponse) GetZeroResultSearches() int64 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *GetSearchAnalyticsResponse) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *GetSearchAnalyticsResponse) GetClickThroughRate() float64 {
+	if x != nil {
+		return x.ClickThroughRate
+	}
+	return 0
+}
+
+func (x *GetSearchAnalyticsResponse) GetAvgClickPosition() float64 {
+	if x != nil {
+		return x.AvgClickPosition
+	}
+	return 0
+}
+
+func (x *GetSearchAnalyticsResponse) GetTopQueries() []*SearchQueryStat {
+	if x != nil {
+		return x.TopQueries
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsResponse) GetZeroResultQueries() []*ZeroResultQueryStat {
+	if x != nil {
+		return x.ZeroResultQueries
+	}
+	return nil
+}
+
+var File_rpc_search_analytics_proto protoreflect.FileDescriptor
+
+var file_rpc_search_analytics_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x8d, 0x01, 0x0a, 0x13, 0x5a, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xe2, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x7a, 0x65, 0x72,
+	0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x67, 0x5f,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x76, 0x67, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x0a, 0x74, 0x6f, 0x70, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x13,
+	0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x5a,
+	0x65, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x11, 0x7a, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e,
+	0x2f, 0x6e, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x67, 0x69, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_search_analytics_proto_rawDescOnce sync.Once
+	file_rpc_search_analytics_proto_rawDescData []byte
+)
+
+func file_rpc_search_analytics_proto_rawDescGZIP() []byte {
+	file_rpc_search_analytics_proto_rawDescOnce.Do(func() {
+		file_rpc_search_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_search_analytics_proto_rawDesc), len(file_rpc_search_analytics_proto_rawDesc)))
+	})
+	return file_rpc_search_analytics_proto_rawDescData
+}
+
+var file_rpc_search_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_search_analytics_proto_goTypes = []any{
+	(*GetSearchAnalyticsRequest)(nil),  // 0: pb.GetSearchAnalyticsRequest
+	(*SearchQueryStat)(nil),            // 1: pb.SearchQueryStat
+	(*ZeroResultQueryStat)(nil),        // 2: pb.ZeroResultQueryStat
+	(*GetSearchAnalyticsResponse)(nil), // 3: pb.GetSearchAnalyticsResponse
+	(*timestamp.Timestamp)(nil),        // 4: google.protobuf.Timestamp
+}
+var file_rpc_search_analytics_proto_depIdxs = []int32{
+	4, // 0: pb.GetSearchAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	4, // 1: pb.GetSearchAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	4, // 2: pb.ZeroResultQueryStat.last_searched_at:type_name -> google.protobuf.Timestamp
+	4, // 3: pb.GetSearchAnalyticsResponse.start_time:type_name -> google.protobuf.Timestamp
+	4, // 4: pb.GetSearchAnalyticsResponse.end_time:type_name -> google.protobuf.Timestamp
+	1, // 5: pb.GetSearchAnalyticsResponse.top_queries:type_name -> pb.SearchQueryStat
+	2, // 6: pb.GetSearchAnalyticsResponse.zero_result_queries:type_name -> pb.ZeroResultQueryStat
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_search_analytics_proto_init() }
+func file_rpc_search_analytics_proto_init() {
+	if File_rpc_search_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_search_analytics_proto_rawDesc), len(file_rpc_search_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_search_analytics_proto_goTypes,
+		DependencyIndexes: file_rpc_search_analytics_proto_depIdxs,
+		MessageInfos:      file_rpc_search_analytics_proto_msgTypes,
+	}.Build()
+	File_rpc_search_analytics_proto = out.File
+	file_rpc_search_analytics_proto_goTypes = nil
+	file_rpc_search_analytics_proto_depIdxs = nil
+}
@@ -42,117 +42,256 @@ var file_service_nostalgia_proto_rawDesc = string([]byte{
 	0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x75,
 	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x65, 0x78,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xb3, 0x19, 0x0a, 0x09, 0x4e, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x67, 0x69,
-	0x61, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x4d, 0x0a, 0x07, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61,
-	0x6e, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x2b, 0x55,
-	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6e,
-	0x65, 0x77, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x73, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x3c, 0x0a, 0x07, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61,
-	0x6e, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x1e, 0x55, 0x73, 0x65, 0x20, 0x74,
-	0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
-	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x3a, 0x0a, 0x07, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x12, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x73, 0x1a, 0x20, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50,
-	0x49, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x20, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x92, 0x41, 0x3d, 0x0a, 0x07, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x10, 0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x20, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69,
-	0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
-	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x7b, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x43, 0x0a, 0x07, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x23, 0x55, 0x73, 0x65,
-	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x32, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x92, 0x41, 0x2d, 0x0a, 0x04, 0x55, 0x74, 0x69, 0x6c,
-	0x12, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x55,
-	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x73,
-	0x68, 0x54, 0x65, 0x78, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73,
-	0x68, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x92, 0x41, 0x5a, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x13, 0x70,
-	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x74, 0x65,
-	0x78, 0x74, 0x1a, 0x3f, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49,
-	0x20, 0x74, 0x6f, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x69, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x12, 0xac, 0x01, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41,
-	0x54, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x0d, 0x67, 0x65, 0x74, 0x20, 0x41, 0x49, 0x20, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41,
-	0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x20, 0x6e, 0x6f,
-	0x6e, 0x2d, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x20, 0x41, 0x49, 0x20, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xbb, 0x01, 0x0a, 0x0e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x75, 0x92, 0x41, 0x5a, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x10, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x20, 0x41, 0x49, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x42, 0x55, 0x73,
-	0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x20, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x20, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x32, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xc9, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x49, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92,
-	0x41, 0x6a, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x41, 0x49, 0x20,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x1a, 0x54, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73,
-	0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x66, 0x65, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x70, 0x65, 0x72,
-	0x73, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x72, 0x70,
+	0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x72,
+	0x70, 0x63, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x72, 0x70, 0x63, 0x5f, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72,
+	0x70, 0x63, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13,
+	0x72, 0x70, 0x63, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdd, 0x40, 0x0a, 0x09, 0x4e, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x67, 0x69, 0x61, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x4d, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x20, 0x61, 0x6e, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a,
+	0x2b, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61,
+	0x20, 0x6e, 0x65, 0x77, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x3c, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x1e, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x3a, 0x0a, 0x07, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x1a, 0x20, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0xcc, 0x01, 0x0a, 0x0e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x92, 0x41, 0x64, 0x0a, 0x07, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x1a, 0x48, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67,
+	0x20, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0xe5, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8f, 0x01, 0x92, 0x41, 0x70, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x14, 0x67, 0x65, 0x74, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x4f, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x74, 0x6f,
+	0x70, 0x20, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x2d,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x2d, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x20, 0x72, 0x61, 0x74, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x68, 0x92, 0x41, 0x3d, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x10,
+	0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f,
+	0x1a, 0x20, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74,
+	0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e,
+	0x66, 0x6f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6e, 0x65, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5d, 0x92, 0x41, 0x43, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20,
+	0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x23, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41,
+	0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a,
+	0x01, 0x2a, 0x32, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f,
+	0x92, 0x41, 0x2d, 0x0a, 0x04, 0x55, 0x74, 0x69, 0x6c, 0x12, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x41, 0x50, 0x49, 0x20, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x74, 0x69, 0x6c, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0xb2, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x65, 0x78, 0x74, 0x12, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73,
+	0x68, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x92,
+	0x41, 0x5a, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x13, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x3f, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x6f, 0x6c,
+	0x69, 0x73, 0x68, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64,
+	0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x70, 0x6f,
+	0x6c, 0x69, 0x73, 0x68, 0x12, 0xa9, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x92, 0x41, 0x90, 0x01,
+	0x0a, 0x02, 0x41, 0x49, 0x12, 0x19, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x20, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x6f, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f,
+	0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x73, 0x6c, 0x75, 0x67, 0x2c, 0x20, 0x53, 0x45, 0x4f, 0x20, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x74, 0x61, 0x67, 0x2c, 0x20,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x61, 0x6c, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x20, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x69, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0xef, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x9f, 0x01, 0x92, 0x41, 0x68, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x11, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x4f, 0x55,
+	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x20, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x20, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41, 0x54, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x0d, 0x67, 0x65,
+	0x74, 0x20, 0x41, 0x49, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x20, 0x6e, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0xbb, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x92, 0x41, 0x5a, 0x0a, 0x02, 0x41,
+	0x49, 0x12, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x41, 0x49, 0x20, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x42, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x20, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x41, 0x49,
+	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a,
+	0x32, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0xc9, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41, 0x6a, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x0e, 0x6c,
+	0x69, 0x73, 0x74, 0x20, 0x41, 0x49, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x1a, 0x54, 0x55,
+	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20,
+	0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x20, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x68, 0x65, 0x6d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0xf4, 0x01, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x92, 0x41, 0x5b, 0x0a,
+	0x02, 0x41, 0x49, 0x12, 0x1d, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x36, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49,
+	0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x41,
+	0x49, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2d,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xfe, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x90, 0x01, 0x92, 0x41, 0x69, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x1d, 0x64, 0x69, 0x66, 0x66,
+	0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x44, 0x55, 0x73, 0x65, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x20, 0x74, 0x77, 0x6f, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x62, 0x79, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0xf4, 0x01, 0x0a, 0x17, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x22, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x92, 0x41, 0x61, 0x0a, 0x02,
+	0x41, 0x49, 0x12, 0x19, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x40, 0x55,
+	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x61, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xe7, 0x01, 0x0a, 0x0d, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xa0, 0x01, 0x92, 0x41, 0x73, 0x0a, 0x02, 0x41, 0x49, 0x12, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x1a, 0x5d, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x20, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x77, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
 	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
@@ -247,99 +386,383 @@ var file_service_nostalgia_proto_rawDesc = string([]byte{
 	0x65, 0x20, 0x61, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x76, 0x69, 0x73,
 	0x69, 0x74, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
 	0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x9b, 0x01, 0x92, 0x41, 0x72, 0x12,
-	0x70, 0x0a, 0x0d, 0x4e, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x67, 0x69, 0x61, 0x20, 0x41, 0x50, 0x49,
-	0x22, 0x5a, 0x0a, 0x1b, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e,
-	0x20, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x20, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x1f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e,
-	0x1a, 0x1a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x32, 0x03, 0x31, 0x2e,
-	0x30, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e, 0x2f, 0x6e, 0x6f, 0x73, 0x74, 0x61,
-	0x6c, 0x67, 0x69, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xcb, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x60, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x68, 0x65,
+	0x6c, 0x64, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x68, 0x65, 0x6c, 0x64, 0x20,
+	0x62, 0x79, 0x20, 0x73, 0x70, 0x61, 0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x6f, 0x78, 0x69,
+	0x63, 0x69, 0x74, 0x79, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x68, 0x65, 0x6c, 0x64, 0x12, 0xb7, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71,
+	0x92, 0x41, 0x4b, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x30, 0x55, 0x73,
+	0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x20,
+	0x61, 0x20, 0x68, 0x65, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0xb9, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x92, 0x41, 0x55,
+	0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x1a, 0x38, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x6c, 0x64,
+	0x20, 0x70, 0x61, 0x74, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0xaf, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x12, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x73, 0x1a, 0x35, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0xcc, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x92, 0x41, 0x62, 0x0a,
+	0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x1a, 0x45, 0x55, 0x73, 0x65, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x6f, 0x72,
+	0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x32, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xab,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41, 0x45, 0x0a, 0x08, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x1a, 0x28, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x76, 0x92, 0x41, 0x5c, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0x0f, 0x67, 0x65, 0x74, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x1a, 0x42, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74,
+	0x6f, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x68,
+	0x69, 0x74, 0x73, 0x2c, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x0f,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x92, 0x41, 0x51, 0x0a, 0x05, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x11, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x20, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x1a, 0x35, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x54, 0x54, 0x4c, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f,
+	0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0xb6, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x92, 0x41, 0x5c, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x12, 0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x46,
+	0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x2c, 0x20,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x65, 0x79, 0x20,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x12, 0x85, 0x02, 0x0a, 0x17, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x92, 0x41, 0x6e, 0x0a, 0x05, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x1a, 0x62, 0x75, 0x6d, 0x70, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x49,
+	0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x20, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x20,
+	0x62, 0x79, 0x20, 0x62, 0x75, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72,
+	0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a,
+	0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x75, 0x6d, 0x70, 0x12, 0xd9, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x92, 0x41, 0x6c, 0x0a, 0x09,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x20,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x20, 0x6a, 0x6f, 0x62, 0x73, 0x1a, 0x4b, 0x55,
+	0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x20, 0x6a, 0x6f, 0x62,
+	0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20,
+	0x72, 0x75, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f,
+	0x6a, 0x6f, 0x62, 0x73, 0x12, 0xc3, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a,
+	0x92, 0x41, 0x60, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x20,
+	0x74, 0x61, 0x73, 0x6b, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x1a, 0x46, 0x55, 0x73, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x74, 0x61, 0x73,
+	0x6b, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x61,
+	0x73, 0x6b, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0xdf, 0x01, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x92, 0x41, 0x6d, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x20, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x1a, 0x53, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49,
+	0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x2c, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x61, 0x73, 0x74,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2f, 0x7b,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0xea, 0x01, 0x0a,
+	0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x97, 0x01, 0x92, 0x41, 0x5c, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x13, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b,
+	0x1a, 0x3f, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x74,
+	0x6f, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x74, 0x6f, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2f, 0x7b, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0xd9, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x83, 0x01, 0x92, 0x41, 0x51, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73,
+	0x6b, 0x1a, 0x33, 0x55, 0x73, 0x65, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x41, 0x50, 0x49, 0x20,
+	0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2f, 0x7b, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x9b, 0x01, 0x92, 0x41, 0x72, 0x12, 0x70, 0x0a, 0x0d, 0x4e,
+	0x6f, 0x73, 0x74, 0x61, 0x6c, 0x67, 0x69, 0x61, 0x20, 0x41, 0x50, 0x49, 0x22, 0x5a, 0x0a, 0x1b,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e, 0x20, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x20, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e, 0x1a, 0x1a, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x40, 0x67,
+	0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x5a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x41, 0x6c, 0x6c, 0x65, 0x6e, 0x2f, 0x6e, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x67, 0x69, 0x61,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_service_nostalgia_proto_goTypes = []any{
-	(*CreateArticleRequest)(nil),      // 0: pb.CreateArticleRequest
-	(*DeleteArticleRequest)(nil),      // 1: pb.DeleteArticleRequest
-	(*ListArticlesRequest)(nil),       // 2: pb.ListArticlesRequest
-	(*GetArticleRequest)(nil),         // 3: pb.GetArticleRequest
-	(*UpdateArticleRequest)(nil),      // 4: pb.UpdateArticleRequest
-	(*UploadFileRequest)(nil),         // 5: pb.UploadFileRequest
-	(*PolishTextRequest)(nil),         // 6: pb.PolishTextRequest
-	(*GetAIConfigRequest)(nil),        // 7: pb.GetAIConfigRequest
-	(*UpdateAIConfigRequest)(nil),     // 8: pb.UpdateAIConfigRequest
-	(*ListAIModelsRequest)(nil),       // 9: pb.ListAIModelsRequest
-	(*CreateCategoryRequest)(nil),     // 10: pb.CreateCategoryRequest
-	(*DeleteCategoryRequest)(nil),     // 11: pb.DeleteCategoryRequest
-	(*UpdateCategoryRequest)(nil),     // 12: pb.UpdateCategoryRequest
-	(*ListCategoriesRequest)(nil),     // 13: pb.ListCategoriesRequest
-	(*ListAllCategoriesRequest)(nil),  // 14: pb.ListAllCategoriesRequest
-	(*ListUsersRequest)(nil),          // 15: pb.ListUsersRequest
-	(*UpdateUserRequest)(nil),         // 16: pb.UpdateUserRequest
-	(*DisableUserRequest)(nil),        // 17: pb.DisableUserRequest
-	(*EnableUserRequest)(nil),         // 18: pb.EnableUserRequest
-	(*CreateArticleResponse)(nil),     // 19: pb.CreateArticleResponse
-	(*DeleteArticleResponse)(nil),     // 20: pb.DeleteArticleResponse
-	(*ListArticlesResponse)(nil),      // 21: pb.ListArticlesResponse
-	(*GetArticleResponse)(nil),        // 22: pb.GetArticleResponse
-	(*UpdateArticleResponse)(nil),     // 23: pb.UpdateArticleResponse
-	(*UploadFileResponse)(nil),        // 24: pb.UploadFileResponse
-	(*PolishTextResponse)(nil),        // 25: pb.PolishTextResponse
-	(*GetAIConfigResponse)(nil),       // 26: pb.GetAIConfigResponse
-	(*ListAIModelsResponse)(nil),      // 27: pb.ListAIModelsResponse
-	(*CreateCategoryResponse)(nil),    // 28: pb.CreateCategoryResponse
-	(*DeleteCategoryResponse)(nil),    // 29: pb.DeleteCategoryResponse
-	(*UpdateCategoryResponse)(nil),    // 30: pb.UpdateCategoryResponse
-	(*ListCategoriesResponse)(nil),    // 31: pb.ListCategoriesResponse
-	(*ListAllCategoriesResponse)(nil), // 32: pb.ListAllCategoriesResponse
-	(*ListUsersResponse)(nil),         // 33: pb.ListUsersResponse
-	(*UpdateUserResponse)(nil),        // 34: pb.UpdateUserResponse
-	(*DisableUserResponse)(nil),       // 35: pb.DisableUserResponse
-	(*EnableUserResponse)(nil),        // 36: pb.EnableUserResponse
+	(*CreateArticleRequest)(nil),               // 0: pb.CreateArticleRequest
+	(*DeleteArticleRequest)(nil),               // 1: pb.DeleteArticleRequest
+	(*ListArticlesRequest)(nil),                // 2: pb.ListArticlesRequest
+	(*SearchArticlesRequest)(nil),              // 3: pb.SearchArticlesRequest
+	(*GetSearchAnalyticsRequest)(nil),          // 4: pb.GetSearchAnalyticsRequest
+	(*GetArticleRequest)(nil),                  // 5: pb.GetArticleRequest
+	(*UpdateArticleRequest)(nil),               // 6: pb.UpdateArticleRequest
+	(*UploadFileRequest)(nil),                  // 7: pb.UploadFileRequest
+	(*PolishTextRequest)(nil),                  // 8: pb.PolishTextRequest
+	(*GenerateArticleMetadataRequest)(nil),     // 9: pb.GenerateArticleMetadataRequest
+	(*TranslateArticleRequest)(nil),            // 10: pb.TranslateArticleRequest
+	(*GetAIConfigRequest)(nil),                 // 11: pb.GetAIConfigRequest
+	(*UpdateAIConfigRequest)(nil),              // 12: pb.UpdateAIConfigRequest
+	(*ListAIModelsRequest)(nil),                // 13: pb.ListAIModelsRequest
+	(*ListPromptTemplateVersionsRequest)(nil),  // 14: pb.ListPromptTemplateVersionsRequest
+	(*DiffPromptTemplateVersionsRequest)(nil),  // 15: pb.DiffPromptTemplateVersionsRequest
+	(*RollbackPromptTemplatesRequest)(nil),     // 16: pb.RollbackPromptTemplatesRequest
+	(*PreviewPromptRequest)(nil),               // 17: pb.PreviewPromptRequest
+	(*CreateCategoryRequest)(nil),              // 18: pb.CreateCategoryRequest
+	(*DeleteCategoryRequest)(nil),              // 19: pb.DeleteCategoryRequest
+	(*UpdateCategoryRequest)(nil),              // 20: pb.UpdateCategoryRequest
+	(*ListCategoriesRequest)(nil),              // 21: pb.ListCategoriesRequest
+	(*ListAllCategoriesRequest)(nil),           // 22: pb.ListAllCategoriesRequest
+	(*ListUsersRequest)(nil),                   // 23: pb.ListUsersRequest
+	(*UpdateUserRequest)(nil),                  // 24: pb.UpdateUserRequest
+	(*DisableUserRequest)(nil),                 // 25: pb.DisableUserRequest
+	(*EnableUserRequest)(nil),                  // 26: pb.EnableUserRequest
+	(*ListHeldCommentsRequest)(nil),            // 27: pb.ListHeldCommentsRequest
+	(*ReviewCommentRequest)(nil),               // 28: pb.ReviewCommentRequest
+	(*CreateRedirectRequest)(nil),              // 29: pb.CreateRedirectRequest
+	(*ListRedirectsRequest)(nil),               // 30: pb.ListRedirectsRequest
+	(*UpdateRedirectRequest)(nil),              // 31: pb.UpdateRedirectRequest
+	(*DeleteRedirectRequest)(nil),              // 32: pb.DeleteRedirectRequest
+	(*GetCacheStatsRequest)(nil),               // 33: pb.GetCacheStatsRequest
+	(*InspectCacheKeyRequest)(nil),             // 34: pb.InspectCacheKeyRequest
+	(*PurgeCacheRequest)(nil),                  // 35: pb.PurgeCacheRequest
+	(*BumpArticleListVersionsRequest)(nil),     // 36: pb.BumpArticleListVersionsRequest
+	(*ListPeriodicJobsRequest)(nil),            // 37: pb.ListPeriodicJobsRequest
+	(*ListTaskQueuesRequest)(nil),              // 38: pb.ListTaskQueuesRequest
+	(*ListQueueTasksRequest)(nil),              // 39: pb.ListQueueTasksRequest
+	(*RetryArchivedTaskRequest)(nil),           // 40: pb.RetryArchivedTaskRequest
+	(*DeleteArchivedTaskRequest)(nil),          // 41: pb.DeleteArchivedTaskRequest
+	(*CreateArticleResponse)(nil),              // 42: pb.CreateArticleResponse
+	(*DeleteArticleResponse)(nil),              // 43: pb.DeleteArticleResponse
+	(*ListArticlesResponse)(nil),               // 44: pb.ListArticlesResponse
+	(*SearchArticlesResponse)(nil),             // 45: pb.SearchArticlesResponse
+	(*GetSearchAnalyticsResponse)(nil),         // 46: pb.GetSearchAnalyticsResponse
+	(*GetArticleResponse)(nil),                 // 47: pb.GetArticleResponse
+	(*UpdateArticleResponse)(nil),              // 48: pb.UpdateArticleResponse
+	(*UploadFileResponse)(nil),                 // 49: pb.UploadFileResponse
+	(*PolishTextResponse)(nil),                 // 50: pb.PolishTextResponse
+	(*GenerateArticleMetadataResponse)(nil),    // 51: pb.GenerateArticleMetadataResponse
+	(*TranslateArticleResponse)(nil),           // 52: pb.TranslateArticleResponse
+	(*GetAIConfigResponse)(nil),                // 53: pb.GetAIConfigResponse
+	(*ListAIModelsResponse)(nil),               // 54: pb.ListAIModelsResponse
+	(*ListPromptTemplateVersionsResponse)(nil), // 55: pb.ListPromptTemplateVersionsResponse
+	(*DiffPromptTemplateVersionsResponse)(nil), // 56: pb.DiffPromptTemplateVersionsResponse
+	(*RollbackPromptTemplatesResponse)(nil),    // 57: pb.RollbackPromptTemplatesResponse
+	(*PreviewPromptResponse)(nil),              // 58: pb.PreviewPromptResponse
+	(*CreateCategoryResponse)(nil),             // 59: pb.CreateCategoryResponse
+	(*DeleteCategoryResponse)(nil),             // 60: pb.DeleteCategoryResponse
+	(*UpdateCategoryResponse)(nil),             // 61: pb.UpdateCategoryResponse
+	(*ListCategoriesResponse)(nil),             // 62: pb.ListCategoriesResponse
+	(*ListAllCategoriesResponse)(nil),          // 63: pb.ListAllCategoriesResponse
+	(*ListUsersResponse)(nil),                  // 64: pb.ListUsersResponse
+	(*UpdateUserResponse)(nil),                 // 65: pb.UpdateUserResponse
+	(*DisableUserResponse)(nil),                // 66: pb.DisableUserResponse
+	(*EnableUserResponse)(nil),                 // 67: pb.EnableUserResponse
+	(*ListHeldCommentsResponse)(nil),           // 68: pb.ListHeldCommentsResponse
+	(*ReviewCommentResponse)(nil),              // 69: pb.ReviewCommentResponse
+	(*CreateRedirectResponse)(nil),             // 70: pb.CreateRedirectResponse
+	(*ListRedirectsResponse)(nil),              // 71: pb.ListRedirectsResponse
+	(*UpdateRedirectResponse)(nil),             // 72: pb.UpdateRedirectResponse
+	(*DeleteRedirectResponse)(nil),             // 73: pb.DeleteRedirectResponse
+	(*GetCacheStatsResponse)(nil),              // 74: pb.GetCacheStatsResponse
+	(*InspectCacheKeyResponse)(nil),            // 75: pb.InspectCacheKeyResponse
+	(*PurgeCacheResponse)(nil),                 // 76: pb.PurgeCacheResponse
+	(*BumpArticleListVersionsResponse)(nil),    // 77: pb.BumpArticleListVersionsResponse
+	(*ListPeriodicJobsResponse)(nil),           // 78: pb.ListPeriodicJobsResponse
+	(*ListTaskQueuesResponse)(nil),             // 79: pb.ListTaskQueuesResponse
+	(*ListQueueTasksResponse)(nil),             // 80: pb.ListQueueTasksResponse
+	(*RetryArchivedTaskResponse)(nil),          // 81: pb.RetryArchivedTaskResponse
+	(*DeleteArchivedTaskResponse)(nil),         // 82: pb.DeleteArchivedTaskResponse
 }
 var file_service_nostalgia_proto_depIdxs = []int32{
 	0,  // 0: pb.Nostalgia.CreateArticle:input_type -> pb.CreateArticleRequest
 	1,  // 1: pb.Nostalgia.DeleteArticle:input_type -> pb.DeleteArticleRequest
 	2,  // 2: pb.Nostalgia.ListArticles:input_type -> pb.ListArticlesRequest
-	3,  // 3: pb.Nostalgia.GetArticle:input_type -> pb.GetArticleRequest
-	4,  // 4: pb.Nostalgia.UpdateArticle:input_type -> pb.UpdateArticleRequest
-	5,  // 5: pb.Nostalgia.UploadFile:input_type -> pb.UploadFileRequest
-	6,  // 6: pb.Nostalgia.PolishText:input_type -> pb.PolishTextRequest
-	7,  // 7: pb.Nostalgia.GetAIConfig:input_type -> pb.GetAIConfigRequest
-	8,  // 8: pb.Nostalgia.UpdateAIConfig:input_type -> pb.UpdateAIConfigRequest
-	9,  // 9: pb.Nostalgia.ListAIModels:input_type -> pb.ListAIModelsRequest
-	10, // 10: pb.Nostalgia.CreateCategory:input_type -> pb.CreateCategoryRequest
-	11, // 11: pb.Nostalgia.DeleteCategory:input_type -> pb.DeleteCategoryRequest
-	12, // 12: pb.Nostalgia.UpdateCategory:input_type -> pb.UpdateCategoryRequest
-	13, // 13: pb.Nostalgia.ListCategories:input_type -> pb.ListCategoriesRequest
-	14, // 14: pb.Nostalgia.ListAllCategories:input_type -> pb.ListAllCategoriesRequest
-	15, // 15: pb.Nostalgia.ListUsers:input_type -> pb.ListUsersRequest
-	16, // 16: pb.Nostalgia.UpdateUser:input_type -> pb.UpdateUserRequest
-	17, // 17: pb.Nostalgia.DisableUser:input_type -> pb.DisableUserRequest
-	18, // 18: pb.Nostalgia.EnableUser:input_type -> pb.EnableUserRequest
-	19, // 19: pb.Nostalgia.CreateArticle:output_type -> pb.CreateArticleResponse
-	20, // 20: pb.Nostalgia.DeleteArticle:output_type -> pb.DeleteArticleResponse
-	21, // 21: pb.Nostalgia.ListArticles:output_type -> pb.ListArticlesResponse
-	22, // 22: pb.Nostalgia.GetArticle:output_type -> pb.GetArticleResponse
-	23, // 23: pb.Nostalgia.UpdateArticle:output_type -> pb.UpdateArticleResponse
-	24, // 24: pb.Nostalgia.UploadFile:output_type -> pb.UploadFileResponse
-	25, // 25: pb.Nostalgia.PolishText:output_type -> pb.PolishTextResponse
-	26, // 26: pb.Nostalgia.GetAIConfig:output_type -> pb.GetAIConfigResponse
-	26, // 27: pb.Nostalgia.UpdateAIConfig:output_type -> pb.GetAIConfigResponse
-	27, // 28: pb.Nostalgia.ListAIModels:output_type -> pb.ListAIModelsResponse
-	28, // 29: pb.Nostalgia.CreateCategory:output_type -> pb.CreateCategoryResponse
-	29, // 30: pb.Nostalgia.DeleteCategory:output_type -> pb.DeleteCategoryResponse
-	30, // 31: pb.Nostalgia.UpdateCategory:output_type -> pb.UpdateCategoryResponse
-	31, // 32: pb.Nostalgia.ListCategories:output_type -> pb.ListCategoriesResponse
-	32, // 33: pb.Nostalgia.ListAllCategories:output_type -> pb.ListAllCategoriesResponse
-	33, // 34: pb.Nostalgia.ListUsers:output_type -> pb.ListUsersResponse
-	34, // 35: pb.Nostalgia.UpdateUser:output_type -> pb.UpdateUserResponse
-	35, // 36: pb.Nostalgia.DisableUser:output_type -> pb.DisableUserResponse
-	36, // 37: pb.Nostalgia.EnableUser:output_type -> pb.EnableUserResponse
-	19, // [19:38] is the sub-list for method output_type
-	0,  // [0:19] is the sub-list for method input_type
+	3,  // 3: pb.Nostalgia.SearchArticles:input_type -> pb.SearchArticlesRequest
+	4,  // 4: pb.Nostalgia.GetSearchAnalytics:input_type -> pb.GetSearchAnalyticsRequest
+	5,  // 5: pb.Nostalgia.GetArticle:input_type -> pb.GetArticleRequest
+	6,  // 6: pb.Nostalgia.UpdateArticle:input_type -> pb.UpdateArticleRequest
+	7,  // 7: pb.Nostalgia.UploadFile:input_type -> pb.UploadFileRequest
+	8,  // 8: pb.Nostalgia.PolishText:input_type -> pb.PolishTextRequest
+	9,  // 9: pb.Nostalgia.GenerateArticleMetadata:input_type -> pb.GenerateArticleMetadataRequest
+	10, // 10: pb.Nostalgia.TranslateArticle:input_type -> pb.TranslateArticleRequest
+	11, // 11: pb.Nostalgia.GetAIConfig:input_type -> pb.GetAIConfigRequest
+	12, // 12: pb.Nostalgia.UpdateAIConfig:input_type -> pb.UpdateAIConfigRequest
+	13, // 13: pb.Nostalgia.ListAIModels:input_type -> pb.ListAIModelsRequest
+	14, // 14: pb.Nostalgia.ListPromptTemplateVersions:input_type -> pb.ListPromptTemplateVersionsRequest
+	15, // 15: pb.Nostalgia.DiffPromptTemplateVersions:input_type -> pb.DiffPromptTemplateVersionsRequest
+	16, // 16: pb.Nostalgia.RollbackPromptTemplates:input_type -> pb.RollbackPromptTemplatesRequest
+	17, // 17: pb.Nostalgia.PreviewPrompt:input_type -> pb.PreviewPromptRequest
+	18, // 18: pb.Nostalgia.CreateCategory:input_type -> pb.CreateCategoryRequest
+	19, // 19: pb.Nostalgia.DeleteCategory:input_type -> pb.DeleteCategoryRequest
+	20, // 20: pb.Nostalgia.UpdateCategory:input_type -> pb.UpdateCategoryRequest
+	21, // 21: pb.Nostalgia.ListCategories:input_type -> pb.ListCategoriesRequest
+	22, // 22: pb.Nostalgia.ListAllCategories:input_type -> pb.ListAllCategoriesRequest
+	23, // 23: pb.Nostalgia.ListUsers:input_type -> pb.ListUsersRequest
+	24, // 24: pb.Nostalgia.UpdateUser:input_type -> pb.UpdateUserRequest
+	25, // 25: pb.Nostalgia.DisableUser:input_type -> pb.DisableUserRequest
+	26, // 26: pb.Nostalgia.EnableUser:input_type -> pb.EnableUserRequest
+	27, // 27: pb.Nostalgia.ListHeldComments:input_type -> pb.ListHeldCommentsRequest
+	28, // 28: pb.Nostalgia.ReviewComment:input_type -> pb.ReviewCommentRequest
+	29, // 29: pb.Nostalgia.CreateRedirect:input_type -> pb.CreateRedirectRequest
+	30, // 30: pb.Nostalgia.ListRedirects:input_type -> pb.ListRedirectsRequest
+	31, // 31: pb.Nostalgia.UpdateRedirect:input_type -> pb.UpdateRedirectRequest
+	32, // 32: pb.Nostalgia.DeleteRedirect:input_type -> pb.DeleteRedirectRequest
+	33, // 33: pb.Nostalgia.GetCacheStats:input_type -> pb.GetCacheStatsRequest
+	34, // 34: pb.Nostalgia.InspectCacheKey:input_type -> pb.InspectCacheKeyRequest
+	35, // 35: pb.Nostalgia.PurgeCache:input_type -> pb.PurgeCacheRequest
+	36, // 36: pb.Nostalgia.BumpArticleListVersions:input_type -> pb.BumpArticleListVersionsRequest
+	37, // 37: pb.Nostalgia.ListPeriodicJobs:input_type -> pb.ListPeriodicJobsRequest
+	38, // 38: pb.Nostalgia.ListTaskQueues:input_type -> pb.ListTaskQueuesRequest
+	39, // 39: pb.Nostalgia.ListQueueTasks:input_type -> pb.ListQueueTasksRequest
+	40, // 40: pb.Nostalgia.RetryArchivedTask:input_type -> pb.RetryArchivedTaskRequest
+	41, // 41: pb.Nostalgia.DeleteArchivedTask:input_type -> pb.DeleteArchivedTaskRequest
+	42, // 42: pb.Nostalgia.CreateArticle:output_type -> pb.CreateArticleResponse
+	43, // 43: pb.Nostalgia.DeleteArticle:output_type -> pb.DeleteArticleResponse
+	44, // 44: pb.Nostalgia.ListArticles:output_type -> pb.ListArticlesResponse
+	45, // 45: pb.Nostalgia.SearchArticles:output_type -> pb.SearchArticlesResponse
+	46, // 46: pb.Nostalgia.GetSearchAnalytics:output_type -> pb.GetSearchAnalyticsResponse
+	47, // 47: pb.Nostalgia.GetArticle:output_type -> pb.GetArticleResponse
+	48, // 48: pb.Nostalgia.UpdateArticle:output_type -> pb.UpdateArticleResponse
+	49, // 49: pb.Nostalgia.UploadFile:output_type -> pb.UploadFileResponse
+	50, // 50: pb.Nostalgia.PolishText:output_type -> pb.PolishTextResponse
+	51, // 51: pb.Nostalgia.GenerateArticleMetadata:output_type -> pb.GenerateArticleMetadataResponse
+	52, // 52: pb.Nostalgia.TranslateArticle:output_type -> pb.TranslateArticleResponse
+	53, // 53: pb.Nostalgia.GetAIConfig:output_type -> pb.GetAIConfigResponse
+	53, // 54: pb.Nostalgia.UpdateAIConfig:output_type -> pb.GetAIConfigResponse
+	54, // 55: pb.Nostalgia.ListAIModels:output_type -> pb.ListAIModelsResponse
+	55, // 56: pb.Nostalgia.ListPromptTemplateVersions:output_type -> pb.ListPromptTemplateVersionsResponse
+	56, // 57: pb.Nostalgia.DiffPromptTemplateVersions:output_type -> pb.DiffPromptTemplateVersionsResponse
+	57, // 58: pb.Nostalgia.RollbackPromptTemplates:output_type -> pb.RollbackPromptTemplatesResponse
+	58, // 59: pb.Nostalgia.PreviewPrompt:output_type -> pb.PreviewPromptResponse
+	59, // 60: pb.Nostalgia.CreateCategory:output_type -> pb.CreateCategoryResponse
+	60, // 61: pb.Nostalgia.DeleteCategory:output_type -> pb.DeleteCategoryResponse
+	61, // 62: pb.Nostalgia.UpdateCategory:output_type -> pb.UpdateCategoryResponse
+	62, // 63: pb.Nostalgia.ListCategories:output_type -> pb.ListCategoriesResponse
+	63, // 64: pb.Nostalgia.ListAllCategories:output_type -> pb.ListAllCategoriesResponse
+	64, // 65: pb.Nostalgia.ListUsers:output_type -> pb.ListUsersResponse
+	65, // 66: pb.Nostalgia.UpdateUser:output_type -> pb.UpdateUserResponse
+	66, // 67: pb.Nostalgia.DisableUser:output_type -> pb.DisableUserResponse
+	67, // 68: pb.Nostalgia.EnableUser:output_type -> pb.EnableUserResponse
+	68, // 69: pb.Nostalgia.ListHeldComments:output_type -> pb.ListHeldCommentsResponse
+	69, // 70: pb.Nostalgia.ReviewComment:output_type -> pb.ReviewCommentResponse
+	70, // 71: pb.Nostalgia.CreateRedirect:output_type -> pb.CreateRedirectResponse
+	71, // 72: pb.Nostalgia.ListRedirects:output_type -> pb.ListRedirectsResponse
+	72, // 73: pb.Nostalgia.UpdateRedirect:output_type -> pb.UpdateRedirectResponse
+	73, // 74: pb.Nostalgia.DeleteRedirect:output_type -> pb.DeleteRedirectResponse
+	74, // 75: pb.Nostalgia.GetCacheStats:output_type -> pb.GetCacheStatsResponse
+	75, // 76: pb.Nostalgia.InspectCacheKey:output_type -> pb.InspectCacheKeyResponse
+	76, // 77: pb.Nostalgia.PurgeCache:output_type -> pb.PurgeCacheResponse
+	77, // 78: pb.Nostalgia.BumpArticleListVersions:output_type -> pb.BumpArticleListVersionsResponse
+	78, // 79: pb.Nostalgia.ListPeriodicJobs:output_type -> pb.ListPeriodicJobsResponse
+	79, // 80: pb.Nostalgia.ListTaskQueues:output_type -> pb.ListTaskQueuesResponse
+	80, // 81: pb.Nostalgia.ListQueueTasks:output_type -> pb.ListQueueTasksResponse
+	81, // 82: pb.Nostalgia.RetryArchivedTask:output_type -> pb.RetryArchivedTaskResponse
+	82, // 83: pb.Nostalgia.DeleteArchivedTask:output_type -> pb.DeleteArchivedTaskResponse
+	42, // [42:84] is the sub-list for method output_type
+	0,  // [0:42] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -357,6 +780,16 @@ func file_service_nostalgia_proto_init() {
 	file_rpc_update_article_proto_init()
 	file_rpc_upload_file_proto_init()
 	file_rpc_polish_text_proto_init()
+	file_rpc_generate_article_metadata_proto_init()
+	file_rpc_translate_article_proto_init()
+	file_rpc_prompt_template_proto_init()
+	file_rpc_comment_moderation_proto_init()
+	file_rpc_search_articles_proto_init()
+	file_rpc_search_analytics_proto_init()
+	file_rpc_redirect_proto_init()
+	file_rpc_cache_proto_init()
+	file_rpc_scheduler_proto_init()
+	file_rpc_task_queue_proto_init()
 	file_category_proto_init()
 	file_user_proto_init()
 	type x struct{}
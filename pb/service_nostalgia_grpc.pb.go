@@ -19,25 +19,48 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Nostalgia_CreateArticle_FullMethodName     = "/pb.Nostalgia/CreateArticle"
-	Nostalgia_DeleteArticle_FullMethodName     = "/pb.Nostalgia/DeleteArticle"
-	Nostalgia_ListArticles_FullMethodName      = "/pb.Nostalgia/ListArticles"
-	Nostalgia_GetArticle_FullMethodName        = "/pb.Nostalgia/GetArticle"
-	Nostalgia_UpdateArticle_FullMethodName     = "/pb.Nostalgia/UpdateArticle"
-	Nostalgia_UploadFile_FullMethodName        = "/pb.Nostalgia/UploadFile"
-	Nostalgia_PolishText_FullMethodName        = "/pb.Nostalgia/PolishText"
-	Nostalgia_GetAIConfig_FullMethodName       = "/pb.Nostalgia/GetAIConfig"
-	Nostalgia_UpdateAIConfig_FullMethodName    = "/pb.Nostalgia/UpdateAIConfig"
-	Nostalgia_ListAIModels_FullMethodName      = "/pb.Nostalgia/ListAIModels"
-	Nostalgia_CreateCategory_FullMethodName    = "/pb.Nostalgia/CreateCategory"
-	Nostalgia_DeleteCategory_FullMethodName    = "/pb.Nostalgia/DeleteCategory"
-	Nostalgia_UpdateCategory_FullMethodName    = "/pb.Nostalgia/UpdateCategory"
-	Nostalgia_ListCategories_FullMethodName    = "/pb.Nostalgia/ListCategories"
-	Nostalgia_ListAllCategories_FullMethodName = "/pb.Nostalgia/ListAllCategories"
-	Nostalgia_ListUsers_FullMethodName         = "/pb.Nostalgia/ListUsers"
-	Nostalgia_UpdateUser_FullMethodName        = "/pb.Nostalgia/UpdateUser"
-	Nostalgia_DisableUser_FullMethodName       = "/pb.Nostalgia/DisableUser"
-	Nostalgia_EnableUser_FullMethodName        = "/pb.Nostalgia/EnableUser"
+	Nostalgia_CreateArticle_FullMethodName              = "/pb.Nostalgia/CreateArticle"
+	Nostalgia_DeleteArticle_FullMethodName              = "/pb.Nostalgia/DeleteArticle"
+	Nostalgia_ListArticles_FullMethodName               = "/pb.Nostalgia/ListArticles"
+	Nostalgia_SearchArticles_FullMethodName             = "/pb.Nostalgia/SearchArticles"
+	Nostalgia_GetSearchAnalytics_FullMethodName         = "/pb.Nostalgia/GetSearchAnalytics"
+	Nostalgia_GetArticle_FullMethodName                 = "/pb.Nostalgia/GetArticle"
+	Nostalgia_UpdateArticle_FullMethodName              = "/pb.Nostalgia/UpdateArticle"
+	Nostalgia_UploadFile_FullMethodName                 = "/pb.Nostalgia/UploadFile"
+	Nostalgia_PolishText_FullMethodName                 = "/pb.Nostalgia/PolishText"
+	Nostalgia_GenerateArticleMetadata_FullMethodName    = "/pb.Nostalgia/GenerateArticleMetadata"
+	Nostalgia_TranslateArticle_FullMethodName           = "/pb.Nostalgia/TranslateArticle"
+	Nostalgia_GetAIConfig_FullMethodName                = "/pb.Nostalgia/GetAIConfig"
+	Nostalgia_UpdateAIConfig_FullMethodName             = "/pb.Nostalgia/UpdateAIConfig"
+	Nostalgia_ListAIModels_FullMethodName               = "/pb.Nostalgia/ListAIModels"
+	Nostalgia_ListPromptTemplateVersions_FullMethodName = "/pb.Nostalgia/ListPromptTemplateVersions"
+	Nostalgia_DiffPromptTemplateVersions_FullMethodName = "/pb.Nostalgia/DiffPromptTemplateVersions"
+	Nostalgia_RollbackPromptTemplates_FullMethodName    = "/pb.Nostalgia/RollbackPromptTemplates"
+	Nostalgia_PreviewPrompt_FullMethodName              = "/pb.Nostalgia/PreviewPrompt"
+	Nostalgia_CreateCategory_FullMethodName             = "/pb.Nostalgia/CreateCategory"
+	Nostalgia_DeleteCategory_FullMethodName             = "/pb.Nostalgia/DeleteCategory"
+	Nostalgia_UpdateCategory_FullMethodName             = "/pb.Nostalgia/UpdateCategory"
+	Nostalgia_ListCategories_FullMethodName             = "/pb.Nostalgia/ListCategories"
+	Nostalgia_ListAllCategories_FullMethodName          = "/pb.Nostalgia/ListAllCategories"
+	Nostalgia_ListUsers_FullMethodName                  = "/pb.Nostalgia/ListUsers"
+	Nostalgia_UpdateUser_FullMethodName                 = "/pb.Nostalgia/UpdateUser"
+	Nostalgia_DisableUser_FullMethodName                = "/pb.Nostalgia/DisableUser"
+	Nostalgia_EnableUser_FullMethodName                 = "/pb.Nostalgia/EnableUser"
+	Nostalgia_ListHeldComments_FullMethodName           = "/pb.Nostalgia/ListHeldComments"
+	Nostalgia_ReviewComment_FullMethodName              = "/pb.Nostalgia/ReviewComment"
+	Nostalgia_CreateRedirect_FullMethodName             = "/pb.Nostalgia/CreateRedirect"
+	Nostalgia_ListRedirects_FullMethodName              = "/pb.Nostalgia/ListRedirects"
+	Nostalgia_UpdateRedirect_FullMethodName             = "/pb.Nostalgia/UpdateRedirect"
+	Nostalgia_DeleteRedirect_FullMethodName             = "/pb.Nostalgia/DeleteRedirect"
+	Nostalgia_GetCacheStats_FullMethodName              = "/pb.Nostalgia/GetCacheStats"
+	Nostalgia_InspectCacheKey_FullMethodName            = "/pb.Nostalgia/InspectCacheKey"
+	Nostalgia_PurgeCache_FullMethodName                 = "/pb.Nostalgia/PurgeCache"
+	Nostalgia_BumpArticleListVersions_FullMethodName    = "/pb.Nostalgia/BumpArticleListVersions"
+	Nostalgia_ListPeriodicJobs_FullMethodName           = "/pb.Nostalgia/ListPeriodicJobs"
+	Nostalgia_ListTaskQueues_FullMethodName             = "/pb.Nostalgia/ListTaskQueues"
+	Nostalgia_ListQueueTasks_FullMethodName             = "/pb.Nostalgia/ListQueueTasks"
+	Nostalgia_RetryArchivedTask_FullMethodName          = "/pb.Nostalgia/RetryArchivedTask"
+	Nostalgia_DeleteArchivedTask_FullMethodName         = "/pb.Nostalgia/DeleteArchivedTask"
 )
 
 // NostalgiaClient is the client API for Nostalgia service.
@@ -47,13 +70,21 @@ type NostalgiaClient interface {
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*CreateArticleResponse, error)
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleResponse, error)
 	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*ListArticlesResponse, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	GetSearchAnalytics(ctx context.Context, in *GetSearchAnalyticsRequest, opts ...grpc.CallOption) (*GetSearchAnalyticsResponse, error)
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*UpdateArticleResponse, error)
 	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
 	PolishText(ctx context.Context, in *PolishTextRequest, opts ...grpc.CallOption) (*PolishTextResponse, error)
+	GenerateArticleMetadata(ctx context.Context, in *GenerateArticleMetadataRequest, opts ...grpc.CallOption) (*GenerateArticleMetadataResponse, error)
+	TranslateArticle(ctx context.Context, in *TranslateArticleRequest, opts ...grpc.CallOption) (*TranslateArticleResponse, error)
 	GetAIConfig(ctx context.Context, in *GetAIConfigRequest, opts ...grpc.CallOption) (*GetAIConfigResponse, error)
 	UpdateAIConfig(ctx context.Context, in *UpdateAIConfigRequest, opts ...grpc.CallOption) (*GetAIConfigResponse, error)
 	ListAIModels(ctx context.Context, in *ListAIModelsRequest, opts ...grpc.CallOption) (*ListAIModelsResponse, error)
+	ListPromptTemplateVersions(ctx context.Context, in *ListPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*ListPromptTemplateVersionsResponse, error)
+	DiffPromptTemplateVersions(ctx context.Context, in *DiffPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*DiffPromptTemplateVersionsResponse, error)
+	RollbackPromptTemplates(ctx context.Context, in *RollbackPromptTemplatesRequest, opts ...grpc.CallOption) (*RollbackPromptTemplatesResponse, error)
+	PreviewPrompt(ctx context.Context, in *PreviewPromptRequest, opts ...grpc.CallOption) (*PreviewPromptResponse, error)
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
@@ -63,6 +94,21 @@ type NostalgiaClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error)
 	EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error)
+	ListHeldComments(ctx context.Context, in *ListHeldCommentsRequest, opts ...grpc.CallOption) (*ListHeldCommentsResponse, error)
+	ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentResponse, error)
+	CreateRedirect(ctx context.Context, in *CreateRedirectRequest, opts ...grpc.CallOption) (*CreateRedirectResponse, error)
+	ListRedirects(ctx context.Context, in *ListRedirectsRequest, opts ...grpc.CallOption) (*ListRedirectsResponse, error)
+	UpdateRedirect(ctx context.Context, in *UpdateRedirectRequest, opts ...grpc.CallOption) (*UpdateRedirectResponse, error)
+	DeleteRedirect(ctx context.Context, in *DeleteRedirectRequest, opts ...grpc.CallOption) (*DeleteRedirectResponse, error)
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+	InspectCacheKey(ctx context.Context, in *InspectCacheKeyRequest, opts ...grpc.CallOption) (*InspectCacheKeyResponse, error)
+	PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error)
+	BumpArticleListVersions(ctx context.Context, in *BumpArticleListVersionsRequest, opts ...grpc.CallOption) (*BumpArticleListVersionsResponse, error)
+	ListPeriodicJobs(ctx context.Context, in *ListPeriodicJobsRequest, opts ...grpc.CallOption) (*ListPeriodicJobsResponse, error)
+	ListTaskQueues(ctx context.Context, in *ListTaskQueuesRequest, opts ...grpc.CallOption) (*ListTaskQueuesResponse, error)
+	ListQueueTasks(ctx context.Context, in *ListQueueTasksRequest, opts ...grpc.CallOption) (*ListQueueTasksResponse, error)
+	RetryArchivedTask(ctx context.Context, in *RetryArchivedTaskRequest, opts ...grpc.CallOption) (*RetryArchivedTaskResponse, error)
+	DeleteArchivedTask(ctx context.Context, in *DeleteArchivedTaskRequest, opts ...grpc.CallOption) (*DeleteArchivedTaskResponse, error)
 }
 
 type nostalgiaClient struct {
@@ -103,6 +149,26 @@ func (c *nostalgiaClient) ListArticles(ctx context.Context, in *ListArticlesRequ
 	return out, nil
 }
 
+func (c *nostalgiaClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) GetSearchAnalytics(ctx context.Context, in *GetSearchAnalyticsRequest, opts ...grpc.CallOption) (*GetSearchAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_GetSearchAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nostalgiaClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetArticleResponse)
@@ -143,6 +209,26 @@ func (c *nostalgiaClient) PolishText(ctx context.Context, in *PolishTextRequest,
 	return out, nil
 }
 
+func (c *nostalgiaClient) GenerateArticleMetadata(ctx context.Context, in *GenerateArticleMetadataRequest, opts ...grpc.CallOption) (*GenerateArticleMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateArticleMetadataResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_GenerateArticleMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) TranslateArticle(ctx context.Context, in *TranslateArticleRequest, opts ...grpc.CallOption) (*TranslateArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TranslateArticleResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_TranslateArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nostalgiaClient) GetAIConfig(ctx context.Context, in *GetAIConfigRequest, opts ...grpc.CallOption) (*GetAIConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAIConfigResponse)
@@ -173,6 +259,46 @@ func (c *nostalgiaClient) ListAIModels(ctx context.Context, in *ListAIModelsRequ
 	return out, nil
 }
 
+func (c *nostalgiaClient) ListPromptTemplateVersions(ctx context.Context, in *ListPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*ListPromptTemplateVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListPromptTemplateVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) DiffPromptTemplateVersions(ctx context.Context, in *DiffPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*DiffPromptTemplateVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffPromptTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_DiffPromptTemplateVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) RollbackPromptTemplates(ctx context.Context, in *RollbackPromptTemplatesRequest, opts ...grpc.CallOption) (*RollbackPromptTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackPromptTemplatesResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_RollbackPromptTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) PreviewPrompt(ctx context.Context, in *PreviewPromptRequest, opts ...grpc.CallOption) (*PreviewPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewPromptResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_PreviewPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nostalgiaClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCategoryResponse)
@@ -263,6 +389,156 @@ func (c *nostalgiaClient) EnableUser(ctx context.Context, in *EnableUserRequest,
 	return out, nil
 }
 
+func (c *nostalgiaClient) ListHeldComments(ctx context.Context, in *ListHeldCommentsRequest, opts ...grpc.CallOption) (*ListHeldCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHeldCommentsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListHeldComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCommentResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ReviewComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) CreateRedirect(ctx context.Context, in *CreateRedirectRequest, opts ...grpc.CallOption) (*CreateRedirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRedirectResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_CreateRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) ListRedirects(ctx context.Context, in *ListRedirectsRequest, opts ...grpc.CallOption) (*ListRedirectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedirectsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListRedirects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) UpdateRedirect(ctx context.Context, in *UpdateRedirectRequest, opts ...grpc.CallOption) (*UpdateRedirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRedirectResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_UpdateRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) DeleteRedirect(ctx context.Context, in *DeleteRedirectRequest, opts ...grpc.CallOption) (*DeleteRedirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRedirectResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_DeleteRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) InspectCacheKey(ctx context.Context, in *InspectCacheKeyRequest, opts ...grpc.CallOption) (*InspectCacheKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectCacheKeyResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_InspectCacheKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCacheResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_PurgeCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) BumpArticleListVersions(ctx context.Context, in *BumpArticleListVersionsRequest, opts ...grpc.CallOption) (*BumpArticleListVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BumpArticleListVersionsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_BumpArticleListVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) ListPeriodicJobs(ctx context.Context, in *ListPeriodicJobsRequest, opts ...grpc.CallOption) (*ListPeriodicJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeriodicJobsResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListPeriodicJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) ListTaskQueues(ctx context.Context, in *ListTaskQueuesRequest, opts ...grpc.CallOption) (*ListTaskQueuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskQueuesResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListTaskQueues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) ListQueueTasks(ctx context.Context, in *ListQueueTasksRequest, opts ...grpc.CallOption) (*ListQueueTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueueTasksResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_ListQueueTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) RetryArchivedTask(ctx context.Context, in *RetryArchivedTaskRequest, opts ...grpc.CallOption) (*RetryArchivedTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryArchivedTaskResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_RetryArchivedTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nostalgiaClient) DeleteArchivedTask(ctx context.Context, in *DeleteArchivedTaskRequest, opts ...grpc.CallOption) (*DeleteArchivedTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteArchivedTaskResponse)
+	err := c.cc.Invoke(ctx, Nostalgia_DeleteArchivedTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NostalgiaServer is the server API for Nostalgia service.
 // All implementations must embed UnimplementedNostalgiaServer
 // for forward compatibility.
@@ -270,13 +546,21 @@ type NostalgiaServer interface {
 	CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleResponse, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
 	ListArticles(context.Context, *ListArticlesRequest) (*ListArticlesResponse, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	GetSearchAnalytics(context.Context, *GetSearchAnalyticsRequest) (*GetSearchAnalyticsResponse, error)
 	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*UpdateArticleResponse, error)
 	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
 	PolishText(context.Context, *PolishTextRequest) (*PolishTextResponse, error)
+	GenerateArticleMetadata(context.Context, *GenerateArticleMetadataRequest) (*GenerateArticleMetadataResponse, error)
+	TranslateArticle(context.Context, *TranslateArticleRequest) (*TranslateArticleResponse, error)
 	GetAIConfig(context.Context, *GetAIConfigRequest) (*GetAIConfigResponse, error)
 	UpdateAIConfig(context.Context, *UpdateAIConfigRequest) (*GetAIConfigResponse, error)
 	ListAIModels(context.Context, *ListAIModelsRequest) (*ListAIModelsResponse, error)
+	ListPromptTemplateVersions(context.Context, *ListPromptTemplateVersionsRequest) (*ListPromptTemplateVersionsResponse, error)
+	DiffPromptTemplateVersions(context.Context, *DiffPromptTemplateVersionsRequest) (*DiffPromptTemplateVersionsResponse, error)
+	RollbackPromptTemplates(context.Context, *RollbackPromptTemplatesRequest) (*RollbackPromptTemplatesResponse, error)
+	PreviewPrompt(context.Context, *PreviewPromptRequest) (*PreviewPromptResponse, error)
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
@@ -286,6 +570,21 @@ type NostalgiaServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error)
 	EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error)
+	ListHeldComments(context.Context, *ListHeldCommentsRequest) (*ListHeldCommentsResponse, error)
+	ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentResponse, error)
+	CreateRedirect(context.Context, *CreateRedirectRequest) (*CreateRedirectResponse, error)
+	ListRedirects(context.Context, *ListRedirectsRequest) (*ListRedirectsResponse, error)
+	UpdateRedirect(context.Context, *UpdateRedirectRequest) (*UpdateRedirectResponse, error)
+	DeleteRedirect(context.Context, *DeleteRedirectRequest) (*DeleteRedirectResponse, error)
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	InspectCacheKey(context.Context, *InspectCacheKeyRequest) (*InspectCacheKeyResponse, error)
+	PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error)
+	BumpArticleListVersions(context.Context, *BumpArticleListVersionsRequest) (*BumpArticleListVersionsResponse, error)
+	ListPeriodicJobs(context.Context, *ListPeriodicJobsRequest) (*ListPeriodicJobsResponse, error)
+	ListTaskQueues(context.Context, *ListTaskQueuesRequest) (*ListTaskQueuesResponse, error)
+	ListQueueTasks(context.Context, *ListQueueTasksRequest) (*ListQueueTasksResponse, error)
+	RetryArchivedTask(context.Context, *RetryArchivedTaskRequest) (*RetryArchivedTaskResponse, error)
+	DeleteArchivedTask(context.Context, *DeleteArchivedTaskRequest) (*DeleteArchivedTaskResponse, error)
 	mustEmbedUnimplementedNostalgiaServer()
 }
 
@@ -305,6 +604,12 @@ func (UnimplementedNostalgiaServer) DeleteArticle(context.Context, *DeleteArticl
 func (UnimplementedNostalgiaServer) ListArticles(context.Context, *ListArticlesRequest) (*ListArticlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
 }
+func (UnimplementedNostalgiaServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedNostalgiaServer) GetSearchAnalytics(context.Context, *GetSearchAnalyticsRequest) (*GetSearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchAnalytics not implemented")
+}
 func (UnimplementedNostalgiaServer) GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
 }
@@ -317,6 +622,12 @@ func (UnimplementedNostalgiaServer) UploadFile(context.Context, *UploadFileReque
 func (UnimplementedNostalgiaServer) PolishText(context.Context, *PolishTextRequest) (*PolishTextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PolishText not implemented")
 }
+func (UnimplementedNostalgiaServer) GenerateArticleMetadata(context.Context, *GenerateArticleMetadataRequest) (*GenerateArticleMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateArticleMetadata not implemented")
+}
+func (UnimplementedNostalgiaServer) TranslateArticle(context.Context, *TranslateArticleRequest) (*TranslateArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TranslateArticle not implemented")
+}
 func (UnimplementedNostalgiaServer) GetAIConfig(context.Context, *GetAIConfigRequest) (*GetAIConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAIConfig not implemented")
 }
@@ -326,6 +637,18 @@ func (UnimplementedNostalgiaServer) UpdateAIConfig(context.Context, *UpdateAICon
 func (UnimplementedNostalgiaServer) ListAIModels(context.Context, *ListAIModelsRequest) (*ListAIModelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAIModels not implemented")
 }
+func (UnimplementedNostalgiaServer) ListPromptTemplateVersions(context.Context, *ListPromptTemplateVersionsRequest) (*ListPromptTemplateVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromptTemplateVersions not implemented")
+}
+func (UnimplementedNostalgiaServer) DiffPromptTemplateVersions(context.Context, *DiffPromptTemplateVersionsRequest) (*DiffPromptTemplateVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPromptTemplateVersions not implemented")
+}
+func (UnimplementedNostalgiaServer) RollbackPromptTemplates(context.Context, *RollbackPromptTemplatesRequest) (*RollbackPromptTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPromptTemplates not implemented")
+}
+func (UnimplementedNostalgiaServer) PreviewPrompt(context.Context, *PreviewPromptRequest) (*PreviewPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPrompt not implemented")
+}
 func (UnimplementedNostalgiaServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
 }
@@ -353,6 +676,51 @@ func (UnimplementedNostalgiaServer) DisableUser(context.Context, *DisableUserReq
 func (UnimplementedNostalgiaServer) EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
 }
+func (UnimplementedNostalgiaServer) ListHeldComments(context.Context, *ListHeldCommentsRequest) (*ListHeldCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeldComments not implemented")
+}
+func (UnimplementedNostalgiaServer) ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewComment not implemented")
+}
+func (UnimplementedNostalgiaServer) CreateRedirect(context.Context, *CreateRedirectRequest) (*CreateRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRedirect not implemented")
+}
+func (UnimplementedNostalgiaServer) ListRedirects(context.Context, *ListRedirectsRequest) (*ListRedirectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRedirects not implemented")
+}
+func (UnimplementedNostalgiaServer) UpdateRedirect(context.Context, *UpdateRedirectRequest) (*UpdateRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedirect not implemented")
+}
+func (UnimplementedNostalgiaServer) DeleteRedirect(context.Context, *DeleteRedirectRequest) (*DeleteRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRedirect not implemented")
+}
+func (UnimplementedNostalgiaServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedNostalgiaServer) InspectCacheKey(context.Context, *InspectCacheKeyRequest) (*InspectCacheKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCacheKey not implemented")
+}
+func (UnimplementedNostalgiaServer) PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCache not implemented")
+}
+func (UnimplementedNostalgiaServer) BumpArticleListVersions(context.Context, *BumpArticleListVersionsRequest) (*BumpArticleListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpArticleListVersions not implemented")
+}
+func (UnimplementedNostalgiaServer) ListPeriodicJobs(context.Context, *ListPeriodicJobsRequest) (*ListPeriodicJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeriodicJobs not implemented")
+}
+func (UnimplementedNostalgiaServer) ListTaskQueues(context.Context, *ListTaskQueuesRequest) (*ListTaskQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueues not implemented")
+}
+func (UnimplementedNostalgiaServer) ListQueueTasks(context.Context, *ListQueueTasksRequest) (*ListQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueTasks not implemented")
+}
+func (UnimplementedNostalgiaServer) RetryArchivedTask(context.Context, *RetryArchivedTaskRequest) (*RetryArchivedTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedTask not implemented")
+}
+func (UnimplementedNostalgiaServer) DeleteArchivedTask(context.Context, *DeleteArchivedTaskRequest) (*DeleteArchivedTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedTask not implemented")
+}
 func (UnimplementedNostalgiaServer) mustEmbedUnimplementedNostalgiaServer() {}
 func (UnimplementedNostalgiaServer) testEmbeddedByValue()                   {}
 
@@ -428,6 +796,42 @@ func _Nostalgia_ListArticles_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nostalgia_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_GetSearchAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).GetSearchAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_GetSearchAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).GetSearchAnalytics(ctx, req.(*GetSearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nostalgia_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetArticleRequest)
 	if err := dec(in); err != nil {
@@ -500,6 +904,42 @@ func _Nostalgia_PolishText_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nostalgia_GenerateArticleMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateArticleMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).GenerateArticleMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_GenerateArticleMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).GenerateArticleMetadata(ctx, req.(*GenerateArticleMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_TranslateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).TranslateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_TranslateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).TranslateArticle(ctx, req.(*TranslateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nostalgia_GetAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAIConfigRequest)
 	if err := dec(in); err != nil {
@@ -554,74 +994,146 @@ func _Nostalgia_ListAIModels_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nostalgia_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _Nostalgia_ListPromptTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplateVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NostalgiaServer).CreateCategory(ctx, in)
+		return srv.(NostalgiaServer).ListPromptTemplateVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nostalgia_CreateCategory_FullMethodName,
+		FullMethod: Nostalgia_ListPromptTemplateVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NostalgiaServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(NostalgiaServer).ListPromptTemplateVersions(ctx, req.(*ListPromptTemplateVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nostalgia_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCategoryRequest)
+func _Nostalgia_DiffPromptTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPromptTemplateVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NostalgiaServer).DeleteCategory(ctx, in)
+		return srv.(NostalgiaServer).DiffPromptTemplateVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nostalgia_DeleteCategory_FullMethodName,
+		FullMethod: Nostalgia_DiffPromptTemplateVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NostalgiaServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+		return srv.(NostalgiaServer).DiffPromptTemplateVersions(ctx, req.(*DiffPromptTemplateVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nostalgia_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _Nostalgia_RollbackPromptTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPromptTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NostalgiaServer).UpdateCategory(ctx, in)
+		return srv.(NostalgiaServer).RollbackPromptTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nostalgia_UpdateCategory_FullMethodName,
+		FullMethod: Nostalgia_RollbackPromptTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NostalgiaServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(NostalgiaServer).RollbackPromptTemplates(ctx, req.(*RollbackPromptTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nostalgia_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _Nostalgia_PreviewPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPromptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NostalgiaServer).ListCategories(ctx, in)
+		return srv.(NostalgiaServer).PreviewPrompt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nostalgia_ListCategories_FullMethodName,
+		FullMethod: Nostalgia_PreviewPrompt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NostalgiaServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(NostalgiaServer).PreviewPrompt(ctx, req.(*PreviewPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListCategories(ctx, req.(*ListCategoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -716,6 +1228,276 @@ func _Nostalgia_EnableUser_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nostalgia_ListHeldComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeldCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListHeldComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListHeldComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListHeldComments(ctx, req.(*ListHeldCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ReviewComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ReviewComment(ctx, req.(*ReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_CreateRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).CreateRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_CreateRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).CreateRedirect(ctx, req.(*CreateRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ListRedirects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedirectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListRedirects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListRedirects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListRedirects(ctx, req.(*ListRedirectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_UpdateRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).UpdateRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_UpdateRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).UpdateRedirect(ctx, req.(*UpdateRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_DeleteRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).DeleteRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_DeleteRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).DeleteRedirect(ctx, req.(*DeleteRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_InspectCacheKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCacheKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).InspectCacheKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_InspectCacheKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).InspectCacheKey(ctx, req.(*InspectCacheKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_PurgeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).PurgeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_PurgeCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).PurgeCache(ctx, req.(*PurgeCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_BumpArticleListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpArticleListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).BumpArticleListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_BumpArticleListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).BumpArticleListVersions(ctx, req.(*BumpArticleListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ListPeriodicJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeriodicJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListPeriodicJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListPeriodicJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListPeriodicJobs(ctx, req.(*ListPeriodicJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ListTaskQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListTaskQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListTaskQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListTaskQueues(ctx, req.(*ListTaskQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_ListQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).ListQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_ListQueueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).ListQueueTasks(ctx, req.(*ListQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_RetryArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryArchivedTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).RetryArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_RetryArchivedTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).RetryArchivedTask(ctx, req.(*RetryArchivedTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nostalgia_DeleteArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArchivedTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NostalgiaServer).DeleteArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nostalgia_DeleteArchivedTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NostalgiaServer).DeleteArchivedTask(ctx, req.(*DeleteArchivedTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Nostalgia_ServiceDesc is the grpc.ServiceDesc for Nostalgia service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -735,6 +1517,14 @@ var Nostalgia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListArticles",
 			Handler:    _Nostalgia_ListArticles_Handler,
 		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _Nostalgia_SearchArticles_Handler,
+		},
+		{
+			MethodName: "GetSearchAnalytics",
+			Handler:    _Nostalgia_GetSearchAnalytics_Handler,
+		},
 		{
 			MethodName: "GetArticle",
 			Handler:    _Nostalgia_GetArticle_Handler,
@@ -751,6 +1541,14 @@ var Nostalgia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PolishText",
 			Handler:    _Nostalgia_PolishText_Handler,
 		},
+		{
+			MethodName: "GenerateArticleMetadata",
+			Handler:    _Nostalgia_GenerateArticleMetadata_Handler,
+		},
+		{
+			MethodName: "TranslateArticle",
+			Handler:    _Nostalgia_TranslateArticle_Handler,
+		},
 		{
 			MethodName: "GetAIConfig",
 			Handler:    _Nostalgia_GetAIConfig_Handler,
@@ -763,6 +1561,22 @@ var Nostalgia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAIModels",
 			Handler:    _Nostalgia_ListAIModels_Handler,
 		},
+		{
+			MethodName: "ListPromptTemplateVersions",
+			Handler:    _Nostalgia_ListPromptTemplateVersions_Handler,
+		},
+		{
+			MethodName: "DiffPromptTemplateVersions",
+			Handler:    _Nostalgia_DiffPromptTemplateVersions_Handler,
+		},
+		{
+			MethodName: "RollbackPromptTemplates",
+			Handler:    _Nostalgia_RollbackPromptTemplates_Handler,
+		},
+		{
+			MethodName: "PreviewPrompt",
+			Handler:    _Nostalgia_PreviewPrompt_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _Nostalgia_CreateCategory_Handler,
@@ -799,6 +1613,66 @@ var Nostalgia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnableUser",
 			Handler:    _Nostalgia_EnableUser_Handler,
 		},
+		{
+			MethodName: "ListHeldComments",
+			Handler:    _Nostalgia_ListHeldComments_Handler,
+		},
+		{
+			MethodName: "ReviewComment",
+			Handler:    _Nostalgia_ReviewComment_Handler,
+		},
+		{
+			MethodName: "CreateRedirect",
+			Handler:    _Nostalgia_CreateRedirect_Handler,
+		},
+		{
+			MethodName: "ListRedirects",
+			Handler:    _Nostalgia_ListRedirects_Handler,
+		},
+		{
+			MethodName: "UpdateRedirect",
+			Handler:    _Nostalgia_UpdateRedirect_Handler,
+		},
+		{
+			MethodName: "DeleteRedirect",
+			Handler:    _Nostalgia_DeleteRedirect_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _Nostalgia_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "InspectCacheKey",
+			Handler:    _Nostalgia_InspectCacheKey_Handler,
+		},
+		{
+			MethodName: "PurgeCache",
+			Handler:    _Nostalgia_PurgeCache_Handler,
+		},
+		{
+			MethodName: "BumpArticleListVersions",
+			Handler:    _Nostalgia_BumpArticleListVersions_Handler,
+		},
+		{
+			MethodName: "ListPeriodicJobs",
+			Handler:    _Nostalgia_ListPeriodicJobs_Handler,
+		},
+		{
+			MethodName: "ListTaskQueues",
+			Handler:    _Nostalgia_ListTaskQueues_Handler,
+		},
+		{
+			MethodName: "ListQueueTasks",
+			Handler:    _Nostalgia_ListQueueTasks_Handler,
+		},
+		{
+			MethodName: "RetryArchivedTask",
+			Handler:    _Nostalgia_RetryArchivedTask_Handler,
+		},
+		{
+			MethodName: "DeleteArchivedTask",
+			Handler:    _Nostalgia_DeleteArchivedTask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_nostalgia.proto",
@@ -12,46 +12,83 @@ import (
 
 const DefaultResourcePath = "./resources"
 
+// DefaultSiteName 社交分享与结构化数据中使用的站点名称
+const DefaultSiteName = "Nostalgia"
+
+// DefaultIndexNowEndpoint IndexNow 共享提交地址，会同步给所有参与的搜索引擎
+const DefaultIndexNowEndpoint = "https://api.indexnow.org/indexnow"
+
+// 搜索高亮与片段的默认配置
+const (
+	DefaultSearchSnippetWidth      = 120
+	DefaultSearchSnippetCount      = 3
+	DefaultSearchHighlightOpenTag  = "<mark>"
+	DefaultSearchHighlightCloseTag = "</mark>"
+)
+
 type Config struct {
-	Environment               string        `mapstructure:"ENVIRONMENT"`
-	AllowedOrigins            []string      `mapstructure:"ALLOWED_ORIGINS"`
-	DBDriver                  string        `mapstructure:"DB_DRIVER"`
-	DBUser                    string        `mapstructure:"DB_USER"`
-	DBPassword                string        `mapstructure:"DB_PASSWORD"`
-	DBSource                  string        `mapstructure:"DB_SOURCE"`
-	MigrationURL              string        `mapstructure:"MIGRATION_URL"`
-	ResourcePath              string        `mapstructure:"RESOURCE_PATH"`
-	Domain                    string        `mapstructure:"DOMAIN"`
-	RedisAddress              string        `mapstructure:"REDIS_ADDRESS"`
-	RedisCacheDB              int           `mapstructure:"REDIS_CACHE_DB"`
-	RedisQueueDB              int           `mapstructure:"REDIS_QUEUE_DB"`
-	AutomationHMACKeyID       string        `mapstructure:"AUTOMATION_HMAC_KEY_ID"`
-	AutomationHMACSecret      string        `mapstructure:"AUTOMATION_HMAC_SECRET"`
-	AutomationSignatureTTL    time.Duration `mapstructure:"AUTOMATION_SIGNATURE_TTL"`
-	AutomationDailyDraftLimit int64         `mapstructure:"AUTOMATION_DAILY_DRAFT_LIMIT"`
-	AutomationNotifyEmail     string        `mapstructure:"AUTOMATION_NOTIFY_EMAIL"`
-	AIPolishProvider          string        `mapstructure:"AI_POLISH_PROVIDER"`
-	AIPolishAPIProtocol       string        `mapstructure:"AI_POLISH_API_PROTOCOL"`
-	AIPolishBaseURL           string        `mapstructure:"AI_POLISH_BASE_URL"`
-	AIPolishAPIKey            string        `mapstructure:"AI_POLISH_API_KEY"`
-	AIPolishModel             string        `mapstructure:"AI_POLISH_MODEL"`
-	AIPolishTimeout           time.Duration `mapstructure:"AI_POLISH_TIMEOUT"`
-	AIPolishMaxInputChars     int           `mapstructure:"AI_POLISH_MAX_INPUT_CHARS"`
-	AIPolishMaxContextChars   int           `mapstructure:"AI_POLISH_MAX_CONTEXT_CHARS"`
-	AIPolishMaxSuggestions    int           `mapstructure:"AI_POLISH_MAX_SUGGESTIONS"`
-	HTTPServerAddress         string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GrpcGatewayAddress        string        `mapstructure:"GRPC_GATEWAY_ADDRESS"`
-	GRPCServerAddress         string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey         string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	SetupToken                string        `mapstructure:"SETUP_TOKEN"`
-	AccessTokenDuration       time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration      time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName           string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress        string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword       string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
-	UploadFileSizeLimit       int64         `mapstructure:"UPLOAD_FILE_SIZE_LIMIT"`
-	UploadFileAllowedMime     []string      `mapstructure:"UPLOAD_FILE_ALLOWED_MIME"`
-	HTTPProxyAddr             string        `mapstructure:"HTTP_PROXY_ADDR"`
+	Environment                 string        `mapstructure:"ENVIRONMENT"`
+	AllowedOrigins              []string      `mapstructure:"ALLOWED_ORIGINS"`
+	DBDriver                    string        `mapstructure:"DB_DRIVER"`
+	DBUser                      string        `mapstructure:"DB_USER"`
+	DBPassword                  string        `mapstructure:"DB_PASSWORD"`
+	DBSource                    string        `mapstructure:"DB_SOURCE"`
+	MigrationURL                string        `mapstructure:"MIGRATION_URL"`
+	ResourcePath                string        `mapstructure:"RESOURCE_PATH"`
+	Domain                      string        `mapstructure:"DOMAIN"`
+	SiteName                    string        `mapstructure:"SITE_NAME"`
+	RedisAddress                string        `mapstructure:"REDIS_ADDRESS"`
+	RedisCacheDB                int           `mapstructure:"REDIS_CACHE_DB"`
+	RedisQueueDB                int           `mapstructure:"REDIS_QUEUE_DB"`
+	LocalCacheMaxEntries        int           `mapstructure:"LOCAL_CACHE_MAX_ENTRIES"`
+	LocalCacheTTL               time.Duration `mapstructure:"LOCAL_CACHE_TTL"`
+	AutomationHMACKeyID         string        `mapstructure:"AUTOMATION_HMAC_KEY_ID"`
+	AutomationHMACSecret        string        `mapstructure:"AUTOMATION_HMAC_SECRET"`
+	AutomationSignatureTTL      time.Duration `mapstructure:"AUTOMATION_SIGNATURE_TTL"`
+	AutomationDailyDraftLimit   int64         `mapstructure:"AUTOMATION_DAILY_DRAFT_LIMIT"`
+	AutomationNotifyEmail       string        `mapstructure:"AUTOMATION_NOTIFY_EMAIL"`
+	AIPolishProvider            string        `mapstructure:"AI_POLISH_PROVIDER"`
+	AIPolishAPIProtocol         string        `mapstructure:"AI_POLISH_API_PROTOCOL"`
+	AIPolishBaseURL             string        `mapstructure:"AI_POLISH_BASE_URL"`
+	AIPolishAPIKey              string        `mapstructure:"AI_POLISH_API_KEY"`
+	AIPolishModel               string        `mapstructure:"AI_POLISH_MODEL"`
+	AIPolishTimeout             time.Duration `mapstructure:"AI_POLISH_TIMEOUT"`
+	AIPolishMaxInputChars       int           `mapstructure:"AI_POLISH_MAX_INPUT_CHARS"`
+	AIPolishMaxContextChars     int           `mapstructure:"AI_POLISH_MAX_CONTEXT_CHARS"`
+	AIPolishMaxSuggestions      int           `mapstructure:"AI_POLISH_MAX_SUGGESTIONS"`
+	AIPolishCacheTTL            time.Duration `mapstructure:"AI_POLISH_CACHE_TTL"`
+	CommentMaxLinks             int           `mapstructure:"COMMENT_MAX_LINKS"`
+	CommentBlocklist            []string      `mapstructure:"COMMENT_BLOCKLIST"`
+	CommentAIScreeningEnabled   bool          `mapstructure:"COMMENT_AI_SCREENING_ENABLED"`
+	SearchSnippetWidth          int           `mapstructure:"SEARCH_SNIPPET_WIDTH"`
+	SearchSnippetCount          int           `mapstructure:"SEARCH_SNIPPET_COUNT"`
+	SearchHighlightOpenTag      string        `mapstructure:"SEARCH_HIGHLIGHT_OPEN_TAG"`
+	SearchHighlightCloseTag     string        `mapstructure:"SEARCH_HIGHLIGHT_CLOSE_TAG"`
+	SearchTimezone              string        `mapstructure:"SEARCH_TIMEZONE"`
+	IndexNowEndpoint            string        `mapstructure:"INDEXNOW_ENDPOINT"`
+	IndexNowKey                 string        `mapstructure:"INDEXNOW_KEY"`
+	IndexNowTimeout             time.Duration `mapstructure:"INDEXNOW_TIMEOUT"`
+	ArticleCounterFlushInterval time.Duration `mapstructure:"ARTICLE_COUNTER_FLUSH_INTERVAL"`
+	CacheWarmListPages          int32         `mapstructure:"CACHE_WARM_LIST_PAGES"`
+	CacheWarmListLimit          int32         `mapstructure:"CACHE_WARM_LIST_LIMIT"`
+	CacheWarmCategoryPages      bool          `mapstructure:"CACHE_WARM_CATEGORY_PAGES"`
+	CacheWarmPopularArticles    int32         `mapstructure:"CACHE_WARM_POPULAR_ARTICLES"`
+	SchedulerEnabled            bool          `mapstructure:"SCHEDULER_ENABLED"`
+	PeriodicJobs                []string      `mapstructure:"PERIODIC_JOBS"`
+	TempUploadRetention         time.Duration `mapstructure:"TEMP_UPLOAD_RETENTION"`
+	HTTPServerAddress           string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GrpcGatewayAddress          string        `mapstructure:"GRPC_GATEWAY_ADDRESS"`
+	GRPCServerAddress           string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenSymmetricKey           string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	SetupToken                  string        `mapstructure:"SETUP_TOKEN"`
+	AccessTokenDuration         time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration        time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	EmailSenderName             string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress          string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword         string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	UploadFileSizeLimit         int64         `mapstructure:"UPLOAD_FILE_SIZE_LIMIT"`
+	UploadFileAllowedMime       []string      `mapstructure:"UPLOAD_FILE_ALLOWED_MIME"`
+	HTTPProxyAddr               string        `mapstructure:"HTTP_PROXY_ADDR"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -60,7 +97,10 @@ func LoadConfig(path string) (config Config, err error) {
 	configReader.AutomaticEnv()
 	configReader.SetDefault("REDIS_CACHE_DB", 0)
 	configReader.SetDefault("REDIS_QUEUE_DB", 1)
+	configReader.SetDefault("LOCAL_CACHE_MAX_ENTRIES", 1000)
+	configReader.SetDefault("LOCAL_CACHE_TTL", 5*time.Second)
 	configReader.SetDefault("RESOURCE_PATH", DefaultResourcePath)
+	configReader.SetDefault("SITE_NAME", DefaultSiteName)
 	configReader.SetDefault("AUTOMATION_SIGNATURE_TTL", 5*time.Minute)
 	configReader.SetDefault("AUTOMATION_DAILY_DRAFT_LIMIT", 1)
 	configReader.SetDefault("AI_POLISH_PROVIDER", "openai")
@@ -69,6 +109,23 @@ func LoadConfig(path string) (config Config, err error) {
 	configReader.SetDefault("AI_POLISH_MAX_INPUT_CHARS", 6000)
 	configReader.SetDefault("AI_POLISH_MAX_CONTEXT_CHARS", 4000)
 	configReader.SetDefault("AI_POLISH_MAX_SUGGESTIONS", 3)
+	configReader.SetDefault("AI_POLISH_CACHE_TTL", 24*time.Hour)
+	configReader.SetDefault("COMMENT_MAX_LINKS", 2)
+	configReader.SetDefault("COMMENT_AI_SCREENING_ENABLED", true)
+	configReader.SetDefault("SEARCH_SNIPPET_WIDTH", DefaultSearchSnippetWidth)
+	configReader.SetDefault("SEARCH_SNIPPET_COUNT", DefaultSearchSnippetCount)
+	configReader.SetDefault("SEARCH_HIGHLIGHT_OPEN_TAG", DefaultSearchHighlightOpenTag)
+	configReader.SetDefault("SEARCH_HIGHLIGHT_CLOSE_TAG", DefaultSearchHighlightCloseTag)
+	configReader.SetDefault("SEARCH_TIMEZONE", "UTC")
+	configReader.SetDefault("INDEXNOW_ENDPOINT", DefaultIndexNowEndpoint)
+	configReader.SetDefault("INDEXNOW_TIMEOUT", 10*time.Second)
+	configReader.SetDefault("ARTICLE_COUNTER_FLUSH_INTERVAL", 30*time.Second)
+	configReader.SetDefault("CACHE_WARM_LIST_PAGES", 2)
+	configReader.SetDefault("CACHE_WARM_LIST_LIMIT", 10)
+	configReader.SetDefault("CACHE_WARM_CATEGORY_PAGES", true)
+	configReader.SetDefault("CACHE_WARM_POPULAR_ARTICLES", 10)
+	configReader.SetDefault("SCHEDULER_ENABLED", true)
+	configReader.SetDefault("TEMP_UPLOAD_RETENTION", 7*24*time.Hour)
 
 	for _, key := range configEnvKeys() {
 		if bindErr := configReader.BindEnv(key); bindErr != nil {
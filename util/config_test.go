@@ -51,6 +51,33 @@ func TestLoadConfigFromEnvironmentWhenDotEnvIsMissing(t *testing.T) {
 	require.Equal(t, []string{"image/jpeg", "image/png"}, config.UploadFileAllowedMime)
 	require.Equal(t, 0, config.RedisCacheDB)
 	require.Equal(t, 1, config.RedisQueueDB)
+	require.Equal(t, 1000, config.LocalCacheMaxEntries)
+	require.Equal(t, 5*time.Second, config.LocalCacheTTL)
+	require.Equal(t, DefaultSiteName, config.SiteName)
+	require.Equal(t, 30*time.Second, config.ArticleCounterFlushInterval)
+	require.Equal(t, int32(2), config.CacheWarmListPages)
+	require.Equal(t, int32(10), config.CacheWarmListLimit)
+	require.True(t, config.CacheWarmCategoryPages)
+	require.Equal(t, int32(10), config.CacheWarmPopularArticles)
+	require.True(t, config.SchedulerEnabled)
+	require.Empty(t, config.PeriodicJobs)
+	require.Equal(t, 7*24*time.Hour, config.TempUploadRetention)
+}
+
+func TestLoadConfigSchedulerOverrides(t *testing.T) {
+	configPath := t.TempDir() + string(os.PathSeparator)
+
+	setConfigEnv(t, map[string]string{
+		"SCHEDULER_ENABLED":     "false",
+		"PERIODIC_JOBS":         "warm_cache=@every 5m,cleanup_sessions=off",
+		"TEMP_UPLOAD_RETENTION": "48h",
+	})
+
+	config, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.False(t, config.SchedulerEnabled)
+	require.Equal(t, []string{"warm_cache=@every 5m", "cleanup_sessions=off"}, config.PeriodicJobs)
+	require.Equal(t, 48*time.Hour, config.TempUploadRetention)
 }
 
 func TestLoadConfigEnvironmentOverridesDotEnvFile(t *testing.T) {
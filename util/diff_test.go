@@ -0,0 +1,23 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	lines := DiffLines("a\nb\nc", "a\nc\nd")
+
+	require.Equal(t, []DiffLine{
+		{Op: DiffEqual, Text: "a"},
+		{Op: DiffDelete, Text: "b"},
+		{Op: DiffEqual, Text: "c"},
+		{Op: DiffInsert, Text: "d"},
+	}, lines)
+}
+
+func TestDiffLinesEmpty(t *testing.T) {
+	require.Equal(t, []DiffLine{{Op: DiffInsert, Text: "a"}}, DiffLines("", "a"))
+	require.Empty(t, DiffLines("", ""))
+}
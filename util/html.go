@@ -0,0 +1,118 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// PlainText 提取 HTML 片段中的可见文本，块级标签处断开，连续空白折叠为单个空格
+func PlainText(content string) string {
+	var builder strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	skipDepth := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return strings.Join(strings.Fields(builder.String()), " ")
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if tokenType == html.StartTagToken && isInvisibleTag(string(name)) {
+				skipDepth++
+			}
+			if isBlockTag(string(name)) {
+				builder.WriteByte(' ')
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if isInvisibleTag(string(name)) && skipDepth > 0 {
+				skipDepth--
+			}
+			if isBlockTag(string(name)) {
+				builder.WriteByte(' ')
+			}
+		case html.TextToken:
+			if skipDepth == 0 {
+				builder.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+func isInvisibleTag(name string) bool {
+	switch name {
+	case "script", "style", "template":
+		return true
+	default:
+		return false
+	}
+}
+
+func isBlockTag(name string) bool {
+	switch name {
+	case "p", "div", "br", "hr", "li", "ul", "ol", "blockquote", "pre", "table", "tr", "td", "th",
+		"h1", "h2", "h3", "h4", "h5", "h6", "figure", "figcaption", "section", "article":
+		return true
+	default:
+		return false
+	}
+}
+
+// TruncateRunes 按字符数截断文本，避免截断多字节字符
+func TruncateRunes(value string, max int) string {
+	runes := []rune(value)
+	if max <= 0 || len(runes) <= max {
+		return value
+	}
+	return strings.TrimSpace(string(runes[:max]))
+}
+
+var whitespacePattern = regexp.MustCompile(`[\s\p{Zs}]+`)
+
+// ReplaceHighlightMarkup 把 PGroonga 高亮结果中的 <span class="keyword"> 替换为指定标签，
+// 其余文本统一转义并折叠空白，原文中残留的 HTML 实体会被还原后再转义
+func ReplaceHighlightMarkup(content string, openTag string, closeTag string) string {
+	var builder strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	keywordDepth := 0
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(builder.String())
+		case html.StartTagToken:
+			name, hasAttr := tokenizer.TagName()
+			if string(name) != "span" {
+				continue
+			}
+			if hasAttr && isKeywordSpan(tokenizer) {
+				builder.WriteString(openTag)
+				keywordDepth++
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if string(name) == "span" && keywordDepth > 0 {
+				builder.WriteString(closeTag)
+				keywordDepth--
+			}
+		case html.TextToken:
+			text := html.UnescapeString(string(tokenizer.Text()))
+			builder.WriteString(html.EscapeString(whitespacePattern.ReplaceAllString(text, " ")))
+		}
+	}
+}
+
+func isKeywordSpan(tokenizer *html.Tokenizer) bool {
+	for {
+		key, value, more := tokenizer.TagAttr()
+		if string(key) == "class" && string(value) == "keyword" {
+			return true
+		}
+		if !more {
+			return false
+		}
+	}
+}
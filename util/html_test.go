@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlainTextStripsTagsAndCollapsesWhitespace(t *testing.T) {
+	content := `<h2>Redis 缓存</h2><p>先删<strong>缓存</strong>，再更新&amp;同步。</p>
+		<script>alert("x")</script><ul><li>一致性</li><li>延迟双删</li></ul>`
+
+	require.Equal(t, "Redis 缓存 先删缓存，再更新&同步。 一致性 延迟双删", PlainText(content))
+}
+
+func TestIsValidSlug(t *testing.T) {
+	require.True(t, IsValidSlug("redis-cache"))
+	require.False(t, IsValidSlug("abc"))
+	require.False(t, IsValidSlug("Redis-Cache"))
+	require.False(t, IsValidSlug("redis--cache"))
+}
+
+func TestTruncateRunes(t *testing.T) {
+	require.Equal(t, "缓存一致", TruncateRunes("缓存一致性", 4))
+	require.Equal(t, "缓存", TruncateRunes("缓存", 4))
+}
+
+func TestReplaceHighlightMarkup(t *testing.T) {
+	got := ReplaceHighlightMarkup(`  使用 <span class="keyword">Redis</span>   缓存&amp;nbsp;&lt;script&gt;  `, "<mark>", "</mark>")
+	require.Equal(t, "使用 <mark>Redis</mark> 缓存 &lt;script&gt;", got)
+
+	got = ReplaceHighlightMarkup(`<span class="keyword">Go</span> &amp;amp; Rust`, "<em>", "</em>")
+	require.Equal(t, "<em>Go</em> &amp; Rust", got)
+}
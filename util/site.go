@@ -0,0 +1,48 @@
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NormalizeOrigin 将站点地址规范化为 scheme://host，无法解析时仅去掉末尾斜杠
+func NormalizeOrigin(value string) string {
+	source := strings.TrimSpace(value)
+	if source == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(source)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return strings.TrimRight(source, "/")
+	}
+
+	return fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
+}
+
+// ArticlePath 前台文章地址，优先使用 slug
+func ArticlePath(id uuid.UUID, slug pgtype.Text) string {
+	if slug.Valid && strings.TrimSpace(slug.String) != "" {
+		return "/article/" + strings.TrimSpace(slug.String)
+	}
+	return "/article/" + id.String()
+}
+
+// NormalizeSitePath 规范化站内路径：去掉查询参数、片段与末尾斜杠，不是站内路径时返回空字符串
+func NormalizeSitePath(value string) string {
+	path := strings.TrimSpace(value)
+	if index := strings.IndexAny(path, "?#"); index >= 0 {
+		path = path[:index]
+	}
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return ""
+	}
+	if trimmed := strings.TrimRight(path, "/"); trimmed != "" {
+		return trimmed
+	}
+	return "/"
+}
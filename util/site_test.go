@@ -0,0 +1,15 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSitePath(t *testing.T) {
+	require.Equal(t, "/article/redis-cache", NormalizeSitePath(" /article/redis-cache/?from=rss#top "))
+	require.Equal(t, "/", NormalizeSitePath("/"))
+	require.Equal(t, "", NormalizeSitePath("//evil.example.com/path"))
+	require.Equal(t, "", NormalizeSitePath("https://blog.example.com/article/go"))
+	require.Equal(t, "", NormalizeSitePath("article/go"))
+}
@@ -0,0 +1,16 @@
+package util
+
+import "regexp"
+
+// SlugPattern 文章短标识格式：小写字母、数字，使用单个连字符分隔
+var SlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+const (
+	MinSlugLength = 5
+	MaxSlugLength = 100
+)
+
+// IsValidSlug 校验短标识格式与长度
+func IsValidSlug(slug string) bool {
+	return len(slug) >= MinSlugLength && len(slug) <= MaxSlugLength && SlugPattern.MatchString(slug)
+}
@@ -34,7 +34,17 @@ export interface GetArticleBySlugRequest {
     slug: string
 }
 
-export async function getArticleBySlug(req: GetArticleBySlugRequest): Promise<ApiSuccessResponse<GetArticleResponse>> {
+// 旧短标识或自定义跳转命中时只返回 redirect
+export interface ArticleRedirect {
+    location: string
+    status_code: number
+}
+
+export interface GetArticleBySlugResponse extends Partial<GetArticleResponse> {
+    redirect?: ArticleRedirect
+}
+
+export async function getArticleBySlug(req: GetArticleBySlugRequest): Promise<ApiSuccessResponse<GetArticleBySlugResponse>> {
     return http.get(`/articles/slug/${req.slug}`, {skipAuth: true})
 }
 
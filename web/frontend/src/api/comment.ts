@@ -4,12 +4,41 @@ import type {Comment} from "@/types/comment";
 
 export interface listCommentsRequest {
     articleId: string
+    cursor?: number
+    limit?: number
 }
 
 export interface listCommentsResponse {
     comments: Comment[]
+    next_cursor: number
+    has_more: boolean
+    total: number
+    top_level_total: number
 }
 
 export async function listComments(req: listCommentsRequest): Promise<ApiSuccessResponse<listCommentsResponse>> {
-    return http.get(`/comments/${req.articleId}`, {skipAuth: true})
+    return http.get(`/comments/${req.articleId}`, {
+        params: {cursor: req.cursor, limit: req.limit},
+        skipAuth: true
+    })
+}
+
+export interface listCommentRepliesRequest {
+    articleId: string
+    parentId: number
+    cursor?: number
+    limit?: number
+}
+
+export interface listCommentRepliesResponse {
+    replies: Comment[]
+    next_cursor: number
+    has_more: boolean
+}
+
+export async function listCommentReplies(req: listCommentRepliesRequest): Promise<ApiSuccessResponse<listCommentRepliesResponse>> {
+    return http.get(`/comments/${req.articleId}/replies/${req.parentId}`, {
+        params: {cursor: req.cursor, limit: req.limit},
+        skipAuth: true
+    })
 }
\ No newline at end of file
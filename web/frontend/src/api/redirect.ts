@@ -0,0 +1,12 @@
+import http from "@/util/http";
+import type {ApiSuccessResponse} from "@/types/request/api";
+import type {ArticleRedirect} from "@/api/article";
+
+export interface ResolveRedirectResponse {
+    redirect: ArticleRedirect
+}
+
+// 未命中跳转规则时返回 404
+export async function resolveRedirect(path: string): Promise<ApiSuccessResponse<ResolveRedirectResponse>> {
+    return http.get('/redirects/resolve', {skipAuth: true, skipErrorHandler: true, params: {path}})
+}
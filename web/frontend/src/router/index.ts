@@ -1,4 +1,4 @@
-import { createRouter, createWebHistory } from 'vue-router'
+import { createRouter, createWebHistory, type RouteLocationNormalized } from 'vue-router'
 import HomeView from '../views/HomeView.vue'
 import LoginUser from '@/components/LoginUser.vue'
 import RegisterUser from '@/components/RegisterUser.vue'
@@ -10,6 +10,32 @@ import Forbidden from '@/views/Forbidden.vue'
 import { ADMIN_BASE_PATH, ADMIN_LOGIN_PATH } from '@/admin/adminRoutes'
 import { useAuthStore } from '@/store/module/auth'
 import { applySeoMetadata, buildRouteSeoMetadata } from '@/util/seo'
+import { resolveRedirect } from '@/api/redirect'
+
+// 未匹配任何页面时先查询旧地址的跳转规则，命中则替换为新地址，站外地址整页跳转
+async function resolveLegacyRedirect(to: RouteLocationNormalized) {
+  let location = ''
+  try {
+    const res = await resolveRedirect(to.path)
+    location = res.data.redirect.location
+  } catch {
+    // 没有跳转规则或查询失败时展示 404 页面
+    return true
+  }
+
+  if (!location || location === to.path) return true
+  if (location.startsWith('/') && !location.startsWith('//')) {
+    const target = new URL(location, window.location.origin)
+    return {
+      path: target.pathname,
+      query: Object.fromEntries(target.searchParams),
+      hash: target.hash,
+      replace: true
+    }
+  }
+  window.location.replace(location)
+  return false
+}
 
 const router = createRouter({
   history: createWebHistory(import.meta.env.BASE_URL),
@@ -129,7 +155,8 @@ const router = createRouter({
       path: '/:pathMatch(.*)*',
       name: 'NotFound',
       component: NotFound,
-      meta: { hideNavbar: true }
+      meta: { hideNavbar: true },
+      beforeEnter: resolveLegacyRedirect
     }
   ]
 })
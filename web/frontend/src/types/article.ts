@@ -31,5 +31,6 @@ export interface ArticleComments {
   deleted_at: string
   from_user_name: string
   to_user_name: string
+  reply_count: number
   child: ArticleComments[]
 }
\ No newline at end of file
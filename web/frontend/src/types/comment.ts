@@ -10,5 +10,6 @@ export interface Comment {
     deleted_at: string
     from_user_name: string
     to_user_name: string
+    reply_count: number
     child: Comment[]
 }
\ No newline at end of file
@@ -17,9 +17,19 @@ type TaskDistributor interface {
 		payload *PayloadNotifyAutomationDraft,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskScreenComment(ctx context.Context, payload *PayloadScreenComment, opts ...asynq.Option) error
 	DistributeTaskDelayDeleteCache(ctx context.Context, payload *PayloadDelayDeleteCache, opts ...asynq.Option) error
 	// DistributeTaskDelayDeleteCacheDefault 使用默认配置分发缓存删除任务
 	DistributeTaskDelayDeleteCacheDefault(ctx context.Context, keys ...string) error
+	DistributeTaskSubmitIndexNow(ctx context.Context, payload *PayloadSubmitIndexNow, opts ...asynq.Option) error
+	// DistributeTaskSubmitIndexNowDefault 使用默认配置分发 IndexNow 推送任务
+	DistributeTaskSubmitIndexNowDefault(ctx context.Context, urls ...string) error
+	DistributeTaskFlushArticleCounters(ctx context.Context, opts ...asynq.Option) error
+	// DistributeTaskFlushArticleCountersDefault 使用默认配置分发计数写回任务
+	DistributeTaskFlushArticleCountersDefault(ctx context.Context) error
+	DistributeTaskWarmCache(ctx context.Context, payload *PayloadWarmCache, opts ...asynq.Option) error
+	// DistributeTaskWarmCacheDefault 使用默认配置分发缓存预热任务
+	DistributeTaskWarmCacheDefault(ctx context.Context, payload *PayloadWarmCache) error
 }
 
 type RedisTaskDistributor struct {
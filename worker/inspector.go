@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hibiken/asynq"
+)
+
+// TaskInspector 查看队列中的任务，并处理已耗尽重试次数的失败任务
+type TaskInspector interface {
+	ListQueues() ([]*asynq.QueueInfo, error)
+	GetQueue(queue string) (*asynq.QueueInfo, error)
+	// ListTasks 只支持 pending、retry 与 archived 状态，page 从 1 开始
+	ListTasks(queue string, state asynq.TaskState, page int, pageSize int) ([]*asynq.TaskInfo, error)
+	GetTask(queue string, id string) (*asynq.TaskInfo, error)
+	// RunTask 将任务移回待执行队列，重试次数不会重置
+	RunTask(queue string, id string) error
+	DeleteTask(queue string, id string) error
+}
+
+type RedisTaskInspector struct {
+	inspector *asynq.Inspector
+}
+
+func NewRedisTaskInspector(redisOpt asynq.RedisClientOpt) TaskInspector {
+	return &RedisTaskInspector{inspector: asynq.NewInspector(redisOpt)}
+}
+
+// ListQueues 队列在第一次投递任务后才会出现
+func (inspector *RedisTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	queues, err := inspector.inspector.Queues()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*asynq.QueueInfo, 0, len(queues))
+	for _, queue := range queues {
+		info, err := inspector.inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queue, err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// GetQueue asynq 查询队列信息时不会包装 ErrQueueNotFound，这里先确认队列存在
+func (inspector *RedisTaskInspector) GetQueue(queue string) (*asynq.QueueInfo, error) {
+	queues, err := inspector.inspector.Queues()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(queues, queue) {
+		return nil, fmt.Errorf("asynq: %w", asynq.ErrQueueNotFound)
+	}
+	return inspector.inspector.GetQueueInfo(queue)
+}
+
+func (inspector *RedisTaskInspector) ListTasks(queue string, state asynq.TaskState, page int, pageSize int) ([]*asynq.TaskInfo, error) {
+	opts := []asynq.ListOption{asynq.Page(page), asynq.PageSize(pageSize)}
+
+	switch state {
+	case asynq.TaskStatePending:
+		return inspector.inspector.ListPendingTasks(queue, opts...)
+	case asynq.TaskStateRetry:
+		return inspector.inspector.ListRetryTasks(queue, opts...)
+	case asynq.TaskStateArchived:
+		return inspector.inspector.ListArchivedTasks(queue, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported task state: %s", state)
+	}
+}
+
+func (inspector *RedisTaskInspector) GetTask(queue string, id string) (*asynq.TaskInfo, error) {
+	return inspector.inspector.GetTaskInfo(queue, id)
+}
+
+func (inspector *RedisTaskInspector) RunTask(queue string, id string) error {
+	return inspector.inspector.RunTask(queue, id)
+}
+
+func (inspector *RedisTaskInspector) DeleteTask(queue string, id string) error {
+	return inspector.inspector.DeleteTask(queue, id)
+}
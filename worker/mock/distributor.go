@@ -74,6 +74,39 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskDelayDeleteCacheDefault
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskDelayDeleteCacheDefault", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskDelayDeleteCacheDefault), varargs...)
 }
 
+// DistributeTaskFlushArticleCounters mocks base method.
+func (m *MockTaskDistributor) DistributeTaskFlushArticleCounters(arg0 context.Context, arg1 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskFlushArticleCounters", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskFlushArticleCounters indicates an expected call of DistributeTaskFlushArticleCounters.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskFlushArticleCounters(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskFlushArticleCounters", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskFlushArticleCounters), varargs...)
+}
+
+// DistributeTaskFlushArticleCountersDefault mocks base method.
+func (m *MockTaskDistributor) DistributeTaskFlushArticleCountersDefault(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeTaskFlushArticleCountersDefault", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskFlushArticleCountersDefault indicates an expected call of DistributeTaskFlushArticleCountersDefault.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskFlushArticleCountersDefault(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskFlushArticleCountersDefault", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskFlushArticleCountersDefault), arg0)
+}
+
 // DistributeTaskNotifyAutomationDraft mocks base method.
 func (m *MockTaskDistributor) DistributeTaskNotifyAutomationDraft(arg0 context.Context, arg1 *worker.PayloadNotifyAutomationDraft, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -93,6 +126,25 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskNotifyAutomationDraft(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskNotifyAutomationDraft", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskNotifyAutomationDraft), varargs...)
 }
 
+// DistributeTaskScreenComment mocks base method.
+func (m *MockTaskDistributor) DistributeTaskScreenComment(arg0 context.Context, arg1 *worker.PayloadScreenComment, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskScreenComment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskScreenComment indicates an expected call of DistributeTaskScreenComment.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskScreenComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskScreenComment", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskScreenComment), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -111,3 +163,74 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendVerifyEmail(arg0, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendVerifyEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendVerifyEmail), varargs...)
 }
+
+// DistributeTaskSubmitIndexNow mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSubmitIndexNow(arg0 context.Context, arg1 *worker.PayloadSubmitIndexNow, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSubmitIndexNow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSubmitIndexNow indicates an expected call of DistributeTaskSubmitIndexNow.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSubmitIndexNow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSubmitIndexNow", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSubmitIndexNow), varargs...)
+}
+
+// DistributeTaskSubmitIndexNowDefault mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSubmitIndexNowDefault(arg0 context.Context, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSubmitIndexNowDefault", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSubmitIndexNowDefault indicates an expected call of DistributeTaskSubmitIndexNowDefault.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSubmitIndexNowDefault(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSubmitIndexNowDefault", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSubmitIndexNowDefault), varargs...)
+}
+
+// DistributeTaskWarmCache mocks base method.
+func (m *MockTaskDistributor) DistributeTaskWarmCache(arg0 context.Context, arg1 *worker.PayloadWarmCache, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskWarmCache", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskWarmCache indicates an expected call of DistributeTaskWarmCache.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskWarmCache(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskWarmCache", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskWarmCache), varargs...)
+}
+
+// DistributeTaskWarmCacheDefault mocks base method.
+func (m *MockTaskDistributor) DistributeTaskWarmCacheDefault(arg0 context.Context, arg1 *worker.PayloadWarmCache) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeTaskWarmCacheDefault", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskWarmCacheDefault indicates an expected call of DistributeTaskWarmCacheDefault.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskWarmCacheDefault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskWarmCacheDefault", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskWarmCacheDefault), arg0, arg1)
+}
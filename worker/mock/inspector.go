@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/MonitorAllen/nostalgia/worker (interfaces: TaskInspector)
+
+// Package mockwk is a generated GoMock package.
+package mockwk
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	asynq "github.com/hibiken/asynq"
+)
+
+// MockTaskInspector is a mock of TaskInspector interface.
+type MockTaskInspector struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskInspectorMockRecorder
+}
+
+// MockTaskInspectorMockRecorder is the mock recorder for MockTaskInspector.
+type MockTaskInspectorMockRecorder struct {
+	mock *MockTaskInspector
+}
+
+// NewMockTaskInspector creates a new mock instance.
+func NewMockTaskInspector(ctrl *gomock.Controller) *MockTaskInspector {
+	mock := &MockTaskInspector{ctrl: ctrl}
+	mock.recorder = &MockTaskInspectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskInspector) EXPECT() *MockTaskInspectorMockRecorder {
+	return m.recorder
+}
+
+// DeleteTask mocks base method.
+func (m *MockTaskInspector) DeleteTask(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockTaskInspectorMockRecorder) DeleteTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskInspector)(nil).DeleteTask), arg0, arg1)
+}
+
+// GetQueue mocks base method.
+func (m *MockTaskInspector) GetQueue(arg0 string) (*asynq.QueueInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", arg0)
+	ret0, _ := ret[0].(*asynq.QueueInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockTaskInspectorMockRecorder) GetQueue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockTaskInspector)(nil).GetQueue), arg0)
+}
+
+// GetTask mocks base method.
+func (m *MockTaskInspector) GetTask(arg0, arg1 string) (*asynq.TaskInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", arg0, arg1)
+	ret0, _ := ret[0].(*asynq.TaskInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockTaskInspectorMockRecorder) GetTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockTaskInspector)(nil).GetTask), arg0, arg1)
+}
+
+// ListQueues mocks base method.
+func (m *MockTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues")
+	ret0, _ := ret[0].([]*asynq.QueueInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockTaskInspectorMockRecorder) ListQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockTaskInspector)(nil).ListQueues))
+}
+
+// ListTasks mocks base method.
+func (m *MockTaskInspector) ListTasks(arg0 string, arg1 asynq.TaskState, arg2, arg3 int) ([]*asynq.TaskInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*asynq.TaskInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockTaskInspectorMockRecorder) ListTasks(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskInspector)(nil).ListTasks), arg0, arg1, arg2, arg3)
+}
+
+// RunTask mocks base method.
+func (m *MockTaskInspector) RunTask(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MockTaskInspectorMockRecorder) RunTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockTaskInspector)(nil).RunTask), arg0, arg1)
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
 	"github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
 	"github.com/MonitorAllen/nostalgia/mail"
+	"github.com/MonitorAllen/nostalgia/util"
 	"github.com/hibiken/asynq"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
@@ -17,13 +20,26 @@ type TaskProcessor interface {
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
 	ProcessTaskNotifyAutomationDraft(ctx context.Context, task *asynq.Task) error
 	ProcessTaskDelayDeleteCache(ctx context.Context, task *asynq.Task) error
+	ProcessTaskScreenComment(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSubmitIndexNow(ctx context.Context, task *asynq.Task) error
+	ProcessTaskFlushArticleCounters(ctx context.Context, task *asynq.Task) error
+	ProcessTaskWarmCache(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCleanupVerifyEmails(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCleanupSessions(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCleanupTempUploads(ctx context.Context, task *asynq.Task) error
 }
 
+// AIPolisherResolver 按用途解析 AI 服务，未配置时返回错误
+type AIPolisherResolver func(ctx context.Context, purpose string) (ai.TextPolisher, error)
+
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
-	cache  cache.Cache
-	mailer mail.EmailSender
+	server     *asynq.Server
+	config     util.Config
+	store      db.Store
+	cache      cache.Cache
+	mailer     mail.EmailSender
+	aiPolisher AIPolisherResolver
+	indexNow   *indexnow.Client
 }
 
 const (
@@ -31,7 +47,7 @@ const (
 	QueueDefault  = "default"
 )
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, cache cache.Cache, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, config util.Config, store db.Store, cache cache.Cache, mailer mail.EmailSender, aiPolisher AIPolisherResolver, indexNow *indexnow.Client) TaskProcessor {
 	logger := NewLogger()
 	redis.SetLogger(logger)
 
@@ -42,8 +58,13 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, cache
 				QueueCritical: 10,
 				QueueDefault:  5,
 			},
+			// 重试次数耗尽的任务会进入归档队列，可通过管理接口按 task_id 查看并重新执行
 			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
-				log.Error().Err(err).Str("type", task.Type()).
+				taskID, _ := asynq.GetTaskID(ctx)
+				retried, _ := asynq.GetRetryCount(ctx)
+				maxRetry, _ := asynq.GetMaxRetry(ctx)
+				log.Error().Err(err).Str("type", task.Type()).Str("task_id", taskID).
+					Int("retried", retried).Int("max_retry", maxRetry).
 					Bytes("payload", task.Payload()).Msg("process task failed")
 			}),
 			Logger: logger,
@@ -51,10 +72,13 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, cache
 	)
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		cache:  cache,
-		mailer: mailer,
+		server:     server,
+		config:     config,
+		store:      store,
+		cache:      cache,
+		mailer:     mailer,
+		aiPolisher: aiPolisher,
+		indexNow:   indexNow,
 	}
 }
 
@@ -64,6 +88,13 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
 	mux.HandleFunc(TaskNotifyAutomationDraft, processor.ProcessTaskNotifyAutomationDraft)
 	mux.HandleFunc(TaskDelayDeleteCache, processor.ProcessTaskDelayDeleteCache)
+	mux.HandleFunc(TaskScreenComment, processor.ProcessTaskScreenComment)
+	mux.HandleFunc(TaskSubmitIndexNow, processor.ProcessTaskSubmitIndexNow)
+	mux.HandleFunc(TaskFlushArticleCounters, processor.recordPeriodicRun(processor.ProcessTaskFlushArticleCounters))
+	mux.HandleFunc(TaskWarmCache, processor.recordPeriodicRun(processor.ProcessTaskWarmCache))
+	mux.HandleFunc(TaskCleanupVerifyEmails, processor.recordPeriodicRun(processor.ProcessTaskCleanupVerifyEmails))
+	mux.HandleFunc(TaskCleanupSessions, processor.recordPeriodicRun(processor.ProcessTaskCleanupSessions))
+	mux.HandleFunc(TaskCleanupTempUploads, processor.recordPeriodicRun(processor.ProcessTaskCleanupTempUploads))
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,378 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// 定时任务名称，用于 PERIODIC_JOBS 覆盖默认执行计划
+const (
+	JobFlushArticleCounters = "flush_article_counters"
+	JobWarmCache            = "warm_cache"
+	JobCleanupVerifyEmails  = "cleanup_verify_emails"
+	JobCleanupSessions      = "cleanup_sessions"
+	JobCleanupTempUploads   = "cleanup_temp_uploads"
+)
+
+// periodicJobDisabled 在 PERIODIC_JOBS 中停用某个任务，如 cleanup_sessions=off
+const periodicJobDisabled = "off"
+
+const (
+	// schedulerLeaderKey 位于队列所在的 Redis 库，持有该键的实例负责投递定时任务
+	schedulerLeaderKey = "lock:scheduler:leader"
+	schedulerLeaseTTL  = 30 * time.Second
+)
+
+// PeriodicJob 由调度器按 cron 表达式投递的任务，Spec 为空表示已停用
+type PeriodicJob struct {
+	Name     string
+	Spec     string
+	TaskType string
+	Payload  []byte
+	Opts     []asynq.Option
+	// required 的任务不能停用，调度器关闭时也会单独投递
+	required bool
+	schedule cron.Schedule
+}
+
+func (job PeriodicJob) Enabled() bool {
+	return job.schedule != nil
+}
+
+func (job PeriodicJob) Required() bool {
+	return job.required
+}
+
+// NextRun 返回 now 之后的下一次投递时间，停用的任务返回零值
+func (job PeriodicJob) NextRun(now time.Time) time.Time {
+	if job.schedule == nil {
+		return time.Time{}
+	}
+	return job.schedule.Next(now)
+}
+
+// PeriodicJobs 返回全部定时任务，PERIODIC_JOBS 中的 name=spec 会覆盖默认计划。
+// spec 支持标准五段 cron 表达式与 @every、@daily 等描述符，由于配置按逗号分隔，表达式中不能包含逗号
+func PeriodicJobs(config util.Config) ([]PeriodicJob, error) {
+	flushInterval := config.ArticleCounterFlushInterval
+	if flushInterval <= 0 {
+		flushInterval = 30 * time.Second
+	}
+	warmCachePayload, err := json.Marshal(NewPayloadWarmCache(config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal warm cache payload: %w", err)
+	}
+
+	// 每个任务都带 Unique，切换主实例的瞬间两边各投递一次时只会执行一次
+	jobs := []PeriodicJob{
+		{
+			Name:     JobFlushArticleCounters,
+			Spec:     "@every " + flushInterval.String(),
+			TaskType: TaskFlushArticleCounters,
+			// 浏览与点赞计数只通过该任务写回数据库，停用后增量会一直积压在 Redis
+			required: true,
+			Opts: []asynq.Option{
+				asynq.MaxRetry(3),
+				asynq.Timeout(30 * time.Second),
+				asynq.Queue(QueueDefault),
+				asynq.Unique(articleCounterFlushLockTTL),
+			},
+		},
+		{
+			Name:     JobWarmCache,
+			Spec:     "@every 15m",
+			TaskType: TaskWarmCache,
+			Payload:  warmCachePayload,
+			Opts: []asynq.Option{
+				asynq.MaxRetry(2),
+				asynq.Timeout(2 * time.Minute),
+				asynq.Queue(QueueDefault),
+				asynq.Unique(time.Minute),
+			},
+		},
+		{
+			Name:     JobCleanupVerifyEmails,
+			Spec:     "@hourly",
+			TaskType: TaskCleanupVerifyEmails,
+			Opts:     cleanupTaskOptions(),
+		},
+		{
+			Name:     JobCleanupSessions,
+			Spec:     "@daily",
+			TaskType: TaskCleanupSessions,
+			Opts:     cleanupTaskOptions(),
+		},
+		{
+			Name:     JobCleanupTempUploads,
+			Spec:     "@daily",
+			TaskType: TaskCleanupTempUploads,
+			Opts:     cleanupTaskOptions(),
+		},
+	}
+
+	overrides, err := parsePeriodicJobOverrides(config.PeriodicJobs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		if spec, ok := overrides[jobs[i].Name]; ok {
+			jobs[i].Spec = spec
+			delete(overrides, jobs[i].Name)
+		}
+		if jobs[i].Spec == "" {
+			if jobs[i].required {
+				return nil, fmt.Errorf("periodic job %s cannot be disabled", jobs[i].Name)
+			}
+			continue
+		}
+
+		schedule, err := cron.ParseStandard(jobs[i].Spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q for periodic job %s: %w", jobs[i].Spec, jobs[i].Name, err)
+		}
+		jobs[i].schedule = schedule
+	}
+	for name := range overrides {
+		return nil, fmt.Errorf("unknown periodic job: %s", name)
+	}
+
+	return jobs, nil
+}
+
+// RequiredPeriodicJobs 返回不能停用的任务，SCHEDULER_ENABLED 关闭时只投递这些任务
+func RequiredPeriodicJobs(jobs []PeriodicJob) []PeriodicJob {
+	var required []PeriodicJob
+	for _, job := range jobs {
+		if job.required {
+			required = append(required, job)
+		}
+	}
+	return required
+}
+
+func cleanupTaskOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(10 * time.Minute),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(time.Hour),
+	}
+}
+
+func parsePeriodicJobOverrides(entries []string) (map[string]string, error) {
+	overrides := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid periodic job %q: expected name=spec", entry)
+		}
+		name = strings.TrimSpace(name)
+		spec = strings.TrimSpace(spec)
+		if strings.EqualFold(spec, periodicJobDisabled) {
+			spec = ""
+		}
+		overrides[name] = spec
+	}
+	return overrides, nil
+}
+
+// recordPeriodicRun 记录任务最近一次执行结果，接口触发的同类任务同样会被记录
+func (processor *RedisTaskProcessor) recordPeriodicRun(handler asynq.HandlerFunc) asynq.HandlerFunc {
+	return func(ctx context.Context, task *asynq.Task) error {
+		run := cachepkg.PeriodicJobRun{StartedAt: time.Now()}
+		err := handler(ctx, task)
+		run.FinishedAt = time.Now()
+		run.Succeeded = err == nil
+		if err != nil {
+			run.Error = err.Error()
+		}
+
+		schedulerCache := cachepkg.NewSchedulerCache(processor.cache)
+		if setErr := schedulerCache.SetLastRun(context.WithoutCancel(ctx), task.Type(), run); setErr != nil {
+			log.Error().Err(setErr).Str("type", task.Type()).Msg("failed to record periodic job run")
+		}
+		return err
+	}
+}
+
+// leaderLease 多实例之间的主实例租约
+type leaderLease interface {
+	// Acquire 获取或续期租约，其他实例持有时返回 false
+	Acquire(ctx context.Context) (bool, error)
+	Release(ctx context.Context) error
+}
+
+// periodicEnqueuer asynq.Scheduler 关闭后不能再次启动，每次成为主实例时重新创建
+type periodicEnqueuer interface {
+	Register(cronspec string, task *asynq.Task, opts ...asynq.Option) (string, error)
+	Start() error
+	Shutdown()
+}
+
+// PeriodicScheduler 只有持有租约的实例会启动 asynq 调度器，多实例部署时定时任务不会重复投递
+type PeriodicScheduler struct {
+	jobs          []PeriodicJob
+	lease         leaderLease
+	newEnqueuer   func() periodicEnqueuer
+	renewInterval time.Duration
+	enqueuer      periodicEnqueuer
+	close         func() error
+}
+
+func NewPeriodicScheduler(redisOpt asynq.RedisClientOpt, jobs []PeriodicJob) *PeriodicScheduler {
+	client := redisOpt.MakeRedisClient().(redis.UniversalClient)
+
+	return &PeriodicScheduler{
+		jobs: jobs,
+		lease: &redisLeaderLease{
+			client: client,
+			key:    schedulerLeaderKey,
+			owner:  uuid.NewString(),
+			ttl:    schedulerLeaseTTL,
+		},
+		newEnqueuer: func() periodicEnqueuer {
+			return asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+				Logger:   NewLogger(),
+				Location: time.Local,
+				PostEnqueueFunc: func(info *asynq.TaskInfo, err error) {
+					if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+						log.Error().Err(err).Msg("failed to enqueue periodic task")
+					}
+				},
+			})
+		},
+		renewInterval: schedulerLeaseTTL / 3,
+		close:         client.Close,
+	}
+}
+
+// Run 阻塞直到 ctx 结束，退出前释放租约以便其他实例尽快接管
+func (scheduler *PeriodicScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(scheduler.renewInterval)
+	defer ticker.Stop()
+
+	defer func() {
+		scheduler.stop()
+		if err := scheduler.lease.Release(context.WithoutCancel(ctx)); err != nil {
+			log.Error().Err(err).Msg("failed to release scheduler lease")
+		}
+		if scheduler.close != nil {
+			if err := scheduler.close(); err != nil {
+				log.Error().Err(err).Msg("failed to close scheduler redis client")
+			}
+		}
+	}()
+
+	for {
+		scheduler.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick 续期租约并按结果启停调度器，无法确认租约时宁可漏投一次也不重复投递
+func (scheduler *PeriodicScheduler) tick(ctx context.Context) {
+	leader, err := scheduler.lease.Acquire(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error().Err(err).Msg("failed to renew scheduler lease")
+		}
+		leader = false
+	}
+	if !leader {
+		scheduler.stop()
+		return
+	}
+	if scheduler.enqueuer != nil {
+		return
+	}
+
+	enqueuer := scheduler.newEnqueuer()
+	for _, job := range scheduler.jobs {
+		if !job.Enabled() {
+			continue
+		}
+		task := asynq.NewTask(job.TaskType, job.Payload)
+		if _, err := enqueuer.Register(job.Spec, task, job.Opts...); err != nil {
+			log.Error().Err(err).Str("job", job.Name).Msg("failed to register periodic job")
+		}
+	}
+	if err := enqueuer.Start(); err != nil {
+		log.Error().Err(err).Msg("failed to start scheduler")
+		return
+	}
+
+	scheduler.enqueuer = enqueuer
+	log.Info().Msg("became scheduler leader")
+}
+
+func (scheduler *PeriodicScheduler) stop() {
+	if scheduler.enqueuer == nil {
+		return
+	}
+	scheduler.enqueuer.Shutdown()
+	scheduler.enqueuer = nil
+	log.Info().Msg("stepped down as scheduler leader")
+}
+
+// acquireLeaseScript 键不存在时抢占租约，自己持有时续期
+var acquireLeaseScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if not owner then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if owner == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript 只释放自己持有的租约
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type redisLeaderLease struct {
+	client redis.UniversalClient
+	key    string
+	owner  string
+	ttl    time.Duration
+}
+
+func (lease *redisLeaderLease) Acquire(ctx context.Context) (bool, error) {
+	acquired, err := acquireLeaseScript.Run(ctx, lease.client, []string{lease.key}, lease.owner, lease.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return acquired == 1, nil
+}
+
+func (lease *redisLeaderLease) Release(ctx context.Context) error {
+	return releaseLeaseScript.Run(ctx, lease.client, []string{lease.key}, lease.owner).Err()
+}
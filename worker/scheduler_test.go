@@ -0,0 +1,234 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicJobs(t *testing.T) {
+	jobs, err := PeriodicJobs(util.Config{ArticleCounterFlushInterval: 10 * time.Second})
+	require.NoError(t, err)
+
+	specs := make(map[string]string, len(jobs))
+	for _, job := range jobs {
+		require.True(t, job.Enabled())
+		specs[job.Name] = job.Spec
+	}
+	require.Equal(t, map[string]string{
+		JobFlushArticleCounters: "@every 10s",
+		JobWarmCache:            "@every 15m",
+		JobCleanupVerifyEmails:  "@hourly",
+		JobCleanupSessions:      "@daily",
+		JobCleanupTempUploads:   "@daily",
+	}, specs)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, now.Add(10*time.Second), jobs[0].NextRun(now))
+
+	required := RequiredPeriodicJobs(jobs)
+	require.Len(t, required, 1)
+	require.Equal(t, JobFlushArticleCounters, required[0].Name)
+}
+
+func TestPeriodicJobsOverrides(t *testing.T) {
+	jobs, err := PeriodicJobs(util.Config{
+		PeriodicJobs: []string{" cleanup_sessions = 30 3 * * * ", "warm_cache=off", "cleanup_temp_uploads="},
+	})
+	require.NoError(t, err)
+
+	for _, job := range jobs {
+		switch job.Name {
+		case JobCleanupSessions:
+			require.Equal(t, "30 3 * * *", job.Spec)
+			require.True(t, job.Enabled())
+		case JobWarmCache, JobCleanupTempUploads:
+			require.False(t, job.Enabled())
+			require.True(t, job.NextRun(time.Now()).IsZero())
+		default:
+			require.True(t, job.Enabled())
+		}
+	}
+}
+
+func TestPeriodicJobsInvalidConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		entries []string
+	}{
+		{name: "UnknownJob", entries: []string{"rebuild_index=@daily"}},
+		{name: "MissingSpec", entries: []string{"cleanup_sessions"}},
+		{name: "InvalidSpec", entries: []string{"cleanup_sessions=every day"}},
+		{name: "DisableRequiredJob", entries: []string{"flush_article_counters=off"}},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := PeriodicJobs(util.Config{PeriodicJobs: tc.entries})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRecordPeriodicRun(t *testing.T) {
+	task := asynq.NewTask(TaskCleanupSessions, nil)
+
+	testCases := []struct {
+		name       string
+		handlerErr error
+		checkRun   func(t *testing.T, run cachepkg.PeriodicJobRun)
+	}{
+		{
+			name: "Succeeded",
+			checkRun: func(t *testing.T, run cachepkg.PeriodicJobRun) {
+				require.True(t, run.Succeeded)
+				require.Empty(t, run.Error)
+			},
+		},
+		{
+			name:       "Failed",
+			handlerErr: errors.New("boom"),
+			checkRun: func(t *testing.T, run cachepkg.PeriodicJobRun) {
+				require.False(t, run.Succeeded)
+				require.Equal(t, "boom", run.Error)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cache := mockcache.NewMockCache(ctrl)
+			cache.EXPECT().
+				Set(gomock.Any(), key.GetSchedulerLastRunKey(TaskCleanupSessions), gomock.Any(), time.Duration(0)).
+				Times(1).
+				DoAndReturn(func(_ context.Context, _ string, value any, _ time.Duration) error {
+					run := value.(cachepkg.PeriodicJobRun)
+					require.False(t, run.FinishedAt.Before(run.StartedAt))
+					tc.checkRun(t, run)
+					return nil
+				})
+
+			processor := &RedisTaskProcessor{cache: cache}
+			handler := processor.recordPeriodicRun(func(context.Context, *asynq.Task) error {
+				return tc.handlerErr
+			})
+			require.ErrorIs(t, handler(context.Background(), task), tc.handlerErr)
+		})
+	}
+}
+
+type fakeLeaderLease struct {
+	leader   bool
+	err      error
+	released bool
+}
+
+func (lease *fakeLeaderLease) Acquire(context.Context) (bool, error) {
+	return lease.leader, lease.err
+}
+
+func (lease *fakeLeaderLease) Release(context.Context) error {
+	lease.released = true
+	return nil
+}
+
+type fakeEnqueuer struct {
+	specs    []string
+	started  bool
+	shutdown bool
+}
+
+func (enqueuer *fakeEnqueuer) Register(cronspec string, _ *asynq.Task, _ ...asynq.Option) (string, error) {
+	enqueuer.specs = append(enqueuer.specs, cronspec)
+	return cronspec, nil
+}
+
+func (enqueuer *fakeEnqueuer) Start() error {
+	enqueuer.started = true
+	return nil
+}
+
+func (enqueuer *fakeEnqueuer) Shutdown() {
+	enqueuer.shutdown = true
+}
+
+func TestPeriodicSchedulerLeadership(t *testing.T) {
+	jobs, err := PeriodicJobs(util.Config{PeriodicJobs: []string{"warm_cache=off"}})
+	require.NoError(t, err)
+
+	lease := &fakeLeaderLease{}
+	var enqueuers []*fakeEnqueuer
+	scheduler := &PeriodicScheduler{
+		jobs:  jobs,
+		lease: lease,
+		newEnqueuer: func() periodicEnqueuer {
+			enqueuer := &fakeEnqueuer{}
+			enqueuers = append(enqueuers, enqueuer)
+			return enqueuer
+		},
+		renewInterval: time.Millisecond,
+	}
+	ctx := context.Background()
+
+	// 其他实例持有租约时不投递
+	scheduler.tick(ctx)
+	require.Empty(t, enqueuers)
+
+	lease.leader = true
+	scheduler.tick(ctx)
+	require.Len(t, enqueuers, 1)
+	require.True(t, enqueuers[0].started)
+	require.Len(t, enqueuers[0].specs, len(jobs)-1)
+
+	// 续期成功时沿用正在运行的调度器
+	scheduler.tick(ctx)
+	require.Len(t, enqueuers, 1)
+
+	// 无法确认租约时立即停止投递
+	lease.err = errors.New("redis is down")
+	scheduler.tick(ctx)
+	require.True(t, enqueuers[0].shutdown)
+
+	lease.err = nil
+	scheduler.tick(ctx)
+	require.Len(t, enqueuers, 2)
+	require.True(t, enqueuers[1].started)
+
+	lease.leader = false
+	scheduler.tick(ctx)
+	require.True(t, enqueuers[1].shutdown)
+}
+
+func TestPeriodicSchedulerRunReleasesLease(t *testing.T) {
+	lease := &fakeLeaderLease{leader: true}
+	enqueuer := &fakeEnqueuer{}
+	scheduler := &PeriodicScheduler{
+		lease:         lease,
+		newEnqueuer:   func() periodicEnqueuer { return enqueuer },
+		renewInterval: time.Millisecond,
+	}
+
+	// 启动时先续期一次租约，ctx 结束后停止调度器并释放租约
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, scheduler.Run(ctx))
+	require.True(t, enqueuer.started)
+	require.True(t, enqueuer.shutdown)
+	require.True(t, lease.released)
+}
@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCleanupSessions = "task:cleanup_sessions"
+
+// ProcessTaskCleanupSessions 过期的会话已无法刷新令牌，直接删除
+func (processor *RedisTaskProcessor) ProcessTaskCleanupSessions(ctx context.Context, task *asynq.Task) error {
+	deleted, err := processor.store.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("deleted", deleted).Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCleanupTempUploads = "task:cleanup_temp_uploads"
+
+const defaultTempUploadRetention = 7 * 24 * time.Hour
+
+// ProcessTaskCleanupTempUploads 删除超过保留时间的临时上传文件。
+// 新建文章时上传的图片不会移出 temp 目录，仍被正文或封面引用的文件需要保留
+func (processor *RedisTaskProcessor) ProcessTaskCleanupTempUploads(ctx context.Context, task *asynq.Task) error {
+	retention := processor.config.TempUploadRetention
+	if retention <= 0 {
+		retention = defaultTempUploadRetention
+	}
+
+	tempDir := filepath.Join(util.ResolveResourcePath(processor.config.ResourcePath), "temp")
+	entries, err := os.ReadDir(tempDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read temp upload dir: %w", err)
+	}
+
+	cutoff := time.Now().Add(-retention)
+	var deleted, referenced int
+	var errs []error
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to stat %s: %w", entry.Name(), err))
+			}
+			continue
+		}
+		if info.ModTime().After(cutoff) {
+			continue
+		}
+
+		inUse, err := processor.store.IsResourceReferenced(ctx, "/resources/temp/"+entry.Name())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check references of %s: %w", entry.Name(), err))
+			continue
+		}
+		if inUse {
+			referenced++
+			continue
+		}
+
+		err = os.Remove(filepath.Join(tempDir, entry.Name()))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("failed to remove %s: %w", entry.Name(), err))
+			continue
+		}
+		deleted++
+	}
+
+	log.Info().Str("type", task.Type()).Int("deleted", deleted).Int("referenced", referenced).
+		Int("failed", len(errs)).Msg("processed task")
+
+	return errors.Join(errs...)
+}
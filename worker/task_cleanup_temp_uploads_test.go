@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTaskCleanupTempUploads(t *testing.T) {
+	task := asynq.NewTask(TaskCleanupTempUploads, nil)
+	old := time.Now().Add(-48 * time.Hour)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkFiles func(t *testing.T, tempDir string, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsResourceReferenced(gomock.Any(), "/resources/temp/orphan.png").Times(1).Return(false, nil)
+				store.EXPECT().IsResourceReferenced(gomock.Any(), "/resources/temp/used.png").Times(1).Return(true, nil)
+			},
+			checkFiles: func(t *testing.T, tempDir string, err error) {
+				require.NoError(t, err)
+				require.NoFileExists(t, filepath.Join(tempDir, "orphan.png"))
+				require.FileExists(t, filepath.Join(tempDir, "used.png"))
+				require.FileExists(t, filepath.Join(tempDir, "fresh.png"))
+			},
+		},
+		{
+			name: "ReferenceCheckFailed",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsResourceReferenced(gomock.Any(), "/resources/temp/orphan.png").Times(1).Return(false, nil)
+				store.EXPECT().IsResourceReferenced(gomock.Any(), "/resources/temp/used.png").Times(1).Return(false, errors.New("db down"))
+			},
+			checkFiles: func(t *testing.T, tempDir string, err error) {
+				require.Error(t, err)
+				require.NoFileExists(t, filepath.Join(tempDir, "orphan.png"))
+				require.FileExists(t, filepath.Join(tempDir, "used.png"))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			root := t.TempDir()
+			tempDir := filepath.Join(root, "temp")
+			require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "nested"), 0o755))
+			for _, name := range []string{"orphan.png", "used.png", "fresh.png"} {
+				require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte("image"), 0o644))
+			}
+			require.NoError(t, os.Chtimes(filepath.Join(tempDir, "orphan.png"), old, old))
+			require.NoError(t, os.Chtimes(filepath.Join(tempDir, "used.png"), old, old))
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			processor := &RedisTaskProcessor{
+				store: store,
+				config: util.Config{
+					ResourcePath:        root,
+					TempUploadRetention: 24 * time.Hour,
+				},
+			}
+			err := processor.ProcessTaskCleanupTempUploads(context.Background(), task)
+			tc.checkFiles(t, tempDir, err)
+		})
+	}
+}
+
+func TestProcessTaskCleanupTempUploadsMissingDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().IsResourceReferenced(gomock.Any(), gomock.Any()).Times(0)
+
+	processor := &RedisTaskProcessor{store: store, config: util.Config{ResourcePath: t.TempDir()}}
+	require.NoError(t, processor.ProcessTaskCleanupTempUploads(context.Background(), asynq.NewTask(TaskCleanupTempUploads, nil)))
+}
@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCleanupVerifyEmails = "task:cleanup_verify_emails"
+
+// verifyEmailRetention 过期的验证记录再保留一天，便于排查用户反馈的验证问题
+const verifyEmailRetention = 24 * time.Hour
+
+func (processor *RedisTaskProcessor) ProcessTaskCleanupVerifyEmails(ctx context.Context, task *asynq.Task) error {
+	deleted, err := processor.store.DeleteExpiredVerifyEmails(ctx, time.Now().Add(-verifyEmailRetention))
+	if err != nil {
+		return fmt.Errorf("failed to delete expired verify emails: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("deleted", deleted).Msg("processed task")
+
+	return nil
+}
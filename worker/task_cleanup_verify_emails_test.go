@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTaskCleanupVerifyEmails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		DeleteExpiredVerifyEmails(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, expiredBefore time.Time) (int64, error) {
+			// 刚过期的记录保留一天
+			require.WithinDuration(t, time.Now().Add(-verifyEmailRetention), expiredBefore, time.Minute)
+			return 3, nil
+		})
+	store.EXPECT().DeleteExpiredVerifyEmails(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), errors.New("db down"))
+
+	processor := &RedisTaskProcessor{store: store}
+	task := asynq.NewTask(TaskCleanupVerifyEmails, nil)
+	require.NoError(t, processor.ProcessTaskCleanupVerifyEmails(context.Background(), task))
+	require.Error(t, processor.ProcessTaskCleanupVerifyEmails(context.Background(), task))
+}
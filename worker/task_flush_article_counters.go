@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskFlushArticleCounters = "task:flush_article_counters"
+
+// articleCounterFlushLockTTL 需大于任务超时时间，避免锁提前释放导致重复写回
+const articleCounterFlushLockTTL = time.Minute
+
+func (distributor *RedisTaskDistributor) DistributeTaskFlushArticleCounters(ctx context.Context, opts ...asynq.Option) error {
+	task := asynq.NewTask(TaskFlushArticleCounters, nil, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Debug().Str("type", task.Type()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+// DistributeTaskFlushArticleCountersDefault 使用默认配置分发计数写回任务
+// 默认配置：MaxRetry=3, Timeout=30s, Queue=default，队列中已有未执行的写回任务时返回 asynq.ErrDuplicateTask
+func (distributor *RedisTaskDistributor) DistributeTaskFlushArticleCountersDefault(ctx context.Context) error {
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(30 * time.Second),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(articleCounterFlushLockTTL),
+	}
+	return distributor.DistributeTaskFlushArticleCounters(ctx, opts...)
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskFlushArticleCounters(ctx context.Context, task *asynq.Task) error {
+	if processor.cache == nil {
+		return nil
+	}
+	counterCache := cachepkg.NewArticleCounterCache(processor.cache)
+
+	locked, err := counterCache.AcquireFlushLock(ctx, articleCounterFlushLockTTL)
+	if err != nil {
+		return fmt.Errorf("failed to acquire flush lock: %w", err)
+	}
+	if !locked {
+		log.Info().Str("type", task.Type()).Msg("skip flushing article counters: another flush is running")
+		return nil
+	}
+	defer func() {
+		if err := counterCache.ReleaseFlushLock(context.WithoutCancel(ctx)); err != nil {
+			log.Error().Err(err).Str("type", task.Type()).Msg("failed to release flush lock")
+		}
+	}()
+
+	batch, err := counterCache.TakePending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to take pending counters: %w", err)
+	}
+	if len(batch.Deltas) == 0 {
+		// 只剩批次 ID 的空批次也要清除，否则新的增量无法进入写回
+		if batch.ID != "" {
+			if err := counterCache.CompleteFlush(ctx, nil); err != nil {
+				return fmt.Errorf("failed to clear empty counter batch: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// 按 ID 排序加锁，避免与其他批量更新互相等待
+	arg := db.IncrementArticleCountersParams{
+		Ids:   make([]uuid.UUID, 0, len(batch.Deltas)),
+		Views: make([]int32, 0, len(batch.Deltas)),
+		Likes: make([]int32, 0, len(batch.Deltas)),
+	}
+	for id := range batch.Deltas {
+		arg.Ids = append(arg.Ids, id)
+	}
+	slices.SortFunc(arg.Ids, func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	for _, id := range arg.Ids {
+		arg.Views = append(arg.Views, clampInt32(batch.Deltas[id].Views))
+		arg.Likes = append(arg.Likes, clampInt32(batch.Deltas[id].Likes))
+	}
+
+	// 写入失败时本批增量保留在 flushing 键中，下次执行时重试；
+	// 批次 ID 与计数在同一事务内写入，写回成功但清除失败时重试不会重复累加
+	result, err := processor.store.FlushArticleCountersTx(ctx, db.FlushArticleCountersTxParams{
+		BatchID:                        batch.ID,
+		IncrementArticleCountersParams: arg,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flush article counters: %w", err)
+	}
+
+	// 写回后的计数与清除本批在同一事务中完成，详情缓存无需删除，读取时以写回后的计数为准叠加未写回的增量；
+	// 清除失败时本批留待重试，重试不会重复累加
+	totals := make(map[uuid.UUID]cachepkg.ArticleCounterTotals, len(result.Articles))
+	for _, article := range result.Articles {
+		totals[article.ID] = cachepkg.ArticleCounterTotals{Views: article.Views, Likes: article.Likes}
+	}
+	if err := counterCache.CompleteFlush(ctx, totals); err != nil {
+		log.Error().Err(err).Str("type", task.Type()).Msg("failed to clear flushed article counters")
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Str("batch_id", batch.ID).
+		Bool("applied", result.Applied).
+		Int("articles", len(result.Articles)).
+		Msg("processed task")
+
+	return nil
+}
+
+func clampInt32(value int64) int32 {
+	if value > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(value)
+}
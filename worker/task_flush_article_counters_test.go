@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTaskFlushArticleCounters(t *testing.T) {
+	articleID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	batchID := "22222222-2222-2222-2222-222222222222"
+	task := asynq.NewTask(TaskFlushArticleCounters, nil)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), key.ArticleCounterFlushLockKey, gomock.Any(), articleCounterFlushLockTTL).Return(true, nil)
+				cache.EXPECT().HGetAll(gomock.Any(), key.ArticleCounterFlushingKey).Return(map[string]string{}, nil)
+				cache.EXPECT().Rename(gomock.Any(), key.ArticleCounterPendingKey, key.ArticleCounterFlushingKey).Return(true, nil)
+				cache.EXPECT().HGetAll(gomock.Any(), key.ArticleCounterFlushingKey).Return(map[string]string{
+					"views:" + articleID.String(): "7",
+					"likes:" + articleID.String(): "2",
+				}, nil)
+				cache.EXPECT().HIncrBy(gomock.Any(), key.ArticleCounterFlushingKey, gomock.Any(), int64(1)).Return(int64(1), nil)
+				store.EXPECT().
+					FlushArticleCountersTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.FlushArticleCountersTxParams) (db.FlushArticleCountersTxResult, error) {
+						require.NotEmpty(t, arg.BatchID)
+						require.Equal(t, db.IncrementArticleCountersParams{
+							Ids:   []uuid.UUID{articleID},
+							Views: []int32{7},
+							Likes: []int32{2},
+						}, arg.IncrementArticleCountersParams)
+						return db.FlushArticleCountersTxResult{
+							Applied:  true,
+							Articles: []db.IncrementArticleCountersRow{{ID: articleID, Views: 17, Likes: 5}},
+						}, nil
+					})
+				// 写回后的计数与清除本批在同一事务中完成
+				cache.EXPECT().
+					SetAndDel(gomock.Any(), map[string]any{
+						key.GetArticleCounterTotalsKey(articleID): cachepkg.ArticleCounterTotals{Views: 17, Likes: 5},
+					}, cachepkg.ArticleCounterTotalsTTL, key.ArticleCounterFlushingKey).
+					Return(nil)
+				cache.EXPECT().Del(gomock.Any(), key.ArticleCounterFlushLockKey).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NothingPending",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), key.ArticleCounterFlushLockKey, gomock.Any(), gomock.Any()).Return(true, nil)
+				cache.EXPECT().HGetAll(gomock.Any(), key.ArticleCounterFlushingKey).Return(map[string]string{}, nil)
+				cache.EXPECT().Rename(gomock.Any(), key.ArticleCounterPendingKey, key.ArticleCounterFlushingKey).Return(false, nil)
+				store.EXPECT().FlushArticleCountersTx(gomock.Any(), gomock.Any()).Times(0)
+				cache.EXPECT().Del(gomock.Any(), key.ArticleCounterFlushLockKey).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Locked",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), key.ArticleCounterFlushLockKey, gomock.Any(), gomock.Any()).Return(false, nil)
+				cache.EXPECT().HGetAll(gomock.Any(), gomock.Any()).Times(0)
+				cache.EXPECT().Del(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "DBErrorKeepsBatch",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), key.ArticleCounterFlushLockKey, gomock.Any(), gomock.Any()).Return(true, nil)
+				// 上次失败留下的批次直接重试
+				cache.EXPECT().HGetAll(gomock.Any(), key.ArticleCounterFlushingKey).Return(map[string]string{
+					"views:" + articleID.String():                "3",
+					key.ArticleCounterBatchFieldPrefix + batchID: "1",
+				}, nil)
+				cache.EXPECT().Rename(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				cache.EXPECT().HIncrBy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					FlushArticleCountersTx(gomock.Any(), db.FlushArticleCountersTxParams{
+						BatchID: batchID,
+						IncrementArticleCountersParams: db.IncrementArticleCountersParams{
+							Ids:   []uuid.UUID{articleID},
+							Views: []int32{3},
+							Likes: []int32{0},
+						},
+					}).
+					Return(db.FlushArticleCountersTxResult{}, sql.ErrConnDone)
+				cache.EXPECT().SetAndDel(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				cache.EXPECT().Del(gomock.Any(), key.ArticleCounterFlushLockKey).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+		{
+			name: "AlreadyApplied",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().SetNX(gomock.Any(), key.ArticleCounterFlushLockKey, gomock.Any(), gomock.Any()).Return(true, nil)
+				// 上次写回成功但清除失败，批次 ID 已记录在数据库中
+				cache.EXPECT().HGetAll(gomock.Any(), key.ArticleCounterFlushingKey).Return(map[string]string{
+					"views:" + articleID.String():                "3",
+					key.ArticleCounterBatchFieldPrefix + batchID: "1",
+				}, nil)
+				store.EXPECT().
+					FlushArticleCountersTx(gomock.Any(), gomock.Any()).
+					Return(db.FlushArticleCountersTxResult{
+						Articles: []db.IncrementArticleCountersRow{{ID: articleID, Views: 13, Likes: 2}},
+					}, nil)
+				cache.EXPECT().
+					SetAndDel(gomock.Any(), map[string]any{
+						key.GetArticleCounterTotalsKey(articleID): cachepkg.ArticleCounterTotals{Views: 13, Likes: 2},
+					}, gomock.Any(), key.ArticleCounterFlushingKey).
+					Return(nil)
+				cache.EXPECT().Del(gomock.Any(), key.ArticleCounterFlushLockKey).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			cache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, cache)
+
+			processor := &RedisTaskProcessor{store: store, cache: cache}
+			err := processor.ProcessTaskFlushArticleCounters(context.Background(), task)
+			tc.checkError(t, err)
+		})
+	}
+}
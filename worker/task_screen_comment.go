@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskScreenComment = "task:screen_comment"
+
+type PayloadScreenComment struct {
+	CommentID int64 `json:"comment_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskScreenComment(ctx context.Context, payload *PayloadScreenComment, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskScreenComment, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskScreenComment(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadScreenComment
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	comment, err := processor.store.GetComment(ctx, payload.CommentID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("comment not found: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get comment: %w", err)
+	}
+	// 只审核仍在等待的评论，已被拦截或人工处理过的评论不再重复审核
+	if comment.Status != moderation.StatusPending {
+		return nil
+	}
+
+	verdict, err := processor.screenComment(ctx, comment)
+	if err != nil {
+		// 最后一次重试仍失败时转人工审核，避免评论一直不公开
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, ok := asynq.GetMaxRetry(ctx)
+		if !ok || retried < maxRetry {
+			return err
+		}
+		log.Error().Err(err).Int64("comment_id", comment.ID).Msg("failed to screen comment, hold for review")
+		verdict = moderation.Verdict{Held: true, Reason: moderation.ReasonScreeningUnavailable}
+	}
+
+	resolved, err := processor.store.ResolvePendingComment(ctx, db.ResolvePendingCommentParams{
+		ID:               comment.ID,
+		Status:           verdict.Status(),
+		ModerationReason: verdict.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to resolve comment: %w", err)
+	}
+	if resolved.Status == moderation.StatusPublished {
+		if err := cachepkg.NewCommentCache(processor.cache).Invalidate(ctx, resolved.ArticleID); err != nil {
+			log.Error().Err(err).Int64("comment_id", resolved.ID).Msg("failed to invalidate comment cache")
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int64("comment_id", resolved.ID).
+		Str("status", resolved.Status).Str("reason", resolved.ModerationReason).Msg("screened comment")
+
+	return nil
+}
+
+// screenComment 未配置 AI 时直接通过，评论已经过启发式规则筛查
+func (processor *RedisTaskProcessor) screenComment(ctx context.Context, comment db.Comment) (moderation.Verdict, error) {
+	if processor.aiPolisher == nil {
+		return moderation.Verdict{}, nil
+	}
+	polisher, err := processor.aiPolisher(ctx, ai.PurposeModeration)
+	if errors.Is(err, ai.ErrDisabled) {
+		log.Warn().Int64("comment_id", comment.ID).Msg("skip comment AI screening: AI is not configured")
+		return moderation.Verdict{}, nil
+	}
+	if err != nil {
+		return moderation.Verdict{}, fmt.Errorf("failed to resolve AI service: %w", err)
+	}
+
+	resp, err := polisher.Polish(ctx, ai.PolishRequest{
+		Mode:   ai.ModeCommentModeration,
+		Target: ai.TargetComment,
+		Text:   comment.Content,
+	})
+	switch {
+	case errors.Is(err, ai.ErrDisabled):
+		return moderation.Verdict{}, nil
+	case errors.Is(err, ai.ErrInvalidInput):
+		// 超出长度限制等无法交给模型判断的评论转人工审核
+		return moderation.Verdict{Held: true, Reason: moderation.ReasonScreeningUnavailable}, nil
+	case err != nil:
+		return moderation.Verdict{}, fmt.Errorf("failed to screen comment: %w", err)
+	}
+
+	return moderation.ClassifyAIResponse(resp), nil
+}
@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/ai"
+	"github.com/MonitorAllen/nostalgia/internal/moderation"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeModerationPolisher struct {
+	request ai.PolishRequest
+	resp    ai.PolishResponse
+}
+
+func (polisher *fakeModerationPolisher) Polish(ctx context.Context, req ai.PolishRequest) (ai.PolishResponse, error) {
+	polisher.request = req
+	return polisher.resp, nil
+}
+
+func newScreenCommentTask(t *testing.T, commentID int64) *asynq.Task {
+	payload, err := json.Marshal(PayloadScreenComment{CommentID: commentID})
+	require.NoError(t, err)
+	return asynq.NewTask(TaskScreenComment, payload)
+}
+
+func TestProcessTaskScreenCommentHoldsFlaggedComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	polisher := &fakeModerationPolisher{resp: ai.PolishResponse{
+		Suggestions: []ai.Suggestion{{Content: "toxic", Reason: "人身攻击"}},
+	}}
+	var purpose string
+	processor := &RedisTaskProcessor{
+		store: store,
+		aiPolisher: func(ctx context.Context, value string) (ai.TextPolisher, error) {
+			purpose = value
+			return polisher, nil
+		},
+	}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(7)).
+		Return(db.Comment{ID: 7, Content: "你写的什么垃圾", Status: moderation.StatusPending}, nil)
+	store.EXPECT().ResolvePendingComment(gomock.Any(), db.ResolvePendingCommentParams{
+		ID:               7,
+		Status:           moderation.StatusHeld,
+		ModerationReason: "ai: toxic: 人身攻击",
+	}).Return(db.Comment{ID: 7, Status: moderation.StatusHeld}, nil)
+
+	err := processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 7))
+	require.NoError(t, err)
+	require.Equal(t, "moderation", purpose)
+	require.Equal(t, ai.ModeCommentModeration, polisher.request.Mode)
+	require.Equal(t, ai.TargetComment, polisher.request.Target)
+	require.Equal(t, "你写的什么垃圾", polisher.request.Text)
+}
+
+func TestProcessTaskScreenCommentPublishesCleanComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	polisher := &fakeModerationPolisher{resp: ai.PolishResponse{Suggestions: []ai.Suggestion{{Content: "ok"}}}}
+	processor := &RedisTaskProcessor{
+		store: store,
+		aiPolisher: func(ctx context.Context, purpose string) (ai.TextPolisher, error) {
+			return polisher, nil
+		},
+	}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(8)).
+		Return(db.Comment{ID: 8, Content: "感谢分享", Status: moderation.StatusPending}, nil)
+	store.EXPECT().ResolvePendingComment(gomock.Any(), db.ResolvePendingCommentParams{
+		ID:     8,
+		Status: moderation.StatusPublished,
+	}).Return(db.Comment{ID: 8, Status: moderation.StatusPublished}, nil)
+
+	require.NoError(t, processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 8)))
+}
+
+func TestProcessTaskScreenCommentPublishesWhenAIDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{
+		store: store,
+		aiPolisher: func(ctx context.Context, purpose string) (ai.TextPolisher, error) {
+			return nil, ai.ErrDisabled
+		},
+	}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(9)).
+		Return(db.Comment{ID: 9, Content: "hello", Status: moderation.StatusPending}, nil)
+	store.EXPECT().ResolvePendingComment(gomock.Any(), db.ResolvePendingCommentParams{
+		ID:     9,
+		Status: moderation.StatusPublished,
+	}).Return(db.Comment{ID: 9, Status: moderation.StatusPublished}, nil)
+
+	require.NoError(t, processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 9)))
+}
+
+func TestProcessTaskScreenCommentRetriesProviderFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{
+		store: store,
+		aiPolisher: func(ctx context.Context, purpose string) (ai.TextPolisher, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(11)).
+		Return(db.Comment{ID: 11, Content: "hello", Status: moderation.StatusPending}, nil)
+	store.EXPECT().ResolvePendingComment(gomock.Any(), gomock.Any()).Times(0)
+
+	err := processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 11))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, asynq.SkipRetry)
+}
+
+func TestProcessTaskScreenCommentSkipsReviewedComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{
+		store: store,
+		aiPolisher: func(ctx context.Context, purpose string) (ai.TextPolisher, error) {
+			t.Fatal("reviewed comment should not be screened again")
+			return nil, nil
+		},
+	}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(12)).
+		Return(db.Comment{ID: 12, Content: "hello", Status: moderation.StatusRejected}, nil)
+	store.EXPECT().ResolvePendingComment(gomock.Any(), gomock.Any()).Times(0)
+
+	require.NoError(t, processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 12)))
+}
+
+func TestProcessTaskScreenCommentSkipsMissingComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{store: store}
+
+	store.EXPECT().GetComment(gomock.Any(), int64(10)).Return(db.Comment{}, db.ErrRecordNotFound)
+
+	err := processor.ProcessTaskScreenComment(context.Background(), newScreenCommentTask(t, 10))
+	require.ErrorIs(t, err, asynq.SkipRetry)
+}
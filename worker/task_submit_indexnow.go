@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSubmitIndexNow = "task:submit_indexnow"
+
+// indexNowMergeWindow 推送任务的延迟执行时间，也是相同地址的去重窗口
+const indexNowMergeWindow = 10 * time.Second
+
+type PayloadSubmitIndexNow struct {
+	URLs []string `json:"urls"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSubmitIndexNow(ctx context.Context, payload *PayloadSubmitIndexNow, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSubmitIndexNow, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+// DistributeTaskSubmitIndexNowDefault 使用默认配置分发 IndexNow 推送任务
+// 默认配置：MaxRetry=5, Timeout=30s, Queue=default, 延迟 10s 执行。
+// 同一组地址在 10s 内只保留一个任务，重复保存时返回 asynq.ErrDuplicateTask
+func (distributor *RedisTaskDistributor) DistributeTaskSubmitIndexNowDefault(ctx context.Context, urls ...string) error {
+	// Unique 按负载去重，地址排序后同一组地址的负载才会相同
+	urls = slices.Clone(urls)
+	slices.Sort(urls)
+	payload := &PayloadSubmitIndexNow{URLs: slices.Compact(urls)}
+	opts := []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Timeout(30 * time.Second),
+		asynq.ProcessIn(indexNowMergeWindow),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(indexNowMergeWindow),
+	}
+	return distributor.DistributeTaskSubmitIndexNow(ctx, payload, opts...)
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSubmitIndexNow(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSubmitIndexNow
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	if len(payload.URLs) == 0 {
+		return nil
+	}
+	if processor.indexNow == nil {
+		log.Warn().Str("type", task.Type()).Msg("skip indexnow submission: not configured")
+		return nil
+	}
+
+	retryCount, _ := asynq.GetRetryCount(ctx)
+	statusCode, submitErr := processor.indexNow.Submit(ctx, payload.URLs)
+
+	submission := db.CreateIndexNowSubmissionParams{
+		Endpoint:   processor.indexNow.Endpoint(),
+		Urls:       payload.URLs,
+		StatusCode: int32(statusCode),
+		Attempt:    int32(retryCount + 1),
+	}
+	if submitErr != nil {
+		submission.Error = submitErr.Error()
+	}
+	// 提交日志写入失败不影响推送结果
+	if _, err := processor.store.CreateIndexNowSubmission(ctx, submission); err != nil {
+		log.Error().Err(err).Str("type", task.Type()).Msg("failed to record indexnow submission")
+	}
+
+	if submitErr != nil {
+		if errors.Is(submitErr, indexnow.ErrRejected) {
+			return fmt.Errorf("failed to submit indexnow: %v: %w", submitErr, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to submit indexnow: %w", submitErr)
+	}
+
+	log.Info().Str("type", task.Type()).Int("status", statusCode).
+		Int("urls", len(payload.URLs)).Msg("processed task")
+
+	return nil
+}
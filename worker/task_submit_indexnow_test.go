@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	"github.com/MonitorAllen/nostalgia/internal/indexnow"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+const testIndexNowURL = "https://blog.example.com/article/go"
+
+func newTestIndexNowClient(t *testing.T, statusCode int) *indexnow.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	client := indexnow.NewClient(util.Config{
+		Domain:           "https://blog.example.com",
+		IndexNowEndpoint: server.URL,
+		IndexNowKey:      "a1b2c3d4e5f6",
+	})
+	require.NotNil(t, client)
+	return client
+}
+
+func newSubmitIndexNowTask(t *testing.T, urls ...string) *asynq.Task {
+	payload, err := json.Marshal(PayloadSubmitIndexNow{URLs: urls})
+	require.NoError(t, err)
+	return asynq.NewTask(TaskSubmitIndexNow, payload)
+}
+
+func TestProcessTaskSubmitIndexNow(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		errMessage string
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:       "Accepted",
+			statusCode: http.StatusAccepted,
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "RejectedSkipsRetry",
+			statusCode: http.StatusForbidden,
+			errMessage: "indexnow responded with status 403: indexnow submission rejected",
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+		{
+			name:       "ServerErrorRetries",
+			statusCode: http.StatusServiceUnavailable,
+			errMessage: "indexnow responded with status 503",
+			checkError: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.False(t, errors.Is(err, asynq.SkipRetry))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := newTestIndexNowClient(t, tc.statusCode)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				CreateIndexNowSubmission(gomock.Any(), gomock.Eq(db.CreateIndexNowSubmissionParams{
+					Endpoint:   client.Endpoint(),
+					Urls:       []string{testIndexNowURL},
+					StatusCode: int32(tc.statusCode),
+					Error:      tc.errMessage,
+					Attempt:    1,
+				})).
+				Times(1).
+				Return(db.IndexnowSubmission{}, nil)
+
+			processor := &RedisTaskProcessor{store: store, indexNow: client}
+			err := processor.ProcessTaskSubmitIndexNow(context.Background(), newSubmitIndexNowTask(t, testIndexNowURL))
+			tc.checkError(t, err)
+		})
+	}
+}
+
+func TestProcessTaskSubmitIndexNowNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().CreateIndexNowSubmission(gomock.Any(), gomock.Any()).Times(0)
+
+	processor := &RedisTaskProcessor{store: store}
+	err := processor.ProcessTaskSubmitIndexNow(context.Background(), newSubmitIndexNowTask(t, testIndexNowURL))
+	require.NoError(t, err)
+}
@@ -0,0 +1,207 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskWarmCache = "task:warm_cache"
+
+// PayloadWarmCache 需要预热的页面，由配置生成
+type PayloadWarmCache struct {
+	// ListPages 首页文章列表预热的页数
+	ListPages int32 `json:"list_pages"`
+	// ListLimit 与前端列表的每页条数保持一致，否则预热的键不会被命中
+	ListLimit int32 `json:"list_limit"`
+	// CategoryPages 是否预热分类列表及每个分类的第一页
+	CategoryPages bool `json:"category_pages"`
+	// PopularArticles 预热浏览量最高的文章详情数量
+	PopularArticles int32 `json:"popular_articles"`
+}
+
+func NewPayloadWarmCache(config util.Config) *PayloadWarmCache {
+	return &PayloadWarmCache{
+		ListPages:       min(config.CacheWarmListPages, cachepkg.MaxCachedArticleListPage),
+		ListLimit:       config.CacheWarmListLimit,
+		CategoryPages:   config.CacheWarmCategoryPages,
+		PopularArticles: config.CacheWarmPopularArticles,
+	}
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskWarmCache(ctx context.Context, payload *PayloadWarmCache, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskWarmCache, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+// DistributeTaskWarmCacheDefault 使用默认配置分发缓存预热任务
+// 默认配置：MaxRetry=2, Timeout=2m, Queue=default, 延迟 5s 等待缓存删除完成并合并短时间内的多次触发，
+// 队列中已有相同的预热任务时返回 asynq.ErrDuplicateTask
+func (distributor *RedisTaskDistributor) DistributeTaskWarmCacheDefault(ctx context.Context, payload *PayloadWarmCache) error {
+	opts := []asynq.Option{
+		asynq.MaxRetry(2),
+		asynq.Timeout(2 * time.Minute),
+		asynq.ProcessIn(5 * time.Second),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(time.Minute),
+	}
+	return distributor.DistributeTaskWarmCache(ctx, payload, opts...)
+}
+
+// ProcessTaskWarmCache 单个页面预热失败不影响其余页面，全部完成后汇总返回错误
+func (processor *RedisTaskProcessor) ProcessTaskWarmCache(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadWarmCache
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	if processor.cache == nil {
+		return nil
+	}
+
+	articleCache := cachepkg.NewArticleCache(processor.cache)
+	var errs []error
+	warmed := 0
+
+	if payload.ListLimit > 0 {
+		for page := int32(1); page <= payload.ListPages; page++ {
+			if err := processor.warmArticleList(ctx, articleCache, 0, page, payload.ListLimit); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			warmed++
+		}
+	}
+
+	if payload.CategoryPages {
+		categories, err := processor.warmCategoryList(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			warmed++
+		}
+		for _, category := range categories {
+			if category.ArticleCount == 0 || payload.ListLimit <= 0 {
+				continue
+			}
+			if err := processor.warmArticleList(ctx, articleCache, category.ID, 1, payload.ListLimit); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			warmed++
+		}
+	}
+
+	if payload.PopularArticles > 0 {
+		articles, err := processor.store.ListPopularArticleIDs(ctx, payload.PopularArticles)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list popular articles: %w", err))
+		}
+		for _, article := range articles {
+			if err := processor.warmArticleDetail(ctx, articleCache, article); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			warmed++
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("warmed", warmed).
+		Int("failed", len(errs)).Msg("processed task")
+
+	return errors.Join(errs...)
+}
+
+// warmArticleList 与文章列表接口使用相同的查询条件，保证写入的缓存可以被直接命中
+func (processor *RedisTaskProcessor) warmArticleList(ctx context.Context, articleCache *cachepkg.ArticleCache, categoryID int64, page int32, limit int32) error {
+	isPublish := pgtype.Bool{Bool: true, Valid: true}
+	category := pgtype.Int8{Int64: categoryID, Valid: categoryID != 0}
+
+	articles, err := processor.store.ListArticles(ctx, db.ListArticlesParams{
+		Limit:      limit,
+		Offset:     (page - 1) * limit,
+		IsPublish:  isPublish,
+		CategoryID: category,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list articles of category %d page %d: %w", categoryID, page, err)
+	}
+
+	count, err := processor.store.CountArticles(ctx, db.CountArticlesParams{
+		IsPublish:  isPublish,
+		CategoryID: category,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to count articles of category %d: %w", categoryID, err)
+	}
+
+	params := cachepkg.ArticleListParams{CategoryID: categoryID, Page: page, Limit: limit}
+	if err := articleCache.SetList(ctx, params, cachepkg.ArticleListPage{Count: count, Articles: articles}); err != nil {
+		return fmt.Errorf("failed to cache articles of category %d page %d: %w", categoryID, page, err)
+	}
+	return nil
+}
+
+// warmCategoryList 分类过多时不缓存完整列表，仍返回已查询的分类用于预热各分类第一页
+func (processor *RedisTaskProcessor) warmCategoryList(ctx context.Context) ([]db.ListCategoriesCountArticlesRow, error) {
+	categories, err := processor.store.ListCategoriesCountArticles(ctx, db.ListCategoriesCountArticlesParams{
+		Limit: cachepkg.MaxCachedCategories,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	if int32(len(categories)) >= cachepkg.MaxCachedCategories {
+		return categories, nil
+	}
+
+	if err := cachepkg.NewCategoryCache(processor.cache).SetList(ctx, categories); err != nil {
+		return categories, fmt.Errorf("failed to cache categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (processor *RedisTaskProcessor) warmArticleDetail(ctx context.Context, articleCache *cachepkg.ArticleCache, popular db.ListPopularArticleIDsRow) error {
+	article, err := processor.store.GetArticle(ctx, popular.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get article %s: %w", popular.ID, err)
+	}
+	if !article.IsPublish {
+		return nil
+	}
+	if err := articleCache.SetByID(ctx, article); err != nil {
+		return fmt.Errorf("failed to cache article %s: %w", popular.ID, err)
+	}
+
+	if !popular.Slug.Valid {
+		return nil
+	}
+	articleBySlug, err := processor.store.GetArticleBySlug(ctx, popular.Slug)
+	if err != nil {
+		return fmt.Errorf("failed to get article by slug %s: %w", popular.Slug.String, err)
+	}
+	if err := articleCache.SetBySlug(ctx, popular.Slug.String, articleBySlug); err != nil {
+		return fmt.Errorf("failed to cache article by slug %s: %w", popular.Slug.String, err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	mockdb "github.com/MonitorAllen/nostalgia/db/mock"
+	db "github.com/MonitorAllen/nostalgia/db/sqlc"
+	cachepkg "github.com/MonitorAllen/nostalgia/internal/cache"
+	"github.com/MonitorAllen/nostalgia/internal/cache/key"
+	mockcache "github.com/MonitorAllen/nostalgia/internal/cache/mock"
+	"github.com/MonitorAllen/nostalgia/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayloadWarmCacheClampsListPages(t *testing.T) {
+	payload := NewPayloadWarmCache(util.Config{
+		CacheWarmListPages:       20,
+		CacheWarmListLimit:       10,
+		CacheWarmCategoryPages:   true,
+		CacheWarmPopularArticles: 5,
+	})
+
+	require.Equal(t, &PayloadWarmCache{
+		ListPages:       cachepkg.MaxCachedArticleListPage,
+		ListLimit:       10,
+		CategoryPages:   true,
+		PopularArticles: 5,
+	}, payload)
+}
+
+func TestProcessTaskWarmCache(t *testing.T) {
+	articleID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	slug := pgtype.Text{String: "redis-cache", Valid: true}
+	categories := []db.ListCategoriesCountArticlesRow{
+		{ID: 3, Name: "Go", ArticleCount: 2},
+		{ID: 4, Name: "空分类", ArticleCount: 0},
+	}
+
+	payload, err := json.Marshal(PayloadWarmCache{ListPages: 1, ListLimit: 10, CategoryPages: true, PopularArticles: 1})
+	require.NoError(t, err)
+	task := asynq.NewTask(TaskWarmCache, payload)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore, cache *mockcache.MockCache)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				// 列表版本号尚未写入
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+				store.EXPECT().
+					ListArticles(gomock.Any(), gomock.Eq(db.ListArticlesParams{
+						Limit:     10,
+						IsPublish: pgtype.Bool{Bool: true, Valid: true},
+					})).
+					Times(1).
+					Return([]db.ListArticlesRow{{ID: articleID}}, nil)
+				store.EXPECT().
+					CountArticles(gomock.Any(), gomock.Eq(db.CountArticlesParams{IsPublish: pgtype.Bool{Bool: true, Valid: true}})).
+					Times(1).
+					Return(int64(1), nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.GetArticleListKey(0, 0, 1, 10)), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+				store.EXPECT().
+					ListCategoriesCountArticles(gomock.Any(), gomock.Eq(db.ListCategoriesCountArticlesParams{Limit: cachepkg.MaxCachedCategories})).
+					Times(1).
+					Return(categories, nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.CategoryAllKey), gomock.Eq(categories), gomock.Any()).Times(1).Return(nil)
+
+				// 没有文章的分类不预热
+				store.EXPECT().
+					ListArticles(gomock.Any(), gomock.Eq(db.ListArticlesParams{
+						Limit:      10,
+						IsPublish:  pgtype.Bool{Bool: true, Valid: true},
+						CategoryID: pgtype.Int8{Int64: 3, Valid: true},
+					})).
+					Times(1).
+					Return([]db.ListArticlesRow{{ID: articleID}}, nil)
+				store.EXPECT().
+					CountArticles(gomock.Any(), gomock.Eq(db.CountArticlesParams{
+						IsPublish:  pgtype.Bool{Bool: true, Valid: true},
+						CategoryID: pgtype.Int8{Int64: 3, Valid: true},
+					})).
+					Times(1).
+					Return(int64(2), nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.GetArticleListKey(0, 3, 1, 10)), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+				store.EXPECT().
+					ListPopularArticleIDs(gomock.Any(), gomock.Eq(int32(1))).
+					Times(1).
+					Return([]db.ListPopularArticleIDsRow{{ID: articleID, Slug: slug}}, nil)
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(articleID)).
+					Times(1).
+					Return(db.GetArticleRow{ID: articleID, Slug: slug, IsPublish: true}, nil)
+				store.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Eq(slug)).
+					Times(1).
+					Return(db.GetArticleBySlugRow{ID: articleID, Slug: slug, IsPublish: true}, nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.GetArticleIDKey(articleID)), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.GetArticleSlugKey(slug.String)), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PartialFailure",
+			buildStubs: func(store *mockdb.MockStore, cache *mockcache.MockCache) {
+				cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+				store.EXPECT().ListArticles(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().CountArticles(gomock.Any(), gomock.Any()).Times(0)
+
+				// 首页失败不影响热门文章预热
+				store.EXPECT().ListCategoriesCountArticles(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().
+					ListPopularArticleIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListPopularArticleIDsRow{{ID: articleID}}, nil)
+				store.EXPECT().
+					GetArticle(gomock.Any(), gomock.Eq(articleID)).
+					Times(1).
+					Return(db.GetArticleRow{ID: articleID, IsPublish: true}, nil)
+				store.EXPECT().GetArticleBySlug(gomock.Any(), gomock.Any()).Times(0)
+				cache.EXPECT().Set(gomock.Any(), gomock.Eq(key.GetArticleIDKey(articleID)), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			cache := mockcache.NewMockCache(ctrl)
+			tc.buildStubs(store, cache)
+
+			processor := &RedisTaskProcessor{store: store, cache: cache}
+			err := processor.ProcessTaskWarmCache(context.Background(), task)
+			tc.checkError(t, err)
+		})
+	}
+}